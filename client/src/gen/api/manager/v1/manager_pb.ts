@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/manager/v1/manager.proto.
  */
 export const file_api_manager_v1_manager: GenFile = /*@__PURE__*/
-  fileDesc("ChxhcGkvbWFuYWdlci92MS9tYW5hZ2VyLnByb3RvEg5hcGkubWFuYWdlci52MSI+ChRTdGFydEluc3RhbmNlUmVxdWVzdBIRCglpbWFnZV90YWcYASABKAkSEwoLdHRsX3NlY29uZHMYAyABKAMijAEKFVN0YXJ0SW5zdGFuY2VSZXNwb25zZRIOCgZzdGF0dXMYASABKAkSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCRITCgtpbnN0YW5jZV9pZBgDIAEoCRI3Cg9jb25uZWN0aW9uX2luZm8YBCABKAsyHi5hcGkubWFuYWdlci52MS5Db25uZWN0aW9uSW5mbyIsCg5Db25uZWN0aW9uSW5mbxIMCgRob3N0GAEgASgJEgwKBHBvcnQYAiABKAUiKgoTU3RvcEluc3RhbmNlUmVxdWVzdBITCgtpbnN0YW5jZV9pZBgBIAEoCSI9ChRTdG9wSW5zdGFuY2VSZXNwb25zZRIOCgZzdGF0dXMYASABKAkSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSItChZEZXN0cm95SW5zdGFuY2VSZXF1ZXN0EhMKC2luc3RhbmNlX2lkGAEgASgJIkAKF0Rlc3Ryb3lJbnN0YW5jZVJlc3BvbnNlEg4KBnN0YXR1cxgBIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIi8KGEdldEluc3RhbmNlU3RhdHVzUmVxdWVzdBITCgtpbnN0YW5jZV9pZBgBIAEoCSLNAQoZR2V0SW5zdGFuY2VTdGF0dXNSZXNwb25zZRI+CgVzdGF0ZRgBIAEoDjIvLmFwaS5tYW5hZ2VyLnYxLkdldEluc3RhbmNlU3RhdHVzUmVzcG9uc2UuU3RhdGUSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSJZCgVTdGF0ZRIVChFTVEFURV9VTlNQRUNJRklFRBAAEhEKDVNUQVRFX1JVTk5JTkcQARIRCg1TVEFURV9TVE9QUEVEEAISEwoPU1RBVEVfREVTVFJPWUVEEAMiMAoZU3RyZWFtSW5zdGFuY2VMb2dzUmVxdWVzdBITCgtpbnN0YW5jZV9pZBgBIAEoCSIuChpTdHJlYW1JbnN0YW5jZUxvZ3NSZXNwb25zZRIQCghsb2dfbGluZRgBIAEoCTKFBAoNUnVubmVyU2VydmljZRJcCg1TdGFydEluc3RhbmNlEiQuYXBpLm1hbmFnZXIudjEuU3RhcnRJbnN0YW5jZVJlcXVlc3QaJS5hcGkubWFuYWdlci52MS5TdGFydEluc3RhbmNlUmVzcG9uc2USWQoMU3RvcEluc3RhbmNlEiMuYXBpLm1hbmFnZXIudjEuU3RvcEluc3RhbmNlUmVxdWVzdBokLmFwaS5tYW5hZ2VyLnYxLlN0b3BJbnN0YW5jZVJlc3BvbnNlEmIKD0Rlc3Ryb3lJbnN0YW5jZRImLmFwaS5tYW5hZ2VyLnYxLkRlc3Ryb3lJbnN0YW5jZVJlcXVlc3QaJy5hcGkubWFuYWdlci52MS5EZXN0cm95SW5zdGFuY2VSZXNwb25zZRJoChFHZXRJbnN0YW5jZVN0YXR1cxIoLmFwaS5tYW5hZ2VyLnYxLkdldEluc3RhbmNlU3RhdHVzUmVxdWVzdBopLmFwaS5tYW5hZ2VyLnYxLkdldEluc3RhbmNlU3RhdHVzUmVzcG9uc2USbQoSU3RyZWFtSW5zdGFuY2VMb2dzEikuYXBpLm1hbmFnZXIudjEuU3RyZWFtSW5zdGFuY2VMb2dzUmVxdWVzdBoqLmFwaS5tYW5hZ2VyLnYxLlN0cmVhbUluc3RhbmNlTG9nc1Jlc3BvbnNlMAFCugEKEmNvbS5hcGkubWFuYWdlci52MUIMTWFuYWdlclByb3RvUAFaPGdpdGh1Yi5jb20va2F2b3MxMTMvcXVpY2tjdGYvZ2VuL2dvL2FwaS9tYW5hZ2VyL3YxO21hbmFnZXJ2MaICA0FNWKoCDkFwaS5NYW5hZ2VyLlYxygIOQXBpXE1hbmFnZXJcVjHiAhpBcGlcTWFuYWdlclxWMVxHUEJNZXRhZGF0YeoCEEFwaTo6TWFuYWdlcjo6VjFiBnByb3RvMw");
+  fileDesc("ChxhcGkvbWFuYWdlci92MS9tYW5hZ2VyLnByb3RvEg5hcGkubWFuYWdlci52MSJMChRTdGFydEluc3RhbmNlUmVxdWVzdBIRCglpbWFnZV90YWcYASABKAkSEwoLdHRsX3NlY29uZHMYAyABKAMSDAoEZmxhZxgEIAEoCSKMAQoVU3RhcnRJbnN0YW5jZVJlc3BvbnNlEg4KBnN0YXR1cxgBIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJEhMKC2luc3RhbmNlX2lkGAMgASgJEjcKD2Nvbm5lY3Rpb25faW5mbxgEIAEoCzIeLmFwaS5tYW5hZ2VyLnYxLkNvbm5lY3Rpb25JbmZvIiwKDkNvbm5lY3Rpb25JbmZvEgwKBGhvc3QYASABKAkSDAoEcG9ydBgCIAEoBSIqChNTdG9wSW5zdGFuY2VSZXF1ZXN0EhMKC2luc3RhbmNlX2lkGAEgASgJIj0KFFN0b3BJbnN0YW5jZVJlc3BvbnNlEg4KBnN0YXR1cxgBIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIi0KFkRlc3Ryb3lJbnN0YW5jZVJlcXVlc3QSEwoLaW5zdGFuY2VfaWQYASABKAkiQAoXRGVzdHJveUluc3RhbmNlUmVzcG9uc2USDgoGc3RhdHVzGAEgASgJEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiLwoYR2V0SW5zdGFuY2VTdGF0dXNSZXF1ZXN0EhMKC2luc3RhbmNlX2lkGAEgASgJIs0BChlHZXRJbnN0YW5jZVN0YXR1c1Jlc3BvbnNlEj4KBXN0YXRlGAEgASgOMi8uYXBpLm1hbmFnZXIudjEuR2V0SW5zdGFuY2VTdGF0dXNSZXNwb25zZS5TdGF0ZRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIlkKBVN0YXRlEhUKEVNUQVRFX1VOU1BFQ0lGSUVEEAASEQoNU1RBVEVfUlVOTklORxABEhEKDVNUQVRFX1NUT1BQRUQQAhITCg9TVEFURV9ERVNUUk9ZRUQQAyIwChlTdHJlYW1JbnN0YW5jZUxvZ3NSZXF1ZXN0EhMKC2luc3RhbmNlX2lkGAEgASgJIi4KGlN0cmVhbUluc3RhbmNlTG9nc1Jlc3BvbnNlEhAKCGxvZ19saW5lGAEgASgJMoUECg1SdW5uZXJTZXJ2aWNlElwKDVN0YXJ0SW5zdGFuY2USJC5hcGkubWFuYWdlci52MS5TdGFydEluc3RhbmNlUmVxdWVzdBolLmFwaS5tYW5hZ2VyLnYxLlN0YXJ0SW5zdGFuY2VSZXNwb25zZRJZCgxTdG9wSW5zdGFuY2USIy5hcGkubWFuYWdlci52MS5TdG9wSW5zdGFuY2VSZXF1ZXN0GiQuYXBpLm1hbmFnZXIudjEuU3RvcEluc3RhbmNlUmVzcG9uc2USYgoPRGVzdHJveUluc3RhbmNlEiYuYXBpLm1hbmFnZXIudjEuRGVzdHJveUluc3RhbmNlUmVxdWVzdBonLmFwaS5tYW5hZ2VyLnYxLkRlc3Ryb3lJbnN0YW5jZVJlc3BvbnNlEmgKEUdldEluc3RhbmNlU3RhdHVzEiguYXBpLm1hbmFnZXIudjEuR2V0SW5zdGFuY2VTdGF0dXNSZXF1ZXN0GikuYXBpLm1hbmFnZXIudjEuR2V0SW5zdGFuY2VTdGF0dXNSZXNwb25zZRJtChJTdHJlYW1JbnN0YW5jZUxvZ3MSKS5hcGkubWFuYWdlci52MS5TdHJlYW1JbnN0YW5jZUxvZ3NSZXF1ZXN0GiouYXBpLm1hbmFnZXIudjEuU3RyZWFtSW5zdGFuY2VMb2dzUmVzcG9uc2UwAUK6AQoSY29tLmFwaS5tYW5hZ2VyLnYxQgxNYW5hZ2VyUHJvdG9QAVo8Z2l0aHViLmNvbS9rYXZvczExMy9xdWlja2N0Zi9nZW4vZ28vYXBpL21hbmFnZXIvdjE7bWFuYWdlcnYxogIDQU1YqgIOQXBpLk1hbmFnZXIuVjHKAg5BcGlcTWFuYWdlclxWMeICGkFwaVxNYW5hZ2VyXFYxXEdQQk1ldGFkYXRh6gIQQXBpOjpNYW5hZ2VyOjpWMWIGcHJvdG8z");
 
 /**
  * @generated from message api.manager.v1.StartInstanceRequest
@@ -25,6 +25,13 @@ export type StartInstanceRequest = Message<"api.manager.v1.StartInstanceRequest"
    * @generated from field: int64 ttl_seconds = 3;
    */
   ttlSeconds: bigint;
+
+  /**
+   * optional, injected into the container when set
+   *
+   * @generated from field: string flag = 4;
+   */
+  flag: string;
 };
 
 /**
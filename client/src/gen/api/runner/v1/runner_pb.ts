@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/runner/v1/runner.proto.
  */
 export const file_api_runner_v1_runner: GenFile = /*@__PURE__*/
-  fileDesc("ChphcGkvcnVubmVyL3YxL3J1bm5lci5wcm90bxINYXBpLnJ1bm5lci52MSJBChRTdGFydEluc3RhbmNlUmVxdWVzdBIRCglpbWFnZV90YWcYASABKAkSFgoOY29udGFpbmVyX25hbWUYAiABKAkijAEKFVN0YXJ0SW5zdGFuY2VSZXNwb25zZRIOCgZzdGF0dXMYASABKAkSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCRIUCgxjb250YWluZXJfaWQYAyABKAkSNgoPY29ubmVjdGlvbl9pbmZvGAQgASgLMh0uYXBpLnJ1bm5lci52MS5Db25uZWN0aW9uSW5mbyIsCg5Db25uZWN0aW9uSW5mbxIMCgRob3N0GAEgASgJEgwKBHBvcnQYAiABKAUiKwoTU3RvcEluc3RhbmNlUmVxdWVzdBIUCgxjb250YWluZXJfaWQYASABKAkiPQoUU3RvcEluc3RhbmNlUmVzcG9uc2USDgoGc3RhdHVzGAEgASgJEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiLgoWRGVzdHJveUluc3RhbmNlUmVxdWVzdBIUCgxjb250YWluZXJfaWQYASABKAkiQAoXRGVzdHJveUluc3RhbmNlUmVzcG9uc2USDgoGc3RhdHVzGAEgASgJEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiMAoYR2V0SW5zdGFuY2VTdGF0dXNSZXF1ZXN0EhQKDGNvbnRhaW5lcl9pZBgBIAEoCSLMAQoZR2V0SW5zdGFuY2VTdGF0dXNSZXNwb25zZRI9CgVzdGF0ZRgBIAEoDjIuLmFwaS5ydW5uZXIudjEuR2V0SW5zdGFuY2VTdGF0dXNSZXNwb25zZS5TdGF0ZRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIlkKBVN0YXRlEhUKEVNUQVRFX1VOU1BFQ0lGSUVEEAASEQoNU1RBVEVfUlVOTklORxABEhEKDVNUQVRFX1NUT1BQRUQQAhITCg9TVEFURV9ERVNUUk9ZRUQQAyIxChlTdHJlYW1JbnN0YW5jZUxvZ3NSZXF1ZXN0EhQKDGNvbnRhaW5lcl9pZBgBIAEoCSIuChpTdHJlYW1JbnN0YW5jZUxvZ3NSZXNwb25zZRIQCghsb2dfbGluZRgBIAEoCTL7AwoNUnVubmVyU2VydmljZRJaCg1TdGFydEluc3RhbmNlEiMuYXBpLnJ1bm5lci52MS5TdGFydEluc3RhbmNlUmVxdWVzdBokLmFwaS5ydW5uZXIudjEuU3RhcnRJbnN0YW5jZVJlc3BvbnNlElcKDFN0b3BJbnN0YW5jZRIiLmFwaS5ydW5uZXIudjEuU3RvcEluc3RhbmNlUmVxdWVzdBojLmFwaS5ydW5uZXIudjEuU3RvcEluc3RhbmNlUmVzcG9uc2USYAoPRGVzdHJveUluc3RhbmNlEiUuYXBpLnJ1bm5lci52MS5EZXN0cm95SW5zdGFuY2VSZXF1ZXN0GiYuYXBpLnJ1bm5lci52MS5EZXN0cm95SW5zdGFuY2VSZXNwb25zZRJmChFHZXRJbnN0YW5jZVN0YXR1cxInLmFwaS5ydW5uZXIudjEuR2V0SW5zdGFuY2VTdGF0dXNSZXF1ZXN0GiguYXBpLnJ1bm5lci52MS5HZXRJbnN0YW5jZVN0YXR1c1Jlc3BvbnNlEmsKElN0cmVhbUluc3RhbmNlTG9ncxIoLmFwaS5ydW5uZXIudjEuU3RyZWFtSW5zdGFuY2VMb2dzUmVxdWVzdBopLmFwaS5ydW5uZXIudjEuU3RyZWFtSW5zdGFuY2VMb2dzUmVzcG9uc2UwAUKyAQoRY29tLmFwaS5ydW5uZXIudjFCC1J1bm5lclByb3RvUAFaOmdpdGh1Yi5jb20va2F2b3MxMTMvcXVpY2tjdGYvZ2VuL2dvL2FwaS9ydW5uZXIvdjE7cnVubmVydjGiAgNBUliqAg1BcGkuUnVubmVyLlYxygINQXBpXFJ1bm5lclxWMeICGUFwaVxSdW5uZXJcVjFcR1BCTWV0YWRhdGHqAg9BcGk6OlJ1bm5lcjo6VjFiBnByb3RvMw");
+  fileDesc("ChphcGkvcnVubmVyL3YxL3J1bm5lci5wcm90bxINYXBpLnJ1bm5lci52MSJPChRTdGFydEluc3RhbmNlUmVxdWVzdBIRCglpbWFnZV90YWcYASABKAkSFgoOY29udGFpbmVyX25hbWUYAiABKAkSDAoEZmxhZxgDIAEoCSKMAQoVU3RhcnRJbnN0YW5jZVJlc3BvbnNlEg4KBnN0YXR1cxgBIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJEhQKDGNvbnRhaW5lcl9pZBgDIAEoCRI2Cg9jb25uZWN0aW9uX2luZm8YBCABKAsyHS5hcGkucnVubmVyLnYxLkNvbm5lY3Rpb25JbmZvIiwKDkNvbm5lY3Rpb25JbmZvEgwKBGhvc3QYASABKAkSDAoEcG9ydBgCIAEoBSIrChNTdG9wSW5zdGFuY2VSZXF1ZXN0EhQKDGNvbnRhaW5lcl9pZBgBIAEoCSI9ChRTdG9wSW5zdGFuY2VSZXNwb25zZRIOCgZzdGF0dXMYASABKAkSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSIuChZEZXN0cm95SW5zdGFuY2VSZXF1ZXN0EhQKDGNvbnRhaW5lcl9pZBgBIAEoCSJAChdEZXN0cm95SW5zdGFuY2VSZXNwb25zZRIOCgZzdGF0dXMYASABKAkSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSIwChhHZXRJbnN0YW5jZVN0YXR1c1JlcXVlc3QSFAoMY29udGFpbmVyX2lkGAEgASgJIswBChlHZXRJbnN0YW5jZVN0YXR1c1Jlc3BvbnNlEj0KBXN0YXRlGAEgASgOMi4uYXBpLnJ1bm5lci52MS5HZXRJbnN0YW5jZVN0YXR1c1Jlc3BvbnNlLlN0YXRlEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiWQoFU3RhdGUSFQoRU1RBVEVfVU5TUEVDSUZJRUQQABIRCg1TVEFURV9SVU5OSU5HEAESEQoNU1RBVEVfU1RPUFBFRBACEhMKD1NUQVRFX0RFU1RST1lFRBADIjEKGVN0cmVhbUluc3RhbmNlTG9nc1JlcXVlc3QSFAoMY29udGFpbmVyX2lkGAEgASgJIi4KGlN0cmVhbUluc3RhbmNlTG9nc1Jlc3BvbnNlEhAKCGxvZ19saW5lGAEgASgJMvsDCg1SdW5uZXJTZXJ2aWNlEloKDVN0YXJ0SW5zdGFuY2USIy5hcGkucnVubmVyLnYxLlN0YXJ0SW5zdGFuY2VSZXF1ZXN0GiQuYXBpLnJ1bm5lci52MS5TdGFydEluc3RhbmNlUmVzcG9uc2USVwoMU3RvcEluc3RhbmNlEiIuYXBpLnJ1bm5lci52MS5TdG9wSW5zdGFuY2VSZXF1ZXN0GiMuYXBpLnJ1bm5lci52MS5TdG9wSW5zdGFuY2VSZXNwb25zZRJgCg9EZXN0cm95SW5zdGFuY2USJS5hcGkucnVubmVyLnYxLkRlc3Ryb3lJbnN0YW5jZVJlcXVlc3QaJi5hcGkucnVubmVyLnYxLkRlc3Ryb3lJbnN0YW5jZVJlc3BvbnNlEmYKEUdldEluc3RhbmNlU3RhdHVzEicuYXBpLnJ1bm5lci52MS5HZXRJbnN0YW5jZVN0YXR1c1JlcXVlc3QaKC5hcGkucnVubmVyLnYxLkdldEluc3RhbmNlU3RhdHVzUmVzcG9uc2USawoSU3RyZWFtSW5zdGFuY2VMb2dzEiguYXBpLnJ1bm5lci52MS5TdHJlYW1JbnN0YW5jZUxvZ3NSZXF1ZXN0GikuYXBpLnJ1bm5lci52MS5TdHJlYW1JbnN0YW5jZUxvZ3NSZXNwb25zZTABQrIBChFjb20uYXBpLnJ1bm5lci52MUILUnVubmVyUHJvdG9QAVo6Z2l0aHViLmNvbS9rYXZvczExMy9xdWlja2N0Zi9nZW4vZ28vYXBpL3J1bm5lci92MTtydW5uZXJ2MaICA0FSWKoCDUFwaS5SdW5uZXIuVjHKAg1BcGlcUnVubmVyXFYx4gIZQXBpXFJ1bm5lclxWMVxHUEJNZXRhZGF0YeoCD0FwaTo6UnVubmVyOjpWMWIGcHJvdG8z");
 
 /**
  * @generated from message api.runner.v1.StartInstanceRequest
@@ -25,6 +25,13 @@ export type StartInstanceRequest = Message<"api.runner.v1.StartInstanceRequest">
    * @generated from field: string container_name = 2;
    */
   containerName: string;
+
+  /**
+   * optional, injected into the container when set
+   *
+   * @generated from field: string flag = 3;
+   */
+  flag: string;
 };
 
 /**
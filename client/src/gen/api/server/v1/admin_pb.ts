@@ -4,7 +4,7 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Attachment, Challenge, ChallengeRequest } from "./model_pb";
+import type { Announcement, Attachment, Challenge, ChallengeRequest, EventConfig, Hint } from "./model_pb";
 import { file_api_server_v1_model } from "./model_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/server/v1/admin.proto.
  */
 export const file_api_server_v1_admin: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvc2VydmVyL3YxL2FkbWluLnByb3RvEg1hcGkuc2VydmVyLnYxIkwKFkNyZWF0ZUNoYWxsZW5nZVJlcXVlc3QSMgoJY2hhbGxlbmdlGAEgASgLMh8uYXBpLnNlcnZlci52MS5DaGFsbGVuZ2VSZXF1ZXN0IkYKF0NyZWF0ZUNoYWxsZW5nZVJlc3BvbnNlEhQKDGNoYWxsZW5nZV9pZBgBIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIkUKFlVwZGF0ZUNoYWxsZW5nZVJlcXVlc3QSKwoJY2hhbGxlbmdlGAEgASgLMhguYXBpLnNlcnZlci52MS5DaGFsbGVuZ2UiMAoXVXBkYXRlQ2hhbGxlbmdlUmVzcG9uc2USFQoNZXJyb3JfbWVzc2FnZRgBIAEoCSJHChtVcGxvYWRDaGFsbGVuZ2VJbWFnZVJlcXVlc3QSFAoMY2hhbGxlbmdlX2lkGAEgASgJEhIKCmltYWdlX2RhdGEYAiABKAwiRQocVXBsb2FkQ2hhbGxlbmdlSW1hZ2VSZXNwb25zZRIOCgZqb2JfaWQYASABKAkSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSIuChZEZWxldGVDaGFsbGVuZ2VSZXF1ZXN0EhQKDGNoYWxsZW5nZV9pZBgBIAEoCSIwChdEZWxldGVDaGFsbGVuZ2VSZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJIhcKFUxpc3RDaGFsbGVuZ2VzUmVxdWVzdCJdChZMaXN0Q2hhbGxlbmdlc1Jlc3BvbnNlEiwKCmNoYWxsZW5nZXMYASADKAsyGC5hcGkuc2VydmVyLnYxLkNoYWxsZW5nZRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIisKE0dldENoYWxsZW5nZVJlcXVlc3QSFAoMY2hhbGxlbmdlX2lkGAEgASgJIloKFEdldENoYWxsZW5nZVJlc3BvbnNlEisKCWNoYWxsZW5nZRgBIAEoCzIYLmFwaS5zZXJ2ZXIudjEuQ2hhbGxlbmdlEhUKDWVycm9yX21lc3NhZ2UYAiABKAkijQEKD0J1aWxkTG9nU3VtbWFyeRIOCgZqb2JfaWQYASABKAkSFAoMY2hhbGxlbmdlX2lkGAIgASgJEioKBnN0YXR1cxgDIAEoDjIaLmFwaS5zZXJ2ZXIudjEuQnVpbGRTdGF0dXMSEgoKY3JlYXRlZF9hdBgEIAEoCRIUCgxjb21wbGV0ZWRfYXQYBSABKAkiLAoUTGlzdEJ1aWxkTG9nc1JlcXVlc3QSFAoMY2hhbGxlbmdlX2lkGAEgASgJIlwKFUxpc3RCdWlsZExvZ3NSZXNwb25zZRIsCgRsb2dzGAEgAygLMh4uYXBpLnNlcnZlci52MS5CdWlsZExvZ1N1bW1hcnkSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSIkChJHZXRCdWlsZExvZ1JlcXVlc3QSDgoGam9iX2lkGAEgASgJIn0KE0dldEJ1aWxkTG9nUmVzcG9uc2USDgoGam9iX2lkGAEgASgJEhMKC2xvZ19jb250ZW50GAIgASgJEioKBnN0YXR1cxgDIAEoDjIaLmFwaS5zZXJ2ZXIudjEuQnVpbGRTdGF0dXMSFQoNZXJyb3JfbWVzc2FnZRgEIAEoCSInChVTdHJlYW1CdWlsZExvZ1JlcXVlc3QSDgoGam9iX2lkGAEgASgJImsKFlN0cmVhbUJ1aWxkTG9nUmVzcG9uc2USEAoIbG9nX2xpbmUYASABKAkSKgoGc3RhdHVzGAIgASgOMhouYXBpLnNlcnZlci52MS5CdWlsZFN0YXR1cxITCgtpc19jb21wbGV0ZRgDIAEoCCJPChdVcGxvYWRBdHRhY2htZW50UmVxdWVzdBIUCgxjaGFsbGVuZ2VfaWQYASABKAkSEAoIZmlsZW5hbWUYAiABKAkSDAoEZGF0YRgDIAEoDCJgChhVcGxvYWRBdHRhY2htZW50UmVzcG9uc2USLQoKYXR0YWNobWVudBgBIAEoCzIZLmFwaS5zZXJ2ZXIudjEuQXR0YWNobWVudBIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIkYKF0RlbGV0ZUF0dGFjaG1lbnRSZXF1ZXN0EhQKDGNoYWxsZW5nZV9pZBgBIAEoCRIVCg1hdHRhY2htZW50X2lkGAIgASgJIjEKGERlbGV0ZUF0dGFjaG1lbnRSZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJIiUKEUFkbWluTG9naW5SZXF1ZXN0EhAKCHBhc3N3b3JkGAEgASgJIhQKEkFkbWluTG9naW5SZXNwb25zZSIUChJBZG1pbkxvZ291dFJlcXVlc3QiFQoTQWRtaW5Mb2dvdXRSZXNwb25zZSqTAQoLQnVpbGRTdGF0dXMSHAoYQlVJTERfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGAoUQlVJTERfU1RBVFVTX1BFTkRJTkcQARIZChVCVUlMRF9TVEFUVVNfQlVJTERJTkcQAhIYChRCVUlMRF9TVEFUVVNfU1VDQ0VTUxADEhcKE0JVSUxEX1NUQVRVU19GQUlMRUQQBDK6CAoMQWRtaW5TZXJ2aWNlEmAKD0NyZWF0ZUNoYWxsZW5nZRIlLmFwaS5zZXJ2ZXIudjEuQ3JlYXRlQ2hhbGxlbmdlUmVxdWVzdBomLmFwaS5zZXJ2ZXIudjEuQ3JlYXRlQ2hhbGxlbmdlUmVzcG9uc2USYAoPVXBkYXRlQ2hhbGxlbmdlEiUuYXBpLnNlcnZlci52MS5VcGRhdGVDaGFsbGVuZ2VSZXF1ZXN0GiYuYXBpLnNlcnZlci52MS5VcGRhdGVDaGFsbGVuZ2VSZXNwb25zZRJvChRVcGxvYWRDaGFsbGVuZ2VJbWFnZRIqLmFwaS5zZXJ2ZXIudjEuVXBsb2FkQ2hhbGxlbmdlSW1hZ2VSZXF1ZXN0GisuYXBpLnNlcnZlci52MS5VcGxvYWRDaGFsbGVuZ2VJbWFnZVJlc3BvbnNlEmAKD0RlbGV0ZUNoYWxsZW5nZRIlLmFwaS5zZXJ2ZXIudjEuRGVsZXRlQ2hhbGxlbmdlUmVxdWVzdBomLmFwaS5zZXJ2ZXIudjEuRGVsZXRlQ2hhbGxlbmdlUmVzcG9uc2USXQoOTGlzdENoYWxsZW5nZXMSJC5hcGkuc2VydmVyLnYxLkxpc3RDaGFsbGVuZ2VzUmVxdWVzdBolLmFwaS5zZXJ2ZXIudjEuTGlzdENoYWxsZW5nZXNSZXNwb25zZRJXCgxHZXRDaGFsbGVuZ2USIi5hcGkuc2VydmVyLnYxLkdldENoYWxsZW5nZVJlcXVlc3QaIy5hcGkuc2VydmVyLnYxLkdldENoYWxsZW5nZVJlc3BvbnNlEloKDUxpc3RCdWlsZExvZ3MSIy5hcGkuc2VydmVyLnYxLkxpc3RCdWlsZExvZ3NSZXF1ZXN0GiQuYXBpLnNlcnZlci52MS5MaXN0QnVpbGRMb2dzUmVzcG9uc2USVAoLR2V0QnVpbGRMb2cSIS5hcGkuc2VydmVyLnYxLkdldEJ1aWxkTG9nUmVxdWVzdBoiLmFwaS5zZXJ2ZXIudjEuR2V0QnVpbGRMb2dSZXNwb25zZRJfCg5TdHJlYW1CdWlsZExvZxIkLmFwaS5zZXJ2ZXIudjEuU3RyZWFtQnVpbGRMb2dSZXF1ZXN0GiUuYXBpLnNlcnZlci52MS5TdHJlYW1CdWlsZExvZ1Jlc3BvbnNlMAESYwoQVXBsb2FkQXR0YWNobWVudBImLmFwaS5zZXJ2ZXIudjEuVXBsb2FkQXR0YWNobWVudFJlcXVlc3QaJy5hcGkuc2VydmVyLnYxLlVwbG9hZEF0dGFjaG1lbnRSZXNwb25zZRJjChBEZWxldGVBdHRhY2htZW50EiYuYXBpLnNlcnZlci52MS5EZWxldGVBdHRhY2htZW50UmVxdWVzdBonLmFwaS5zZXJ2ZXIudjEuRGVsZXRlQXR0YWNobWVudFJlc3BvbnNlMrsBChBBZG1pbkF1dGhTZXJ2aWNlElEKCkFkbWluTG9naW4SIC5hcGkuc2VydmVyLnYxLkFkbWluTG9naW5SZXF1ZXN0GiEuYXBpLnNlcnZlci52MS5BZG1pbkxvZ2luUmVzcG9uc2USVAoLQWRtaW5Mb2dvdXQSIS5hcGkuc2VydmVyLnYxLkFkbWluTG9nb3V0UmVxdWVzdBoiLmFwaS5zZXJ2ZXIudjEuQWRtaW5Mb2dvdXRSZXNwb25zZUKxAQoRY29tLmFwaS5zZXJ2ZXIudjFCCkFkbWluUHJvdG9QAVo6Z2l0aHViLmNvbS9rYXZvczExMy9xdWlja2N0Zi9nZW4vZ28vYXBpL3NlcnZlci92MTtzZXJ2ZXJ2MaICA0FTWKoCDUFwaS5TZXJ2ZXIuVjHKAg1BcGlcU2VydmVyXFYx4gIZQXBpXFNlcnZlclxWMVxHUEJNZXRhZGF0YeoCD0FwaTo6U2VydmVyOjpWMWIGcHJvdG8z", [file_api_server_v1_model]);
+  fileDesc("ChlhcGkvc2VydmVyL3YxL2FkbWluLnByb3RvEg1hcGkuc2VydmVyLnYxIkwKFkNyZWF0ZUNoYWxsZW5nZVJlcXVlc3QSMgoJY2hhbGxlbmdlGAEgASgLMh8uYXBpLnNlcnZlci52MS5DaGFsbGVuZ2VSZXF1ZXN0IkYKF0NyZWF0ZUNoYWxsZW5nZVJlc3BvbnNlEhQKDGNoYWxsZW5nZV9pZBgBIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIkUKFlVwZGF0ZUNoYWxsZW5nZVJlcXVlc3QSKwoJY2hhbGxlbmdlGAEgASgLMhguYXBpLnNlcnZlci52MS5DaGFsbGVuZ2UiMAoXVXBkYXRlQ2hhbGxlbmdlUmVzcG9uc2USFQoNZXJyb3JfbWVzc2FnZRgBIAEoCSJHChtVcGxvYWRDaGFsbGVuZ2VJbWFnZVJlcXVlc3QSFAoMY2hhbGxlbmdlX2lkGAEgASgJEhIKCmltYWdlX2RhdGEYAiABKAwiRQocVXBsb2FkQ2hhbGxlbmdlSW1hZ2VSZXNwb25zZRIOCgZqb2JfaWQYASABKAkSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSIuChZEZWxldGVDaGFsbGVuZ2VSZXF1ZXN0EhQKDGNoYWxsZW5nZV9pZBgBIAEoCSIwChdEZWxldGVDaGFsbGVuZ2VSZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJIhcKFUxpc3RDaGFsbGVuZ2VzUmVxdWVzdCJdChZMaXN0Q2hhbGxlbmdlc1Jlc3BvbnNlEiwKCmNoYWxsZW5nZXMYASADKAsyGC5hcGkuc2VydmVyLnYxLkNoYWxsZW5nZRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIisKE0dldENoYWxsZW5nZVJlcXVlc3QSFAoMY2hhbGxlbmdlX2lkGAEgASgJIloKFEdldENoYWxsZW5nZVJlc3BvbnNlEisKCWNoYWxsZW5nZRgBIAEoCzIYLmFwaS5zZXJ2ZXIudjEuQ2hhbGxlbmdlEhUKDWVycm9yX21lc3NhZ2UYAiABKAkijQEKD0J1aWxkTG9nU3VtbWFyeRIOCgZqb2JfaWQYASABKAkSFAoMY2hhbGxlbmdlX2lkGAIgASgJEioKBnN0YXR1cxgDIAEoDjIaLmFwaS5zZXJ2ZXIudjEuQnVpbGRTdGF0dXMSEgoKY3JlYXRlZF9hdBgEIAEoCRIUCgxjb21wbGV0ZWRfYXQYBSABKAkiLAoUTGlzdEJ1aWxkTG9nc1JlcXVlc3QSFAoMY2hhbGxlbmdlX2lkGAEgASgJIlwKFUxpc3RCdWlsZExvZ3NSZXNwb25zZRIsCgRsb2dzGAEgAygLMh4uYXBpLnNlcnZlci52MS5CdWlsZExvZ1N1bW1hcnkSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSIkChJHZXRCdWlsZExvZ1JlcXVlc3QSDgoGam9iX2lkGAEgASgJIn0KE0dldEJ1aWxkTG9nUmVzcG9uc2USDgoGam9iX2lkGAEgASgJEhMKC2xvZ19jb250ZW50GAIgASgJEioKBnN0YXR1cxgDIAEoDjIaLmFwaS5zZXJ2ZXIudjEuQnVpbGRTdGF0dXMSFQoNZXJyb3JfbWVzc2FnZRgEIAEoCSInChVTdHJlYW1CdWlsZExvZ1JlcXVlc3QSDgoGam9iX2lkGAEgASgJImsKFlN0cmVhbUJ1aWxkTG9nUmVzcG9uc2USEAoIbG9nX2xpbmUYASABKAkSKgoGc3RhdHVzGAIgASgOMhouYXBpLnNlcnZlci52MS5CdWlsZFN0YXR1cxITCgtpc19jb21wbGV0ZRgDIAEoCCJPChdVcGxvYWRBdHRhY2htZW50UmVxdWVzdBIUCgxjaGFsbGVuZ2VfaWQYASABKAkSEAoIZmlsZW5hbWUYAiABKAkSDAoEZGF0YRgDIAEoDCJgChhVcGxvYWRBdHRhY2htZW50UmVzcG9uc2USLQoKYXR0YWNobWVudBgBIAEoCzIZLmFwaS5zZXJ2ZXIudjEuQXR0YWNobWVudBIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIkYKF0RlbGV0ZUF0dGFjaG1lbnRSZXF1ZXN0EhQKDGNoYWxsZW5nZV9pZBgBIAEoCRIVCg1hdHRhY2htZW50X2lkGAIgASgJIjEKGERlbGV0ZUF0dGFjaG1lbnRSZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJIhcKFUdldEV2ZW50Q29uZmlnUmVxdWVzdCJhChZHZXRFdmVudENvbmZpZ1Jlc3BvbnNlEjAKDGV2ZW50X2NvbmZpZxgBIAEoCzIaLmFwaS5zZXJ2ZXIudjEuRXZlbnRDb25maWcSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSJMChhVcGRhdGVFdmVudENvbmZpZ1JlcXVlc3QSMAoMZXZlbnRfY29uZmlnGAEgASgLMhouYXBpLnNlcnZlci52MS5FdmVudENvbmZpZyIyChlVcGRhdGVFdmVudENvbmZpZ1Jlc3BvbnNlEhUKDWVycm9yX21lc3NhZ2UYASABKAkiNQobR2V0RmxhZ1NoYXJpbmdSZXBvcnRSZXF1ZXN0EhYKDndpbmRvd19zZWNvbmRzGAEgASgDImoKHEdldEZsYWdTaGFyaW5nUmVwb3J0UmVzcG9uc2USMwoIY2x1c3RlcnMYASADKAsyIS5hcGkuc2VydmVyLnYxLkZsYWdTaGFyaW5nQ2x1c3RlchIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIv4BChJGbGFnU2hhcmluZ0NsdXN0ZXISMAoGcmVhc29uGAEgASgOMiAuYXBpLnNlcnZlci52MS5GbGFnU2hhcmluZ1JlYXNvbhIUCgxjaGFsbGVuZ2VfaWQYAiABKAkSFgoOY2hhbGxlbmdlX25hbWUYAyABKAkSFgoOc3VibWl0dGVkX2ZsYWcYBCABKAkSGgoSZmlyc3Rfc3VibWl0dGVkX2F0GAUgASgDEhkKEWxhc3Rfc3VibWl0dGVkX2F0GAYgASgDEjkKC3N1Ym1pc3Npb25zGAcgAygLMiQuYXBpLnNlcnZlci52MS5GbGFnU2hhcmluZ1N1Ym1pc3Npb24ikAEKFUZsYWdTaGFyaW5nU3VibWlzc2lvbhIVCg1zdWJtaXNzaW9uX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEAoIdXNlcm5hbWUYAyABKAkSDwoHdGVhbV9pZBgEIAEoCRIWCg5zdWJtaXR0ZWRfZmxhZxgFIAEoCRIUCgxzdWJtaXR0ZWRfYXQYBiABKAMilgMKFkxpc3RTdWJtaXNzaW9uc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIUCgxjaGFsbGVuZ2VfaWQYAiABKAkSPAoGcmVzdWx0GAMgASgOMiwuYXBpLnNlcnZlci52MS5MaXN0U3VibWlzc2lvbnNSZXF1ZXN0LlJlc3VsdBINCgVzaW5jZRgEIAEoAxINCgV1bnRpbBgFIAEoAxI6CgVvcmRlchgGIAEoDjIrLmFwaS5zZXJ2ZXIudjEuTGlzdFN1Ym1pc3Npb25zUmVxdWVzdC5PcmRlchIOCgZjdXJzb3IYByABKAkSEQoJcGFnZV9zaXplGAggASgFIkoKBlJlc3VsdBIWChJSRVNVTFRfVU5TUEVDSUZJRUQQABISCg5SRVNVTFRfQ09SUkVDVBABEhQKEFJFU1VMVF9JTkNPUlJFQ1QQAiJOCgVPcmRlchIVChFPUkRFUl9VTlNQRUNJRklFRBAAEhYKEk9SREVSX05FV0VTVF9GSVJTVBABEhYKEk9SREVSX09MREVTVF9GSVJTVBACInoKF0xpc3RTdWJtaXNzaW9uc1Jlc3BvbnNlEjMKC3N1Ym1pc3Npb25zGAEgAygLMh4uYXBpLnNlcnZlci52MS5BZG1pblN1Ym1pc3Npb24SEwoLbmV4dF9jdXJzb3IYAiABKAkSFQoNZXJyb3JfbWVzc2FnZRgDIAEoCSKGAgoPQWRtaW5TdWJtaXNzaW9uEhUKDXN1Ym1pc3Npb25faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIQCgh1c2VybmFtZRgDIAEoCRIPCgd0ZWFtX2lkGAQgASgJEhQKDGNoYWxsZW5nZV9pZBgFIAEoCRIWCg5jaGFsbGVuZ2VfbmFtZRgGIAEoCRIWCg5zdWJtaXR0ZWRfZmxhZxgHIAEoCRIPCgdjb3JyZWN0GAggASgIEg8KB3BhcnRfaWQYCSABKAkSEgoKc29sdmVfcmFuaxgKIAEoBRIUCgxzdWJtaXR0ZWRfYXQYCyABKAMSFgoOaW52YWxpZGF0ZWRfYXQYDCABKAMiNAobSW52YWxpZGF0ZVN1Ym1pc3Npb25SZXF1ZXN0EhUKDXN1Ym1pc3Npb25faWQYASABKAkiNQocSW52YWxpZGF0ZVN1Ym1pc3Npb25SZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJIn0KCUFkbWluVXNlchIPCgd1c2VyX2lkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEiYKBHJvbGUYAyABKA4yGC5hcGkuc2VydmVyLnYxLlN0YWZmUm9sZRISCgpjcmVhdGVkX2F0GAQgASgDEhEKCWJhbm5lZF9hdBgFIAEoAyITChFMaXN0QWRtaW5zUmVxdWVzdCJVChJMaXN0QWRtaW5zUmVzcG9uc2USKAoGYWRtaW5zGAEgAygLMhguYXBpLnNlcnZlci52MS5BZG1pblVzZXISFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSJNChFHcmFudEFkbWluUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRImCgRyb2xlGAIgASgOMhguYXBpLnNlcnZlci52MS5TdGFmZlJvbGUiVAoSR3JhbnRBZG1pblJlc3BvbnNlEicKBWFkbWluGAEgASgLMhguYXBpLnNlcnZlci52MS5BZG1pblVzZXISFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSIlChJSZXZva2VBZG1pblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSIsChNSZXZva2VBZG1pblJlc3BvbnNlEhUKDWVycm9yX21lc3NhZ2UYASABKAkiRAoQTGlzdFVzZXJzUmVxdWVzdBINCgVxdWVyeRgBIAEoCRIOCgZjdXJzb3IYAiABKAkSEQoJcGFnZV9zaXplGAMgASgFImgKEUxpc3RVc2Vyc1Jlc3BvbnNlEicKBXVzZXJzGAEgAygLMhguYXBpLnNlcnZlci52MS5BZG1pblVzZXISEwoLbmV4dF9jdXJzb3IYAiABKAkSFQoNZXJyb3JfbWVzc2FnZRgDIAEoCSIhCg5HZXRVc2VyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIrEBCg9HZXRVc2VyUmVzcG9uc2USJgoEdXNlchgBIAEoCzIYLmFwaS5zZXJ2ZXIudjEuQWRtaW5Vc2VyEi4KBnNvbHZlcxgCIAMoCzIeLmFwaS5zZXJ2ZXIudjEuQWRtaW5TdWJtaXNzaW9uEi8KCWluc3RhbmNlcxgDIAMoCzIcLmFwaS5zZXJ2ZXIudjEuQWRtaW5JbnN0YW5jZRIVCg1lcnJvcl9tZXNzYWdlGAQgASgJIqYBCg1BZG1pbkluc3RhbmNlEhMKC2luc3RhbmNlX2lkGAEgASgJEhQKDGNoYWxsZW5nZV9pZBgCIAEoCRIWCg5jaGFsbGVuZ2VfbmFtZRgDIAEoCRIOCgZzdGF0dXMYBCABKAkSDAoEaG9zdBgFIAEoCRIMCgRwb3J0GAYgASgFEhIKCnN0YXJ0ZWRfYXQYByABKAMSEgoKZXhwaXJlc19hdBgIIAEoAyIhCg5CYW5Vc2VyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIigKD0JhblVzZXJSZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJIiMKEFVuYmFuVXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSIqChFVbmJhblVzZXJSZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJIisKGFJlc2V0VXNlclBhc3N3b3JkUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIk4KGVJlc2V0VXNlclBhc3N3b3JkUmVzcG9uc2USGgoSdGVtcG9yYXJ5X3Bhc3N3b3JkGAEgASgJEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiJAoRRGVsZXRlVXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSIrChJEZWxldGVVc2VyUmVzcG9uc2USFQoNZXJyb3JfbWVzc2FnZRgBIAEoCSJIChFDcmVhdGVIaW50UmVxdWVzdBIUCgxjaGFsbGVuZ2VfaWQYASABKAkSDwoHY29udGVudBgCIAEoCRIMCgRjb3N0GAMgASgFIjwKEkNyZWF0ZUhpbnRSZXNwb25zZRIPCgdoaW50X2lkGAEgASgJEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiNgoRVXBkYXRlSGludFJlcXVlc3QSIQoEaGludBgBIAEoCzITLmFwaS5zZXJ2ZXIudjEuSGludCIrChJVcGRhdGVIaW50UmVzcG9uc2USFQoNZXJyb3JfbWVzc2FnZRgBIAEoCSIkChFEZWxldGVIaW50UmVxdWVzdBIPCgdoaW50X2lkGAEgASgJIisKEkRlbGV0ZUhpbnRSZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJIigKEExpc3RIaW50c1JlcXVlc3QSFAoMY2hhbGxlbmdlX2lkGAEgASgJIk4KEUxpc3RIaW50c1Jlc3BvbnNlEiIKBWhpbnRzGAEgAygLMhMuYXBpLnNlcnZlci52MS5IaW50EhUKDWVycm9yX21lc3NhZ2UYAiABKAkiUQoZQ3JlYXRlQW5ub3VuY2VtZW50UmVxdWVzdBIUCgxjaGFsbGVuZ2VfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSDwoHY29udGVudBgDIAEoCSJMChpDcmVhdGVBbm5vdW5jZW1lbnRSZXNwb25zZRIXCg9hbm5vdW5jZW1lbnRfaWQYASABKAkSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSJOChlVcGRhdGVBbm5vdW5jZW1lbnRSZXF1ZXN0EjEKDGFubm91bmNlbWVudBgBIAEoCzIbLmFwaS5zZXJ2ZXIudjEuQW5ub3VuY2VtZW50IjMKGlVwZGF0ZUFubm91bmNlbWVudFJlc3BvbnNlEhUKDWVycm9yX21lc3NhZ2UYASABKAkiNAoZRGVsZXRlQW5ub3VuY2VtZW50UmVxdWVzdBIXCg9hbm5vdW5jZW1lbnRfaWQYASABKAkiMwoaRGVsZXRlQW5ub3VuY2VtZW50UmVzcG9uc2USFQoNZXJyb3JfbWVzc2FnZRgBIAEoCSIaChhMaXN0QW5ub3VuY2VtZW50c1JlcXVlc3QiZgoZTGlzdEFubm91bmNlbWVudHNSZXNwb25zZRIyCg1hbm5vdW5jZW1lbnRzGAEgAygLMhsuYXBpLnNlcnZlci52MS5Bbm5vdW5jZW1lbnQSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSLDAQoWTGlzdEF1ZGl0RXZlbnRzUmVxdWVzdBIQCghhY3Rvcl9pZBgBIAEoCRIOCgZhY3Rpb24YAiABKAkSMwoLdGFyZ2V0X3R5cGUYAyABKA4yHi5hcGkuc2VydmVyLnYxLkF1ZGl0VGFyZ2V0VHlwZRIRCgl0YXJnZXRfaWQYBCABKAkSDQoFc2luY2UYBSABKAMSDQoFdW50aWwYBiABKAMSDgoGY3Vyc29yGAcgASgJEhEKCXBhZ2Vfc2l6ZRgIIAEoBSJwChdMaXN0QXVkaXRFdmVudHNSZXNwb25zZRIpCgZldmVudHMYASADKAsyGS5hcGkuc2VydmVyLnYxLkF1ZGl0RXZlbnQSEwoLbmV4dF9jdXJzb3IYAiABKAkSFQoNZXJyb3JfbWVzc2FnZRgDIAEoCSKOAgoKQXVkaXRFdmVudBIWCg5hdWRpdF9ldmVudF9pZBgBIAEoCRIQCghhY3Rvcl9pZBgCIAEoCRISCgphY3Rvcl9uYW1lGAMgASgJEg4KBmFjdGlvbhgEIAEoCRIzCgt0YXJnZXRfdHlwZRgFIAEoDjIeLmFwaS5zZXJ2ZXIudjEuQXVkaXRUYXJnZXRUeXBlEhEKCXRhcmdldF9pZBgGIAEoCRIrCgdjaGFuZ2VzGAcgAygLMhouYXBpLnNlcnZlci52MS5BdWRpdENoYW5nZRIVCg1lcnJvcl9tZXNzYWdlGAggASgJEhEKCWNsaWVudF9pcBgJIAEoCRITCgtvY2N1cnJlZF9hdBgKIAEoAyI7CgtBdWRpdENoYW5nZRINCgVmaWVsZBgBIAEoCRIOCgZiZWZvcmUYAiABKAkSDQoFYWZ0ZXIYAyABKAkiJQoRQWRtaW5Mb2dpblJlcXVlc3QSEAoIcGFzc3dvcmQYASABKAkiFAoSQWRtaW5Mb2dpblJlc3BvbnNlIhQKEkFkbWluTG9nb3V0UmVxdWVzdCIVChNBZG1pbkxvZ291dFJlc3BvbnNlKpMBCgtCdWlsZFN0YXR1cxIcChhCVUlMRF9TVEFUVVNfVU5TUEVDSUZJRUQQABIYChRCVUlMRF9TVEFUVVNfUEVORElORxABEhkKFUJVSUxEX1NUQVRVU19CVUlMRElORxACEhgKFEJVSUxEX1NUQVRVU19TVUNDRVNTEAMSFwoTQlVJTERfU1RBVFVTX0ZBSUxFRBAEKokBChFGbGFnU2hhcmluZ1JlYXNvbhIjCh9GTEFHX1NIQVJJTkdfUkVBU09OX1VOU1BFQ0lGSUVEEAASKQolRkxBR19TSEFSSU5HX1JFQVNPTl9TQU1FX1dST05HX0FOU1dFUhABEiQKIEZMQUdfU0hBUklOR19SRUFTT05fQ0xPU0VfU09MVkVTEAIqcQoJU3RhZmZSb2xlEhoKFlNUQUZGX1JPTEVfVU5TUEVDSUZJRUQQABIZChVTVEFGRl9ST0xFX1NVUEVSQURNSU4QARIVChFTVEFGRl9ST0xFX0FVVEhPUhACEhYKElNUQUZGX1JPTEVfU1VQUE9SVBADKvcBCg9BdWRpdFRhcmdldFR5cGUSIQodQVVESVRfVEFSR0VUX1RZUEVfVU5TUEVDSUZJRUQQABIfChtBVURJVF9UQVJHRVRfVFlQRV9DSEFMTEVOR0UQARIaChZBVURJVF9UQVJHRVRfVFlQRV9ISU5UEAISIgoeQVVESVRfVEFSR0VUX1RZUEVfQU5OT1VOQ0VNRU5UEAMSIgoeQVVESVRfVEFSR0VUX1RZUEVfRVZFTlRfQ09ORklHEAQSIAocQVVESVRfVEFSR0VUX1RZUEVfU1VCTUlTU0lPThAFEhoKFkFVRElUX1RBUkdFVF9UWVBFX1VTRVIQBjKEGQoMQWRtaW5TZXJ2aWNlEmAKD0NyZWF0ZUNoYWxsZW5nZRIlLmFwaS5zZXJ2ZXIudjEuQ3JlYXRlQ2hhbGxlbmdlUmVxdWVzdBomLmFwaS5zZXJ2ZXIudjEuQ3JlYXRlQ2hhbGxlbmdlUmVzcG9uc2USYAoPVXBkYXRlQ2hhbGxlbmdlEiUuYXBpLnNlcnZlci52MS5VcGRhdGVDaGFsbGVuZ2VSZXF1ZXN0GiYuYXBpLnNlcnZlci52MS5VcGRhdGVDaGFsbGVuZ2VSZXNwb25zZRJvChRVcGxvYWRDaGFsbGVuZ2VJbWFnZRIqLmFwaS5zZXJ2ZXIudjEuVXBsb2FkQ2hhbGxlbmdlSW1hZ2VSZXF1ZXN0GisuYXBpLnNlcnZlci52MS5VcGxvYWRDaGFsbGVuZ2VJbWFnZVJlc3BvbnNlEmAKD0RlbGV0ZUNoYWxsZW5nZRIlLmFwaS5zZXJ2ZXIudjEuRGVsZXRlQ2hhbGxlbmdlUmVxdWVzdBomLmFwaS5zZXJ2ZXIudjEuRGVsZXRlQ2hhbGxlbmdlUmVzcG9uc2USXQoOTGlzdENoYWxsZW5nZXMSJC5hcGkuc2VydmVyLnYxLkxpc3RDaGFsbGVuZ2VzUmVxdWVzdBolLmFwaS5zZXJ2ZXIudjEuTGlzdENoYWxsZW5nZXNSZXNwb25zZRJXCgxHZXRDaGFsbGVuZ2USIi5hcGkuc2VydmVyLnYxLkdldENoYWxsZW5nZVJlcXVlc3QaIy5hcGkuc2VydmVyLnYxLkdldENoYWxsZW5nZVJlc3BvbnNlEloKDUxpc3RCdWlsZExvZ3MSIy5hcGkuc2VydmVyLnYxLkxpc3RCdWlsZExvZ3NSZXF1ZXN0GiQuYXBpLnNlcnZlci52MS5MaXN0QnVpbGRMb2dzUmVzcG9uc2USVAoLR2V0QnVpbGRMb2cSIS5hcGkuc2VydmVyLnYxLkdldEJ1aWxkTG9nUmVxdWVzdBoiLmFwaS5zZXJ2ZXIudjEuR2V0QnVpbGRMb2dSZXNwb25zZRJfCg5TdHJlYW1CdWlsZExvZxIkLmFwaS5zZXJ2ZXIudjEuU3RyZWFtQnVpbGRMb2dSZXF1ZXN0GiUuYXBpLnNlcnZlci52MS5TdHJlYW1CdWlsZExvZ1Jlc3BvbnNlMAESYwoQVXBsb2FkQXR0YWNobWVudBImLmFwaS5zZXJ2ZXIudjEuVXBsb2FkQXR0YWNobWVudFJlcXVlc3QaJy5hcGkuc2VydmVyLnYxLlVwbG9hZEF0dGFjaG1lbnRSZXNwb25zZRJjChBEZWxldGVBdHRhY2htZW50EiYuYXBpLnNlcnZlci52MS5EZWxldGVBdHRhY2htZW50UmVxdWVzdBonLmFwaS5zZXJ2ZXIudjEuRGVsZXRlQXR0YWNobWVudFJlc3BvbnNlEl0KDkdldEV2ZW50Q29uZmlnEiQuYXBpLnNlcnZlci52MS5HZXRFdmVudENvbmZpZ1JlcXVlc3QaJS5hcGkuc2VydmVyLnYxLkdldEV2ZW50Q29uZmlnUmVzcG9uc2USZgoRVXBkYXRlRXZlbnRDb25maWcSJy5hcGkuc2VydmVyLnYxLlVwZGF0ZUV2ZW50Q29uZmlnUmVxdWVzdBooLmFwaS5zZXJ2ZXIudjEuVXBkYXRlRXZlbnRDb25maWdSZXNwb25zZRJvChRHZXRGbGFnU2hhcmluZ1JlcG9ydBIqLmFwaS5zZXJ2ZXIudjEuR2V0RmxhZ1NoYXJpbmdSZXBvcnRSZXF1ZXN0GisuYXBpLnNlcnZlci52MS5HZXRGbGFnU2hhcmluZ1JlcG9ydFJlc3BvbnNlEmAKD0xpc3RTdWJtaXNzaW9ucxIlLmFwaS5zZXJ2ZXIudjEuTGlzdFN1Ym1pc3Npb25zUmVxdWVzdBomLmFwaS5zZXJ2ZXIudjEuTGlzdFN1Ym1pc3Npb25zUmVzcG9uc2USbwoUSW52YWxpZGF0ZVN1Ym1pc3Npb24SKi5hcGkuc2VydmVyLnYxLkludmFsaWRhdGVTdWJtaXNzaW9uUmVxdWVzdBorLmFwaS5zZXJ2ZXIudjEuSW52YWxpZGF0ZVN1Ym1pc3Npb25SZXNwb25zZRJRCgpMaXN0QWRtaW5zEiAuYXBpLnNlcnZlci52MS5MaXN0QWRtaW5zUmVxdWVzdBohLmFwaS5zZXJ2ZXIudjEuTGlzdEFkbWluc1Jlc3BvbnNlElEKCkdyYW50QWRtaW4SIC5hcGkuc2VydmVyLnYxLkdyYW50QWRtaW5SZXF1ZXN0GiEuYXBpLnNlcnZlci52MS5HcmFudEFkbWluUmVzcG9uc2USVAoLUmV2b2tlQWRtaW4SIS5hcGkuc2VydmVyLnYxLlJldm9rZUFkbWluUmVxdWVzdBoiLmFwaS5zZXJ2ZXIudjEuUmV2b2tlQWRtaW5SZXNwb25zZRJOCglMaXN0VXNlcnMSHy5hcGkuc2VydmVyLnYxLkxpc3RVc2Vyc1JlcXVlc3QaIC5hcGkuc2VydmVyLnYxLkxpc3RVc2Vyc1Jlc3BvbnNlEkgKB0dldFVzZXISHS5hcGkuc2VydmVyLnYxLkdldFVzZXJSZXF1ZXN0Gh4uYXBpLnNlcnZlci52MS5HZXRVc2VyUmVzcG9uc2USSAoHQmFuVXNlchIdLmFwaS5zZXJ2ZXIudjEuQmFuVXNlclJlcXVlc3QaHi5hcGkuc2VydmVyLnYxLkJhblVzZXJSZXNwb25zZRJOCglVbmJhblVzZXISHy5hcGkuc2VydmVyLnYxLlVuYmFuVXNlclJlcXVlc3QaIC5hcGkuc2VydmVyLnYxLlVuYmFuVXNlclJlc3BvbnNlEmYKEVJlc2V0VXNlclBhc3N3b3JkEicuYXBpLnNlcnZlci52MS5SZXNldFVzZXJQYXNzd29yZFJlcXVlc3QaKC5hcGkuc2VydmVyLnYxLlJlc2V0VXNlclBhc3N3b3JkUmVzcG9uc2USUQoKRGVsZXRlVXNlchIgLmFwaS5zZXJ2ZXIudjEuRGVsZXRlVXNlclJlcXVlc3QaIS5hcGkuc2VydmVyLnYxLkRlbGV0ZVVzZXJSZXNwb25zZRJRCgpDcmVhdGVIaW50EiAuYXBpLnNlcnZlci52MS5DcmVhdGVIaW50UmVxdWVzdBohLmFwaS5zZXJ2ZXIudjEuQ3JlYXRlSGludFJlc3BvbnNlElEKClVwZGF0ZUhpbnQSIC5hcGkuc2VydmVyLnYxLlVwZGF0ZUhpbnRSZXF1ZXN0GiEuYXBpLnNlcnZlci52MS5VcGRhdGVIaW50UmVzcG9uc2USUQoKRGVsZXRlSGludBIgLmFwaS5zZXJ2ZXIudjEuRGVsZXRlSGludFJlcXVlc3QaIS5hcGkuc2VydmVyLnYxLkRlbGV0ZUhpbnRSZXNwb25zZRJOCglMaXN0SGludHMSHy5hcGkuc2VydmVyLnYxLkxpc3RIaW50c1JlcXVlc3QaIC5hcGkuc2VydmVyLnYxLkxpc3RIaW50c1Jlc3BvbnNlEmkKEkNyZWF0ZUFubm91bmNlbWVudBIoLmFwaS5zZXJ2ZXIudjEuQ3JlYXRlQW5ub3VuY2VtZW50UmVxdWVzdBopLmFwaS5zZXJ2ZXIudjEuQ3JlYXRlQW5ub3VuY2VtZW50UmVzcG9uc2USaQoSVXBkYXRlQW5ub3VuY2VtZW50EiguYXBpLnNlcnZlci52MS5VcGRhdGVBbm5vdW5jZW1lbnRSZXF1ZXN0GikuYXBpLnNlcnZlci52MS5VcGRhdGVBbm5vdW5jZW1lbnRSZXNwb25zZRJpChJEZWxldGVBbm5vdW5jZW1lbnQSKC5hcGkuc2VydmVyLnYxLkRlbGV0ZUFubm91bmNlbWVudFJlcXVlc3QaKS5hcGkuc2VydmVyLnYxLkRlbGV0ZUFubm91bmNlbWVudFJlc3BvbnNlEmYKEUxpc3RBbm5vdW5jZW1lbnRzEicuYXBpLnNlcnZlci52MS5MaXN0QW5ub3VuY2VtZW50c1JlcXVlc3QaKC5hcGkuc2VydmVyLnYxLkxpc3RBbm5vdW5jZW1lbnRzUmVzcG9uc2USYAoPTGlzdEF1ZGl0RXZlbnRzEiUuYXBpLnNlcnZlci52MS5MaXN0QXVkaXRFdmVudHNSZXF1ZXN0GiYuYXBpLnNlcnZlci52MS5MaXN0QXVkaXRFdmVudHNSZXNwb25zZTK7AQoQQWRtaW5BdXRoU2VydmljZRJRCgpBZG1pbkxvZ2luEiAuYXBpLnNlcnZlci52MS5BZG1pbkxvZ2luUmVxdWVzdBohLmFwaS5zZXJ2ZXIudjEuQWRtaW5Mb2dpblJlc3BvbnNlElQKC0FkbWluTG9nb3V0EiEuYXBpLnNlcnZlci52MS5BZG1pbkxvZ291dFJlcXVlc3QaIi5hcGkuc2VydmVyLnYxLkFkbWluTG9nb3V0UmVzcG9uc2VCsQEKEWNvbS5hcGkuc2VydmVyLnYxQgpBZG1pblByb3RvUAFaOmdpdGh1Yi5jb20va2F2b3MxMTMvcXVpY2tjdGYvZ2VuL2dvL2FwaS9zZXJ2ZXIvdjE7c2VydmVydjGiAgNBU1iqAg1BcGkuU2VydmVyLlYxygINQXBpXFNlcnZlclxWMeICGUFwaVxTZXJ2ZXJcVjFcR1BCTWV0YWRhdGHqAg9BcGk6OlNlcnZlcjo6VjFiBnByb3RvMw", [file_api_server_v1_model]);
 
 /**
  * @generated from message api.server.v1.CreateChallengeRequest
@@ -498,11 +498,1440 @@ export type DeleteAttachmentResponse = Message<"api.server.v1.DeleteAttachmentRe
 export const DeleteAttachmentResponseSchema: GenMessage<DeleteAttachmentResponse> = /*@__PURE__*/
   messageDesc(file_api_server_v1_admin, 22);
 
+/**
+ * @generated from message api.server.v1.GetEventConfigRequest
+ */
+export type GetEventConfigRequest = Message<"api.server.v1.GetEventConfigRequest"> & {
+};
+
+/**
+ * Describes the message api.server.v1.GetEventConfigRequest.
+ * Use `create(GetEventConfigRequestSchema)` to create a new message.
+ */
+export const GetEventConfigRequestSchema: GenMessage<GetEventConfigRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 23);
+
+/**
+ * @generated from message api.server.v1.GetEventConfigResponse
+ */
+export type GetEventConfigResponse = Message<"api.server.v1.GetEventConfigResponse"> & {
+  /**
+   * @generated from field: api.server.v1.EventConfig event_config = 1;
+   */
+  eventConfig?: EventConfig;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.GetEventConfigResponse.
+ * Use `create(GetEventConfigResponseSchema)` to create a new message.
+ */
+export const GetEventConfigResponseSchema: GenMessage<GetEventConfigResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 24);
+
+/**
+ * @generated from message api.server.v1.UpdateEventConfigRequest
+ */
+export type UpdateEventConfigRequest = Message<"api.server.v1.UpdateEventConfigRequest"> & {
+  /**
+   * @generated from field: api.server.v1.EventConfig event_config = 1;
+   */
+  eventConfig?: EventConfig;
+};
+
+/**
+ * Describes the message api.server.v1.UpdateEventConfigRequest.
+ * Use `create(UpdateEventConfigRequestSchema)` to create a new message.
+ */
+export const UpdateEventConfigRequestSchema: GenMessage<UpdateEventConfigRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 25);
+
+/**
+ * @generated from message api.server.v1.UpdateEventConfigResponse
+ */
+export type UpdateEventConfigResponse = Message<"api.server.v1.UpdateEventConfigResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.UpdateEventConfigResponse.
+ * Use `create(UpdateEventConfigResponseSchema)` to create a new message.
+ */
+export const UpdateEventConfigResponseSchema: GenMessage<UpdateEventConfigResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 26);
+
+/**
+ * @generated from message api.server.v1.GetFlagSharingReportRequest
+ */
+export type GetFlagSharingReportRequest = Message<"api.server.v1.GetFlagSharingReportRequest"> & {
+  /**
+   * optional, solves within this many seconds are grouped (default 10)
+   *
+   * @generated from field: int64 window_seconds = 1;
+   */
+  windowSeconds: bigint;
+};
+
+/**
+ * Describes the message api.server.v1.GetFlagSharingReportRequest.
+ * Use `create(GetFlagSharingReportRequestSchema)` to create a new message.
+ */
+export const GetFlagSharingReportRequestSchema: GenMessage<GetFlagSharingReportRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 27);
+
+/**
+ * @generated from message api.server.v1.GetFlagSharingReportResponse
+ */
+export type GetFlagSharingReportResponse = Message<"api.server.v1.GetFlagSharingReportResponse"> & {
+  /**
+   * @generated from field: repeated api.server.v1.FlagSharingCluster clusters = 1;
+   */
+  clusters: FlagSharingCluster[];
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.GetFlagSharingReportResponse.
+ * Use `create(GetFlagSharingReportResponseSchema)` to create a new message.
+ */
+export const GetFlagSharingReportResponseSchema: GenMessage<GetFlagSharingReportResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 28);
+
+/**
+ * @generated from message api.server.v1.FlagSharingCluster
+ */
+export type FlagSharingCluster = Message<"api.server.v1.FlagSharingCluster"> & {
+  /**
+   * @generated from field: api.server.v1.FlagSharingReason reason = 1;
+   */
+  reason: FlagSharingReason;
+
+  /**
+   * @generated from field: string challenge_id = 2;
+   */
+  challengeId: string;
+
+  /**
+   * @generated from field: string challenge_name = 3;
+   */
+  challengeName: string;
+
+  /**
+   * set for FLAG_SHARING_REASON_SAME_WRONG_ANSWER
+   *
+   * @generated from field: string submitted_flag = 4;
+   */
+  submittedFlag: string;
+
+  /**
+   * @generated from field: int64 first_submitted_at = 5;
+   */
+  firstSubmittedAt: bigint;
+
+  /**
+   * @generated from field: int64 last_submitted_at = 6;
+   */
+  lastSubmittedAt: bigint;
+
+  /**
+   * @generated from field: repeated api.server.v1.FlagSharingSubmission submissions = 7;
+   */
+  submissions: FlagSharingSubmission[];
+};
+
+/**
+ * Describes the message api.server.v1.FlagSharingCluster.
+ * Use `create(FlagSharingClusterSchema)` to create a new message.
+ */
+export const FlagSharingClusterSchema: GenMessage<FlagSharingCluster> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 29);
+
+/**
+ * @generated from message api.server.v1.FlagSharingSubmission
+ */
+export type FlagSharingSubmission = Message<"api.server.v1.FlagSharingSubmission"> & {
+  /**
+   * @generated from field: string submission_id = 1;
+   */
+  submissionId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string team_id = 4;
+   */
+  teamId: string;
+
+  /**
+   * @generated from field: string submitted_flag = 5;
+   */
+  submittedFlag: string;
+
+  /**
+   * @generated from field: int64 submitted_at = 6;
+   */
+  submittedAt: bigint;
+};
+
+/**
+ * Describes the message api.server.v1.FlagSharingSubmission.
+ * Use `create(FlagSharingSubmissionSchema)` to create a new message.
+ */
+export const FlagSharingSubmissionSchema: GenMessage<FlagSharingSubmission> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 30);
+
+/**
+ * @generated from message api.server.v1.ListSubmissionsRequest
+ */
+export type ListSubmissionsRequest = Message<"api.server.v1.ListSubmissionsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string challenge_id = 2;
+   */
+  challengeId: string;
+
+  /**
+   * @generated from field: api.server.v1.ListSubmissionsRequest.Result result = 3;
+   */
+  result: ListSubmissionsRequest_Result;
+
+  /**
+   * unix seconds, inclusive
+   *
+   * @generated from field: int64 since = 4;
+   */
+  since: bigint;
+
+  /**
+   * unix seconds, exclusive
+   *
+   * @generated from field: int64 until = 5;
+   */
+  until: bigint;
+
+  /**
+   * @generated from field: api.server.v1.ListSubmissionsRequest.Order order = 6;
+   */
+  order: ListSubmissionsRequest_Order;
+
+  /**
+   * next_cursor of the previous page
+   *
+   * @generated from field: string cursor = 7;
+   */
+  cursor: string;
+
+  /**
+   * defaults to 50, at most 200
+   *
+   * @generated from field: int32 page_size = 8;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message api.server.v1.ListSubmissionsRequest.
+ * Use `create(ListSubmissionsRequestSchema)` to create a new message.
+ */
+export const ListSubmissionsRequestSchema: GenMessage<ListSubmissionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 31);
+
+/**
+ * @generated from enum api.server.v1.ListSubmissionsRequest.Result
+ */
+export enum ListSubmissionsRequest_Result {
+  /**
+   * both correct and incorrect
+   *
+   * @generated from enum value: RESULT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: RESULT_CORRECT = 1;
+   */
+  CORRECT = 1,
+
+  /**
+   * @generated from enum value: RESULT_INCORRECT = 2;
+   */
+  INCORRECT = 2,
+}
+
+/**
+ * Describes the enum api.server.v1.ListSubmissionsRequest.Result.
+ */
+export const ListSubmissionsRequest_ResultSchema: GenEnum<ListSubmissionsRequest_Result> = /*@__PURE__*/
+  enumDesc(file_api_server_v1_admin, 31, 0);
+
+/**
+ * @generated from enum api.server.v1.ListSubmissionsRequest.Order
+ */
+export enum ListSubmissionsRequest_Order {
+  /**
+   * newest first
+   *
+   * @generated from enum value: ORDER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ORDER_NEWEST_FIRST = 1;
+   */
+  NEWEST_FIRST = 1,
+
+  /**
+   * @generated from enum value: ORDER_OLDEST_FIRST = 2;
+   */
+  OLDEST_FIRST = 2,
+}
+
+/**
+ * Describes the enum api.server.v1.ListSubmissionsRequest.Order.
+ */
+export const ListSubmissionsRequest_OrderSchema: GenEnum<ListSubmissionsRequest_Order> = /*@__PURE__*/
+  enumDesc(file_api_server_v1_admin, 31, 1);
+
+/**
+ * @generated from message api.server.v1.ListSubmissionsResponse
+ */
+export type ListSubmissionsResponse = Message<"api.server.v1.ListSubmissionsResponse"> & {
+  /**
+   * @generated from field: repeated api.server.v1.AdminSubmission submissions = 1;
+   */
+  submissions: AdminSubmission[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor: string;
+
+  /**
+   * @generated from field: string error_message = 3;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.ListSubmissionsResponse.
+ * Use `create(ListSubmissionsResponseSchema)` to create a new message.
+ */
+export const ListSubmissionsResponseSchema: GenMessage<ListSubmissionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 32);
+
+/**
+ * @generated from message api.server.v1.AdminSubmission
+ */
+export type AdminSubmission = Message<"api.server.v1.AdminSubmission"> & {
+  /**
+   * @generated from field: string submission_id = 1;
+   */
+  submissionId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string team_id = 4;
+   */
+  teamId: string;
+
+  /**
+   * @generated from field: string challenge_id = 5;
+   */
+  challengeId: string;
+
+  /**
+   * @generated from field: string challenge_name = 6;
+   */
+  challengeName: string;
+
+  /**
+   * @generated from field: string submitted_flag = 7;
+   */
+  submittedFlag: string;
+
+  /**
+   * @generated from field: bool correct = 8;
+   */
+  correct: boolean;
+
+  /**
+   * @generated from field: string part_id = 9;
+   */
+  partId: string;
+
+  /**
+   * @generated from field: int32 solve_rank = 10;
+   */
+  solveRank: number;
+
+  /**
+   * @generated from field: int64 submitted_at = 11;
+   */
+  submittedAt: bigint;
+
+  /**
+   * 0 unless an admin invalidated the submission
+   *
+   * @generated from field: int64 invalidated_at = 12;
+   */
+  invalidatedAt: bigint;
+};
+
+/**
+ * Describes the message api.server.v1.AdminSubmission.
+ * Use `create(AdminSubmissionSchema)` to create a new message.
+ */
+export const AdminSubmissionSchema: GenMessage<AdminSubmission> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 33);
+
+/**
+ * @generated from message api.server.v1.InvalidateSubmissionRequest
+ */
+export type InvalidateSubmissionRequest = Message<"api.server.v1.InvalidateSubmissionRequest"> & {
+  /**
+   * @generated from field: string submission_id = 1;
+   */
+  submissionId: string;
+};
+
+/**
+ * Describes the message api.server.v1.InvalidateSubmissionRequest.
+ * Use `create(InvalidateSubmissionRequestSchema)` to create a new message.
+ */
+export const InvalidateSubmissionRequestSchema: GenMessage<InvalidateSubmissionRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 34);
+
+/**
+ * @generated from message api.server.v1.InvalidateSubmissionResponse
+ */
+export type InvalidateSubmissionResponse = Message<"api.server.v1.InvalidateSubmissionResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.InvalidateSubmissionResponse.
+ * Use `create(InvalidateSubmissionResponseSchema)` to create a new message.
+ */
+export const InvalidateSubmissionResponseSchema: GenMessage<InvalidateSubmissionResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 35);
+
+/**
+ * @generated from message api.server.v1.AdminUser
+ */
+export type AdminUser = Message<"api.server.v1.AdminUser"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: api.server.v1.StaffRole role = 3;
+   */
+  role: StaffRole;
+
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 created_at = 4;
+   */
+  createdAt: bigint;
+
+  /**
+   * 0 unless the user is banned
+   *
+   * @generated from field: int64 banned_at = 5;
+   */
+  bannedAt: bigint;
+};
+
+/**
+ * Describes the message api.server.v1.AdminUser.
+ * Use `create(AdminUserSchema)` to create a new message.
+ */
+export const AdminUserSchema: GenMessage<AdminUser> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 36);
+
+/**
+ * @generated from message api.server.v1.ListAdminsRequest
+ */
+export type ListAdminsRequest = Message<"api.server.v1.ListAdminsRequest"> & {
+};
+
+/**
+ * Describes the message api.server.v1.ListAdminsRequest.
+ * Use `create(ListAdminsRequestSchema)` to create a new message.
+ */
+export const ListAdminsRequestSchema: GenMessage<ListAdminsRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 37);
+
+/**
+ * @generated from message api.server.v1.ListAdminsResponse
+ */
+export type ListAdminsResponse = Message<"api.server.v1.ListAdminsResponse"> & {
+  /**
+   * @generated from field: repeated api.server.v1.AdminUser admins = 1;
+   */
+  admins: AdminUser[];
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.ListAdminsResponse.
+ * Use `create(ListAdminsResponseSchema)` to create a new message.
+ */
+export const ListAdminsResponseSchema: GenMessage<ListAdminsResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 38);
+
+/**
+ * GrantAdminRequest also changes the role of an existing staff member
+ *
+ * @generated from message api.server.v1.GrantAdminRequest
+ */
+export type GrantAdminRequest = Message<"api.server.v1.GrantAdminRequest"> & {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * @generated from field: api.server.v1.StaffRole role = 2;
+   */
+  role: StaffRole;
+};
+
+/**
+ * Describes the message api.server.v1.GrantAdminRequest.
+ * Use `create(GrantAdminRequestSchema)` to create a new message.
+ */
+export const GrantAdminRequestSchema: GenMessage<GrantAdminRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 39);
+
+/**
+ * @generated from message api.server.v1.GrantAdminResponse
+ */
+export type GrantAdminResponse = Message<"api.server.v1.GrantAdminResponse"> & {
+  /**
+   * @generated from field: api.server.v1.AdminUser admin = 1;
+   */
+  admin?: AdminUser;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.GrantAdminResponse.
+ * Use `create(GrantAdminResponseSchema)` to create a new message.
+ */
+export const GrantAdminResponseSchema: GenMessage<GrantAdminResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 40);
+
+/**
+ * @generated from message api.server.v1.RevokeAdminRequest
+ */
+export type RevokeAdminRequest = Message<"api.server.v1.RevokeAdminRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.server.v1.RevokeAdminRequest.
+ * Use `create(RevokeAdminRequestSchema)` to create a new message.
+ */
+export const RevokeAdminRequestSchema: GenMessage<RevokeAdminRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 41);
+
+/**
+ * @generated from message api.server.v1.RevokeAdminResponse
+ */
+export type RevokeAdminResponse = Message<"api.server.v1.RevokeAdminResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.RevokeAdminResponse.
+ * Use `create(RevokeAdminResponseSchema)` to create a new message.
+ */
+export const RevokeAdminResponseSchema: GenMessage<RevokeAdminResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 42);
+
+/**
+ * @generated from message api.server.v1.ListUsersRequest
+ */
+export type ListUsersRequest = Message<"api.server.v1.ListUsersRequest"> & {
+  /**
+   * matches part of the username
+   *
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * next_cursor of the previous page
+   *
+   * @generated from field: string cursor = 2;
+   */
+  cursor: string;
+
+  /**
+   * defaults to 50, at most 200
+   *
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message api.server.v1.ListUsersRequest.
+ * Use `create(ListUsersRequestSchema)` to create a new message.
+ */
+export const ListUsersRequestSchema: GenMessage<ListUsersRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 43);
+
+/**
+ * @generated from message api.server.v1.ListUsersResponse
+ */
+export type ListUsersResponse = Message<"api.server.v1.ListUsersResponse"> & {
+  /**
+   * newest first
+   *
+   * @generated from field: repeated api.server.v1.AdminUser users = 1;
+   */
+  users: AdminUser[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor: string;
+
+  /**
+   * @generated from field: string error_message = 3;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.ListUsersResponse.
+ * Use `create(ListUsersResponseSchema)` to create a new message.
+ */
+export const ListUsersResponseSchema: GenMessage<ListUsersResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 44);
+
+/**
+ * @generated from message api.server.v1.GetUserRequest
+ */
+export type GetUserRequest = Message<"api.server.v1.GetUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.server.v1.GetUserRequest.
+ * Use `create(GetUserRequestSchema)` to create a new message.
+ */
+export const GetUserRequestSchema: GenMessage<GetUserRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 45);
+
+/**
+ * @generated from message api.server.v1.GetUserResponse
+ */
+export type GetUserResponse = Message<"api.server.v1.GetUserResponse"> & {
+  /**
+   * @generated from field: api.server.v1.AdminUser user = 1;
+   */
+  user?: AdminUser;
+
+  /**
+   * @generated from field: repeated api.server.v1.AdminSubmission solves = 2;
+   */
+  solves: AdminSubmission[];
+
+  /**
+   * @generated from field: repeated api.server.v1.AdminInstance instances = 3;
+   */
+  instances: AdminInstance[];
+
+  /**
+   * @generated from field: string error_message = 4;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.GetUserResponse.
+ * Use `create(GetUserResponseSchema)` to create a new message.
+ */
+export const GetUserResponseSchema: GenMessage<GetUserResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 46);
+
+/**
+ * @generated from message api.server.v1.AdminInstance
+ */
+export type AdminInstance = Message<"api.server.v1.AdminInstance"> & {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId: string;
+
+  /**
+   * @generated from field: string challenge_id = 2;
+   */
+  challengeId: string;
+
+  /**
+   * @generated from field: string challenge_name = 3;
+   */
+  challengeName: string;
+
+  /**
+   * running or stopped
+   *
+   * @generated from field: string status = 4;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string host = 5;
+   */
+  host: string;
+
+  /**
+   * @generated from field: int32 port = 6;
+   */
+  port: number;
+
+  /**
+   * @generated from field: int64 started_at = 7;
+   */
+  startedAt: bigint;
+
+  /**
+   * @generated from field: int64 expires_at = 8;
+   */
+  expiresAt: bigint;
+};
+
+/**
+ * Describes the message api.server.v1.AdminInstance.
+ * Use `create(AdminInstanceSchema)` to create a new message.
+ */
+export const AdminInstanceSchema: GenMessage<AdminInstance> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 47);
+
+/**
+ * BanUserRequest blocks login, signs the user out and hides the user from scoreboards
+ * Staff must have their role revoked first
+ *
+ * @generated from message api.server.v1.BanUserRequest
+ */
+export type BanUserRequest = Message<"api.server.v1.BanUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.server.v1.BanUserRequest.
+ * Use `create(BanUserRequestSchema)` to create a new message.
+ */
+export const BanUserRequestSchema: GenMessage<BanUserRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 48);
+
+/**
+ * @generated from message api.server.v1.BanUserResponse
+ */
+export type BanUserResponse = Message<"api.server.v1.BanUserResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.BanUserResponse.
+ * Use `create(BanUserResponseSchema)` to create a new message.
+ */
+export const BanUserResponseSchema: GenMessage<BanUserResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 49);
+
+/**
+ * @generated from message api.server.v1.UnbanUserRequest
+ */
+export type UnbanUserRequest = Message<"api.server.v1.UnbanUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.server.v1.UnbanUserRequest.
+ * Use `create(UnbanUserRequestSchema)` to create a new message.
+ */
+export const UnbanUserRequestSchema: GenMessage<UnbanUserRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 50);
+
+/**
+ * @generated from message api.server.v1.UnbanUserResponse
+ */
+export type UnbanUserResponse = Message<"api.server.v1.UnbanUserResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.UnbanUserResponse.
+ * Use `create(UnbanUserResponseSchema)` to create a new message.
+ */
+export const UnbanUserResponseSchema: GenMessage<UnbanUserResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 51);
+
+/**
+ * ResetUserPasswordRequest replaces the password with a generated one and signs the user out
+ *
+ * @generated from message api.server.v1.ResetUserPasswordRequest
+ */
+export type ResetUserPasswordRequest = Message<"api.server.v1.ResetUserPasswordRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.server.v1.ResetUserPasswordRequest.
+ * Use `create(ResetUserPasswordRequestSchema)` to create a new message.
+ */
+export const ResetUserPasswordRequestSchema: GenMessage<ResetUserPasswordRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 52);
+
+/**
+ * @generated from message api.server.v1.ResetUserPasswordResponse
+ */
+export type ResetUserPasswordResponse = Message<"api.server.v1.ResetUserPasswordResponse"> & {
+  /**
+   * @generated from field: string temporary_password = 1;
+   */
+  temporaryPassword: string;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.ResetUserPasswordResponse.
+ * Use `create(ResetUserPasswordResponseSchema)` to create a new message.
+ */
+export const ResetUserPasswordResponseSchema: GenMessage<ResetUserPasswordResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 53);
+
+/**
+ * DeleteUserRequest destroys the user's instances and revokes their solves before deleting the account
+ * Staff must have their role revoked first
+ *
+ * @generated from message api.server.v1.DeleteUserRequest
+ */
+export type DeleteUserRequest = Message<"api.server.v1.DeleteUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.server.v1.DeleteUserRequest.
+ * Use `create(DeleteUserRequestSchema)` to create a new message.
+ */
+export const DeleteUserRequestSchema: GenMessage<DeleteUserRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 54);
+
+/**
+ * @generated from message api.server.v1.DeleteUserResponse
+ */
+export type DeleteUserResponse = Message<"api.server.v1.DeleteUserResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.DeleteUserResponse.
+ * Use `create(DeleteUserResponseSchema)` to create a new message.
+ */
+export const DeleteUserResponseSchema: GenMessage<DeleteUserResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 55);
+
+/**
+ * @generated from message api.server.v1.CreateHintRequest
+ */
+export type CreateHintRequest = Message<"api.server.v1.CreateHintRequest"> & {
+  /**
+   * @generated from field: string challenge_id = 1;
+   */
+  challengeId: string;
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content: string;
+
+  /**
+   * @generated from field: int32 cost = 3;
+   */
+  cost: number;
+};
+
+/**
+ * Describes the message api.server.v1.CreateHintRequest.
+ * Use `create(CreateHintRequestSchema)` to create a new message.
+ */
+export const CreateHintRequestSchema: GenMessage<CreateHintRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 56);
+
+/**
+ * @generated from message api.server.v1.CreateHintResponse
+ */
+export type CreateHintResponse = Message<"api.server.v1.CreateHintResponse"> & {
+  /**
+   * @generated from field: string hint_id = 1;
+   */
+  hintId: string;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.CreateHintResponse.
+ * Use `create(CreateHintResponseSchema)` to create a new message.
+ */
+export const CreateHintResponseSchema: GenMessage<CreateHintResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 57);
+
+/**
+ * @generated from message api.server.v1.UpdateHintRequest
+ */
+export type UpdateHintRequest = Message<"api.server.v1.UpdateHintRequest"> & {
+  /**
+   * @generated from field: api.server.v1.Hint hint = 1;
+   */
+  hint?: Hint;
+};
+
+/**
+ * Describes the message api.server.v1.UpdateHintRequest.
+ * Use `create(UpdateHintRequestSchema)` to create a new message.
+ */
+export const UpdateHintRequestSchema: GenMessage<UpdateHintRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 58);
+
+/**
+ * @generated from message api.server.v1.UpdateHintResponse
+ */
+export type UpdateHintResponse = Message<"api.server.v1.UpdateHintResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.UpdateHintResponse.
+ * Use `create(UpdateHintResponseSchema)` to create a new message.
+ */
+export const UpdateHintResponseSchema: GenMessage<UpdateHintResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 59);
+
+/**
+ * @generated from message api.server.v1.DeleteHintRequest
+ */
+export type DeleteHintRequest = Message<"api.server.v1.DeleteHintRequest"> & {
+  /**
+   * @generated from field: string hint_id = 1;
+   */
+  hintId: string;
+};
+
+/**
+ * Describes the message api.server.v1.DeleteHintRequest.
+ * Use `create(DeleteHintRequestSchema)` to create a new message.
+ */
+export const DeleteHintRequestSchema: GenMessage<DeleteHintRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 60);
+
+/**
+ * @generated from message api.server.v1.DeleteHintResponse
+ */
+export type DeleteHintResponse = Message<"api.server.v1.DeleteHintResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.DeleteHintResponse.
+ * Use `create(DeleteHintResponseSchema)` to create a new message.
+ */
+export const DeleteHintResponseSchema: GenMessage<DeleteHintResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 61);
+
+/**
+ * @generated from message api.server.v1.ListHintsRequest
+ */
+export type ListHintsRequest = Message<"api.server.v1.ListHintsRequest"> & {
+  /**
+   * @generated from field: string challenge_id = 1;
+   */
+  challengeId: string;
+};
+
+/**
+ * Describes the message api.server.v1.ListHintsRequest.
+ * Use `create(ListHintsRequestSchema)` to create a new message.
+ */
+export const ListHintsRequestSchema: GenMessage<ListHintsRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 62);
+
+/**
+ * @generated from message api.server.v1.ListHintsResponse
+ */
+export type ListHintsResponse = Message<"api.server.v1.ListHintsResponse"> & {
+  /**
+   * @generated from field: repeated api.server.v1.Hint hints = 1;
+   */
+  hints: Hint[];
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.ListHintsResponse.
+ * Use `create(ListHintsResponseSchema)` to create a new message.
+ */
+export const ListHintsResponseSchema: GenMessage<ListHintsResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 63);
+
+/**
+ * @generated from message api.server.v1.CreateAnnouncementRequest
+ */
+export type CreateAnnouncementRequest = Message<"api.server.v1.CreateAnnouncementRequest"> & {
+  /**
+   * empty for an event-wide announcement
+   *
+   * @generated from field: string challenge_id = 1;
+   */
+  challengeId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string content = 3;
+   */
+  content: string;
+};
+
+/**
+ * Describes the message api.server.v1.CreateAnnouncementRequest.
+ * Use `create(CreateAnnouncementRequestSchema)` to create a new message.
+ */
+export const CreateAnnouncementRequestSchema: GenMessage<CreateAnnouncementRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 64);
+
+/**
+ * @generated from message api.server.v1.CreateAnnouncementResponse
+ */
+export type CreateAnnouncementResponse = Message<"api.server.v1.CreateAnnouncementResponse"> & {
+  /**
+   * @generated from field: string announcement_id = 1;
+   */
+  announcementId: string;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.CreateAnnouncementResponse.
+ * Use `create(CreateAnnouncementResponseSchema)` to create a new message.
+ */
+export const CreateAnnouncementResponseSchema: GenMessage<CreateAnnouncementResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 65);
+
+/**
+ * @generated from message api.server.v1.UpdateAnnouncementRequest
+ */
+export type UpdateAnnouncementRequest = Message<"api.server.v1.UpdateAnnouncementRequest"> & {
+  /**
+   * @generated from field: api.server.v1.Announcement announcement = 1;
+   */
+  announcement?: Announcement;
+};
+
+/**
+ * Describes the message api.server.v1.UpdateAnnouncementRequest.
+ * Use `create(UpdateAnnouncementRequestSchema)` to create a new message.
+ */
+export const UpdateAnnouncementRequestSchema: GenMessage<UpdateAnnouncementRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 66);
+
+/**
+ * @generated from message api.server.v1.UpdateAnnouncementResponse
+ */
+export type UpdateAnnouncementResponse = Message<"api.server.v1.UpdateAnnouncementResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.UpdateAnnouncementResponse.
+ * Use `create(UpdateAnnouncementResponseSchema)` to create a new message.
+ */
+export const UpdateAnnouncementResponseSchema: GenMessage<UpdateAnnouncementResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 67);
+
+/**
+ * @generated from message api.server.v1.DeleteAnnouncementRequest
+ */
+export type DeleteAnnouncementRequest = Message<"api.server.v1.DeleteAnnouncementRequest"> & {
+  /**
+   * @generated from field: string announcement_id = 1;
+   */
+  announcementId: string;
+};
+
+/**
+ * Describes the message api.server.v1.DeleteAnnouncementRequest.
+ * Use `create(DeleteAnnouncementRequestSchema)` to create a new message.
+ */
+export const DeleteAnnouncementRequestSchema: GenMessage<DeleteAnnouncementRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 68);
+
+/**
+ * @generated from message api.server.v1.DeleteAnnouncementResponse
+ */
+export type DeleteAnnouncementResponse = Message<"api.server.v1.DeleteAnnouncementResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.DeleteAnnouncementResponse.
+ * Use `create(DeleteAnnouncementResponseSchema)` to create a new message.
+ */
+export const DeleteAnnouncementResponseSchema: GenMessage<DeleteAnnouncementResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 69);
+
+/**
+ * @generated from message api.server.v1.ListAnnouncementsRequest
+ */
+export type ListAnnouncementsRequest = Message<"api.server.v1.ListAnnouncementsRequest"> & {
+};
+
+/**
+ * Describes the message api.server.v1.ListAnnouncementsRequest.
+ * Use `create(ListAnnouncementsRequestSchema)` to create a new message.
+ */
+export const ListAnnouncementsRequestSchema: GenMessage<ListAnnouncementsRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 70);
+
+/**
+ * @generated from message api.server.v1.ListAnnouncementsResponse
+ */
+export type ListAnnouncementsResponse = Message<"api.server.v1.ListAnnouncementsResponse"> & {
+  /**
+   * @generated from field: repeated api.server.v1.Announcement announcements = 1;
+   */
+  announcements: Announcement[];
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.ListAnnouncementsResponse.
+ * Use `create(ListAnnouncementsResponseSchema)` to create a new message.
+ */
+export const ListAnnouncementsResponseSchema: GenMessage<ListAnnouncementsResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 71);
+
+/**
+ * @generated from message api.server.v1.ListAuditEventsRequest
+ */
+export type ListAuditEventsRequest = Message<"api.server.v1.ListAuditEventsRequest"> & {
+  /**
+   * @generated from field: string actor_id = 1;
+   */
+  actorId: string;
+
+  /**
+   * AdminService method name such as UpdateChallenge
+   *
+   * @generated from field: string action = 2;
+   */
+  action: string;
+
+  /**
+   * unspecified matches every type
+   *
+   * @generated from field: api.server.v1.AuditTargetType target_type = 3;
+   */
+  targetType: AuditTargetType;
+
+  /**
+   * @generated from field: string target_id = 4;
+   */
+  targetId: string;
+
+  /**
+   * unix seconds, inclusive
+   *
+   * @generated from field: int64 since = 5;
+   */
+  since: bigint;
+
+  /**
+   * unix seconds, exclusive
+   *
+   * @generated from field: int64 until = 6;
+   */
+  until: bigint;
+
+  /**
+   * next_cursor of the previous page
+   *
+   * @generated from field: string cursor = 7;
+   */
+  cursor: string;
+
+  /**
+   * defaults to 50, at most 200
+   *
+   * @generated from field: int32 page_size = 8;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message api.server.v1.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
+ */
+export const ListAuditEventsRequestSchema: GenMessage<ListAuditEventsRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 72);
+
+/**
+ * @generated from message api.server.v1.ListAuditEventsResponse
+ */
+export type ListAuditEventsResponse = Message<"api.server.v1.ListAuditEventsResponse"> & {
+  /**
+   * newest first
+   *
+   * @generated from field: repeated api.server.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor: string;
+
+  /**
+   * @generated from field: string error_message = 3;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export const ListAuditEventsResponseSchema: GenMessage<ListAuditEventsResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 73);
+
+/**
+ * @generated from message api.server.v1.AuditEvent
+ */
+export type AuditEvent = Message<"api.server.v1.AuditEvent"> & {
+  /**
+   * @generated from field: string audit_event_id = 1;
+   */
+  auditEventId: string;
+
+  /**
+   * @generated from field: string actor_id = 2;
+   */
+  actorId: string;
+
+  /**
+   * @generated from field: string actor_name = 3;
+   */
+  actorName: string;
+
+  /**
+   * @generated from field: string action = 4;
+   */
+  action: string;
+
+  /**
+   * @generated from field: api.server.v1.AuditTargetType target_type = 5;
+   */
+  targetType: AuditTargetType;
+
+  /**
+   * @generated from field: string target_id = 6;
+   */
+  targetId: string;
+
+  /**
+   * @generated from field: repeated api.server.v1.AuditChange changes = 7;
+   */
+  changes: AuditChange[];
+
+  /**
+   * set when the call failed
+   *
+   * @generated from field: string error_message = 8;
+   */
+  errorMessage: string;
+
+  /**
+   * @generated from field: string client_ip = 9;
+   */
+  clientIp: string;
+
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 occurred_at = 10;
+   */
+  occurredAt: bigint;
+};
+
+/**
+ * Describes the message api.server.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
+ */
+export const AuditEventSchema: GenMessage<AuditEvent> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 74);
+
+/**
+ * AuditChange holds the values of a field before and after the call
+ * Flag values and password hashes are replaced with "[REDACTED]"
+ *
+ * @generated from message api.server.v1.AuditChange
+ */
+export type AuditChange = Message<"api.server.v1.AuditChange"> & {
+  /**
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * @generated from field: string before = 2;
+   */
+  before: string;
+
+  /**
+   * @generated from field: string after = 3;
+   */
+  after: string;
+};
+
+/**
+ * Describes the message api.server.v1.AuditChange.
+ * Use `create(AuditChangeSchema)` to create a new message.
+ */
+export const AuditChangeSchema: GenMessage<AuditChange> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_admin, 75);
+
 /**
  * @generated from message api.server.v1.AdminLoginRequest
  */
 export type AdminLoginRequest = Message<"api.server.v1.AdminLoginRequest"> & {
   /**
+   * activation code, only needed to make the first admin while no admin account exists
+   *
    * @generated from field: string password = 1;
    */
   password: string;
@@ -513,7 +1942,7 @@ export type AdminLoginRequest = Message<"api.server.v1.AdminLoginRequest"> & {
  * Use `create(AdminLoginRequestSchema)` to create a new message.
  */
 export const AdminLoginRequestSchema: GenMessage<AdminLoginRequest> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_admin, 23);
+  messageDesc(file_api_server_v1_admin, 76);
 
 /**
  * @generated from message api.server.v1.AdminLoginResponse
@@ -526,7 +1955,7 @@ export type AdminLoginResponse = Message<"api.server.v1.AdminLoginResponse"> & {
  * Use `create(AdminLoginResponseSchema)` to create a new message.
  */
 export const AdminLoginResponseSchema: GenMessage<AdminLoginResponse> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_admin, 24);
+  messageDesc(file_api_server_v1_admin, 77);
 
 /**
  * @generated from message api.server.v1.AdminLogoutRequest
@@ -539,7 +1968,7 @@ export type AdminLogoutRequest = Message<"api.server.v1.AdminLogoutRequest"> & {
  * Use `create(AdminLogoutRequestSchema)` to create a new message.
  */
 export const AdminLogoutRequestSchema: GenMessage<AdminLogoutRequest> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_admin, 25);
+  messageDesc(file_api_server_v1_admin, 78);
 
 /**
  * @generated from message api.server.v1.AdminLogoutResponse
@@ -552,7 +1981,7 @@ export type AdminLogoutResponse = Message<"api.server.v1.AdminLogoutResponse"> &
  * Use `create(AdminLogoutResponseSchema)` to create a new message.
  */
 export const AdminLogoutResponseSchema: GenMessage<AdminLogoutResponse> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_admin, 26);
+  messageDesc(file_api_server_v1_admin, 79);
 
 /**
  * @generated from enum api.server.v1.BuildStatus
@@ -590,6 +2019,115 @@ export enum BuildStatus {
 export const BuildStatusSchema: GenEnum<BuildStatus> = /*@__PURE__*/
   enumDesc(file_api_server_v1_admin, 0);
 
+/**
+ * @generated from enum api.server.v1.FlagSharingReason
+ */
+export enum FlagSharingReason {
+  /**
+   * @generated from enum value: FLAG_SHARING_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FLAG_SHARING_REASON_SAME_WRONG_ANSWER = 1;
+   */
+  SAME_WRONG_ANSWER = 1,
+
+  /**
+   * @generated from enum value: FLAG_SHARING_REASON_CLOSE_SOLVES = 2;
+   */
+  CLOSE_SOLVES = 2,
+}
+
+/**
+ * Describes the enum api.server.v1.FlagSharingReason.
+ */
+export const FlagSharingReasonSchema: GenEnum<FlagSharingReason> = /*@__PURE__*/
+  enumDesc(file_api_server_v1_admin, 1);
+
+/**
+ * @generated from enum api.server.v1.StaffRole
+ */
+export enum StaffRole {
+  /**
+   * @generated from enum value: STAFF_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * full access
+   *
+   * @generated from enum value: STAFF_ROLE_SUPERADMIN = 1;
+   */
+  SUPERADMIN = 1,
+
+  /**
+   * manages only the challenges they created
+   *
+   * @generated from enum value: STAFF_ROLE_AUTHOR = 2;
+   */
+  AUTHOR = 2,
+
+  /**
+   * views users and submissions
+   *
+   * @generated from enum value: STAFF_ROLE_SUPPORT = 3;
+   */
+  SUPPORT = 3,
+}
+
+/**
+ * Describes the enum api.server.v1.StaffRole.
+ */
+export const StaffRoleSchema: GenEnum<StaffRole> = /*@__PURE__*/
+  enumDesc(file_api_server_v1_admin, 2);
+
+/**
+ * @generated from enum api.server.v1.AuditTargetType
+ */
+export enum AuditTargetType {
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_CHALLENGE = 1;
+   */
+  CHALLENGE = 1,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_HINT = 2;
+   */
+  HINT = 2,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_ANNOUNCEMENT = 3;
+   */
+  ANNOUNCEMENT = 3,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_EVENT_CONFIG = 4;
+   */
+  EVENT_CONFIG = 4,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_SUBMISSION = 5;
+   */
+  SUBMISSION = 5,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_USER = 6;
+   */
+  USER = 6,
+}
+
+/**
+ * Describes the enum api.server.v1.AuditTargetType.
+ */
+export const AuditTargetTypeSchema: GenEnum<AuditTargetType> = /*@__PURE__*/
+  enumDesc(file_api_server_v1_admin, 3);
+
 /**
  * @generated from service api.server.v1.AdminService
  */
@@ -682,6 +2220,190 @@ export const AdminService: GenService<{
     input: typeof DeleteAttachmentRequestSchema;
     output: typeof DeleteAttachmentResponseSchema;
   },
+  /**
+   * @generated from rpc api.server.v1.AdminService.GetEventConfig
+   */
+  getEventConfig: {
+    methodKind: "unary";
+    input: typeof GetEventConfigRequestSchema;
+    output: typeof GetEventConfigResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.UpdateEventConfig
+   */
+  updateEventConfig: {
+    methodKind: "unary";
+    input: typeof UpdateEventConfigRequestSchema;
+    output: typeof UpdateEventConfigResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.GetFlagSharingReport
+   */
+  getFlagSharingReport: {
+    methodKind: "unary";
+    input: typeof GetFlagSharingReportRequestSchema;
+    output: typeof GetFlagSharingReportResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.ListSubmissions
+   */
+  listSubmissions: {
+    methodKind: "unary";
+    input: typeof ListSubmissionsRequestSchema;
+    output: typeof ListSubmissionsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.InvalidateSubmission
+   */
+  invalidateSubmission: {
+    methodKind: "unary";
+    input: typeof InvalidateSubmissionRequestSchema;
+    output: typeof InvalidateSubmissionResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.ListAdmins
+   */
+  listAdmins: {
+    methodKind: "unary";
+    input: typeof ListAdminsRequestSchema;
+    output: typeof ListAdminsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.GrantAdmin
+   */
+  grantAdmin: {
+    methodKind: "unary";
+    input: typeof GrantAdminRequestSchema;
+    output: typeof GrantAdminResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.RevokeAdmin
+   */
+  revokeAdmin: {
+    methodKind: "unary";
+    input: typeof RevokeAdminRequestSchema;
+    output: typeof RevokeAdminResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.ListUsers
+   */
+  listUsers: {
+    methodKind: "unary";
+    input: typeof ListUsersRequestSchema;
+    output: typeof ListUsersResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.GetUser
+   */
+  getUser: {
+    methodKind: "unary";
+    input: typeof GetUserRequestSchema;
+    output: typeof GetUserResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.BanUser
+   */
+  banUser: {
+    methodKind: "unary";
+    input: typeof BanUserRequestSchema;
+    output: typeof BanUserResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.UnbanUser
+   */
+  unbanUser: {
+    methodKind: "unary";
+    input: typeof UnbanUserRequestSchema;
+    output: typeof UnbanUserResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.ResetUserPassword
+   */
+  resetUserPassword: {
+    methodKind: "unary";
+    input: typeof ResetUserPasswordRequestSchema;
+    output: typeof ResetUserPasswordResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.DeleteUser
+   */
+  deleteUser: {
+    methodKind: "unary";
+    input: typeof DeleteUserRequestSchema;
+    output: typeof DeleteUserResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.CreateHint
+   */
+  createHint: {
+    methodKind: "unary";
+    input: typeof CreateHintRequestSchema;
+    output: typeof CreateHintResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.UpdateHint
+   */
+  updateHint: {
+    methodKind: "unary";
+    input: typeof UpdateHintRequestSchema;
+    output: typeof UpdateHintResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.DeleteHint
+   */
+  deleteHint: {
+    methodKind: "unary";
+    input: typeof DeleteHintRequestSchema;
+    output: typeof DeleteHintResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.ListHints
+   */
+  listHints: {
+    methodKind: "unary";
+    input: typeof ListHintsRequestSchema;
+    output: typeof ListHintsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.CreateAnnouncement
+   */
+  createAnnouncement: {
+    methodKind: "unary";
+    input: typeof CreateAnnouncementRequestSchema;
+    output: typeof CreateAnnouncementResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.UpdateAnnouncement
+   */
+  updateAnnouncement: {
+    methodKind: "unary";
+    input: typeof UpdateAnnouncementRequestSchema;
+    output: typeof UpdateAnnouncementResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.DeleteAnnouncement
+   */
+  deleteAnnouncement: {
+    methodKind: "unary";
+    input: typeof DeleteAnnouncementRequestSchema;
+    output: typeof DeleteAnnouncementResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.ListAnnouncements
+   */
+  listAnnouncements: {
+    methodKind: "unary";
+    input: typeof ListAnnouncementsRequestSchema;
+    output: typeof ListAnnouncementsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.AdminService.ListAuditEvents
+   */
+  listAuditEvents: {
+    methodKind: "unary";
+    input: typeof ListAuditEventsRequestSchema;
+    output: typeof ListAuditEventsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_server_v1_admin, 0);
 
@@ -4,7 +4,7 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Challenge, Submission } from "./model_pb";
+import type { Announcement, Challenge, Hint, LiveEvent, ScoreHistory, ScoreboardEntry, Submission, Team } from "./model_pb";
 import { file_api_server_v1_model } from "./model_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/server/v1/client.proto.
  */
 export const file_api_server_v1_client: GenFile = /*@__PURE__*/
-  fileDesc("ChphcGkvc2VydmVyL3YxL2NsaWVudC5wcm90bxINYXBpLnNlcnZlci52MSIWChRHZXRDaGFsbGVuZ2VzUmVxdWVzdCJcChVHZXRDaGFsbGVuZ2VzUmVzcG9uc2USLAoKY2hhbGxlbmdlcxgBIAMoCzIYLmFwaS5zZXJ2ZXIudjEuQ2hhbGxlbmdlEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiQgoRU3VibWl0RmxhZ1JlcXVlc3QSLQoKc3VibWlzc2lvbhgBIAEoCzIZLmFwaS5zZXJ2ZXIudjEuU3VibWlzc2lvbiJUChJTdWJtaXRGbGFnUmVzcG9uc2USDwoHY29ycmVjdBgBIAEoCBIWCg5wb2ludHNfYXdhcmRlZBgCIAEoBRIVCg1lcnJvcl9tZXNzYWdlGAMgASgJIiwKFFN0YXJ0SW5zdGFuY2VSZXF1ZXN0EhQKDGNoYWxsZW5nZV9pZBgBIAEoCSJKChVTdGFydEluc3RhbmNlUmVzcG9uc2USDAoEaG9zdBgBIAEoCRIMCgRwb3J0GAIgASgFEhUKDWVycm9yX21lc3NhZ2UYAyABKAkiKwoTU3RvcEluc3RhbmNlUmVxdWVzdBIUCgxjaGFsbGVuZ2VfaWQYASABKAkiLQoUU3RvcEluc3RhbmNlUmVzcG9uc2USFQoNZXJyb3JfbWVzc2FnZRgBIAEoCSIwChhHZXRJbnN0YW5jZVN0YXR1c1JlcXVlc3QSFAoMY2hhbGxlbmdlX2lkGAEgASgJIu8BChlHZXRJbnN0YW5jZVN0YXR1c1Jlc3BvbnNlEj8KBnN0YXR1cxgBIAEoDjIvLmFwaS5zZXJ2ZXIudjEuR2V0SW5zdGFuY2VTdGF0dXNSZXNwb25zZS5TdGF0dXMSDAoEaG9zdBgCIAEoCRIMCgRwb3J0GAMgASgFEhUKDWVycm9yX21lc3NhZ2UYBCABKAkiXgoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEhIKDlNUQVRVU19SVU5OSU5HEAESEgoOU1RBVFVTX1NUT1BQRUQQAhIUChBTVEFUVVNfREVTVFJPWUVEEAMiMgoMTG9naW5SZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJIjUKDUxvZ2luUmVzcG9uc2USDQoFdG9rZW4YASABKAkSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSI1Cg9SZWdpc3RlclJlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkSEAoIcGFzc3dvcmQYAiABKAkiOgoQUmVnaXN0ZXJSZXNwb25zZRIPCgd1c2VyX2lkGAEgASgJEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiHgoNTG9nb3V0UmVxdWVzdBINCgV0b2tlbhgBIAEoCSInCg5Mb2dvdXRSZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJMuQDChZDbGllbnRDaGFsbGVuZ2VTZXJ2aWNlEloKDUdldENoYWxsZW5nZXMSIy5hcGkuc2VydmVyLnYxLkdldENoYWxsZW5nZXNSZXF1ZXN0GiQuYXBpLnNlcnZlci52MS5HZXRDaGFsbGVuZ2VzUmVzcG9uc2USUQoKU3VibWl0RmxhZxIgLmFwaS5zZXJ2ZXIudjEuU3VibWl0RmxhZ1JlcXVlc3QaIS5hcGkuc2VydmVyLnYxLlN1Ym1pdEZsYWdSZXNwb25zZRJaCg1TdGFydEluc3RhbmNlEiMuYXBpLnNlcnZlci52MS5TdGFydEluc3RhbmNlUmVxdWVzdBokLmFwaS5zZXJ2ZXIudjEuU3RhcnRJbnN0YW5jZVJlc3BvbnNlElcKDFN0b3BJbnN0YW5jZRIiLmFwaS5zZXJ2ZXIudjEuU3RvcEluc3RhbmNlUmVxdWVzdBojLmFwaS5zZXJ2ZXIudjEuU3RvcEluc3RhbmNlUmVzcG9uc2USZgoRR2V0SW5zdGFuY2VTdGF0dXMSJy5hcGkuc2VydmVyLnYxLkdldEluc3RhbmNlU3RhdHVzUmVxdWVzdBooLmFwaS5zZXJ2ZXIudjEuR2V0SW5zdGFuY2VTdGF0dXNSZXNwb25zZTLpAQoPVXNlckF1dGhTZXJ2aWNlEkIKBUxvZ2luEhsuYXBpLnNlcnZlci52MS5Mb2dpblJlcXVlc3QaHC5hcGkuc2VydmVyLnYxLkxvZ2luUmVzcG9uc2USSwoIUmVnaXN0ZXISHi5hcGkuc2VydmVyLnYxLlJlZ2lzdGVyUmVxdWVzdBofLmFwaS5zZXJ2ZXIudjEuUmVnaXN0ZXJSZXNwb25zZRJFCgZMb2dvdXQSHC5hcGkuc2VydmVyLnYxLkxvZ291dFJlcXVlc3QaHS5hcGkuc2VydmVyLnYxLkxvZ291dFJlc3BvbnNlQrIBChFjb20uYXBpLnNlcnZlci52MUILQ2xpZW50UHJvdG9QAVo6Z2l0aHViLmNvbS9rYXZvczExMy9xdWlja2N0Zi9nZW4vZ28vYXBpL3NlcnZlci92MTtzZXJ2ZXJ2MaICA0FTWKoCDUFwaS5TZXJ2ZXIuVjHKAg1BcGlcU2VydmVyXFYx4gIZQXBpXFNlcnZlclxWMVxHUEJNZXRhZGF0YeoCD0FwaTo6U2VydmVyOjpWMWIGcHJvdG8z", [file_api_server_v1_model]);
+  fileDesc("ChphcGkvc2VydmVyL3YxL2NsaWVudC5wcm90bxINYXBpLnNlcnZlci52MSIWChRHZXRDaGFsbGVuZ2VzUmVxdWVzdCJcChVHZXRDaGFsbGVuZ2VzUmVzcG9uc2USLAoKY2hhbGxlbmdlcxgBIAMoCzIYLmFwaS5zZXJ2ZXIudjEuQ2hhbGxlbmdlEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiQgoRU3VibWl0RmxhZ1JlcXVlc3QSLQoKc3VibWlzc2lvbhgBIAEoCzIZLmFwaS5zZXJ2ZXIudjEuU3VibWlzc2lvbiJxChJTdWJtaXRGbGFnUmVzcG9uc2USDwoHY29ycmVjdBgBIAEoCBIWCg5wb2ludHNfYXdhcmRlZBgCIAEoBRIVCg1lcnJvcl9tZXNzYWdlGAMgASgJEhsKE3JldHJ5X2FmdGVyX3NlY29uZHMYBCABKAUiFgoUR2V0U2NvcmVib2FyZFJlcXVlc3QibwoVR2V0U2NvcmVib2FyZFJlc3BvbnNlEi8KB2VudHJpZXMYASADKAsyHi5hcGkuc2VydmVyLnYxLlNjb3JlYm9hcmRFbnRyeRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJEg4KBmZyb3plbhgDIAEoCCIlChZHZXRTY29yZUhpc3RvcnlSZXF1ZXN0EgsKA3RvcBgBIAEoBSJwChdHZXRTY29yZUhpc3RvcnlSZXNwb25zZRIuCgloaXN0b3JpZXMYASADKAsyGy5hcGkuc2VydmVyLnYxLlNjb3JlSGlzdG9yeRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJEg4KBmZyb3plbhgDIAEoCCIsChRTdGFydEluc3RhbmNlUmVxdWVzdBIUCgxjaGFsbGVuZ2VfaWQYASABKAkiSgoVU3RhcnRJbnN0YW5jZVJlc3BvbnNlEgwKBGhvc3QYASABKAkSDAoEcG9ydBgCIAEoBRIVCg1lcnJvcl9tZXNzYWdlGAMgASgJIisKE1N0b3BJbnN0YW5jZVJlcXVlc3QSFAoMY2hhbGxlbmdlX2lkGAEgASgJIi0KFFN0b3BJbnN0YW5jZVJlc3BvbnNlEhUKDWVycm9yX21lc3NhZ2UYASABKAkiMAoYR2V0SW5zdGFuY2VTdGF0dXNSZXF1ZXN0EhQKDGNoYWxsZW5nZV9pZBgBIAEoCSLvAQoZR2V0SW5zdGFuY2VTdGF0dXNSZXNwb25zZRI/CgZzdGF0dXMYASABKA4yLy5hcGkuc2VydmVyLnYxLkdldEluc3RhbmNlU3RhdHVzUmVzcG9uc2UuU3RhdHVzEgwKBGhvc3QYAiABKAkSDAoEcG9ydBgDIAEoBRIVCg1lcnJvcl9tZXNzYWdlGAQgASgJIl4KBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABISCg5TVEFUVVNfUlVOTklORxABEhIKDlNUQVRVU19TVE9QUEVEEAISFAoQU1RBVFVTX0RFU1RST1lFRBADIicKD0dldEhpbnRzUmVxdWVzdBIUCgxjaGFsbGVuZ2VfaWQYASABKAkiTQoQR2V0SGludHNSZXNwb25zZRIiCgVoaW50cxgBIAMoCzITLmFwaS5zZXJ2ZXIudjEuSGludBIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIiQKEVVubG9ja0hpbnRSZXF1ZXN0Eg8KB2hpbnRfaWQYASABKAkiTgoSVW5sb2NrSGludFJlc3BvbnNlEiEKBGhpbnQYASABKAsyEy5hcGkuc2VydmVyLnYxLkhpbnQSFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSIZChdHZXRBbm5vdW5jZW1lbnRzUmVxdWVzdCJlChhHZXRBbm5vdW5jZW1lbnRzUmVzcG9uc2USMgoNYW5ub3VuY2VtZW50cxgBIAMoCzIbLmFwaS5zZXJ2ZXIudjEuQW5ub3VuY2VtZW50EhUKDWVycm9yX21lc3NhZ2UYAiABKAkiFQoTU3RyZWFtRXZlbnRzUmVxdWVzdCI/ChRTdHJlYW1FdmVudHNSZXNwb25zZRInCgVldmVudBgBIAEoCzIYLmFwaS5zZXJ2ZXIudjEuTGl2ZUV2ZW50IiEKEUNyZWF0ZVRlYW1SZXF1ZXN0EgwKBG5hbWUYASABKAkiTgoSQ3JlYXRlVGVhbVJlc3BvbnNlEiEKBHRlYW0YASABKAsyEy5hcGkuc2VydmVyLnYxLlRlYW0SFQoNZXJyb3JfbWVzc2FnZRgCIAEoCSImCg9Kb2luVGVhbVJlcXVlc3QSEwoLaW52aXRlX2NvZGUYASABKAkiTAoQSm9pblRlYW1SZXNwb25zZRIhCgR0ZWFtGAEgASgLMhMuYXBpLnNlcnZlci52MS5UZWFtEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiEgoQTGVhdmVUZWFtUmVxdWVzdCIqChFMZWF2ZVRlYW1SZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJIhIKEEdldE15VGVhbVJlcXVlc3QiTQoRR2V0TXlUZWFtUmVzcG9uc2USIQoEdGVhbRgBIAEoCzITLmFwaS5zZXJ2ZXIudjEuVGVhbRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIjIKDExvZ2luUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSI1Cg1Mb2dpblJlc3BvbnNlEg0KBXRva2VuGAEgASgJEhUKDWVycm9yX21lc3NhZ2UYAiABKAkiNQoPUmVnaXN0ZXJSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJIjoKEFJlZ2lzdGVyUmVzcG9uc2USDwoHdXNlcl9pZBgBIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIh4KDUxvZ291dFJlcXVlc3QSDQoFdG9rZW4YASABKAkiJwoOTG9nb3V0UmVzcG9uc2USFQoNZXJyb3JfbWVzc2FnZRgBIAEoCSJ7CgtVc2VyUHJvZmlsZRIPCgd1c2VyX2lkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEg0KBWVtYWlsGAMgASgJEhQKDGRpc3BsYXlfbmFtZRgEIAEoCRITCgthZmZpbGlhdGlvbhgFIAEoCRIPCgdjb3VudHJ5GAYgASgJIhMKEUdldFByb2ZpbGVSZXF1ZXN0IlgKEkdldFByb2ZpbGVSZXNwb25zZRIrCgdwcm9maWxlGAEgASgLMhouYXBpLnNlcnZlci52MS5Vc2VyUHJvZmlsZRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJImEKFFVwZGF0ZVByb2ZpbGVSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRITCgthZmZpbGlhdGlvbhgDIAEoCRIPCgdjb3VudHJ5GAQgASgJIlsKFVVwZGF0ZVByb2ZpbGVSZXNwb25zZRIrCgdwcm9maWxlGAEgASgLMhouYXBpLnNlcnZlci52MS5Vc2VyUHJvZmlsZRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJIkcKFUNoYW5nZVBhc3N3b3JkUmVxdWVzdBIYChBjdXJyZW50X3Bhc3N3b3JkGAEgASgJEhQKDG5ld19wYXNzd29yZBgCIAEoCSIvChZDaGFuZ2VQYXNzd29yZFJlc3BvbnNlEhUKDWVycm9yX21lc3NhZ2UYASABKAkygggKFkNsaWVudENoYWxsZW5nZVNlcnZpY2USWgoNR2V0Q2hhbGxlbmdlcxIjLmFwaS5zZXJ2ZXIudjEuR2V0Q2hhbGxlbmdlc1JlcXVlc3QaJC5hcGkuc2VydmVyLnYxLkdldENoYWxsZW5nZXNSZXNwb25zZRJRCgpTdWJtaXRGbGFnEiAuYXBpLnNlcnZlci52MS5TdWJtaXRGbGFnUmVxdWVzdBohLmFwaS5zZXJ2ZXIudjEuU3VibWl0RmxhZ1Jlc3BvbnNlEloKDUdldFNjb3JlYm9hcmQSIy5hcGkuc2VydmVyLnYxLkdldFNjb3JlYm9hcmRSZXF1ZXN0GiQuYXBpLnNlcnZlci52MS5HZXRTY29yZWJvYXJkUmVzcG9uc2USYAoPR2V0U2NvcmVIaXN0b3J5EiUuYXBpLnNlcnZlci52MS5HZXRTY29yZUhpc3RvcnlSZXF1ZXN0GiYuYXBpLnNlcnZlci52MS5HZXRTY29yZUhpc3RvcnlSZXNwb25zZRJLCghHZXRIaW50cxIeLmFwaS5zZXJ2ZXIudjEuR2V0SGludHNSZXF1ZXN0Gh8uYXBpLnNlcnZlci52MS5HZXRIaW50c1Jlc3BvbnNlElEKClVubG9ja0hpbnQSIC5hcGkuc2VydmVyLnYxLlVubG9ja0hpbnRSZXF1ZXN0GiEuYXBpLnNlcnZlci52MS5VbmxvY2tIaW50UmVzcG9uc2USYwoQR2V0QW5ub3VuY2VtZW50cxImLmFwaS5zZXJ2ZXIudjEuR2V0QW5ub3VuY2VtZW50c1JlcXVlc3QaJy5hcGkuc2VydmVyLnYxLkdldEFubm91bmNlbWVudHNSZXNwb25zZRJZCgxTdHJlYW1FdmVudHMSIi5hcGkuc2VydmVyLnYxLlN0cmVhbUV2ZW50c1JlcXVlc3QaIy5hcGkuc2VydmVyLnYxLlN0cmVhbUV2ZW50c1Jlc3BvbnNlMAESWgoNU3RhcnRJbnN0YW5jZRIjLmFwaS5zZXJ2ZXIudjEuU3RhcnRJbnN0YW5jZVJlcXVlc3QaJC5hcGkuc2VydmVyLnYxLlN0YXJ0SW5zdGFuY2VSZXNwb25zZRJXCgxTdG9wSW5zdGFuY2USIi5hcGkuc2VydmVyLnYxLlN0b3BJbnN0YW5jZVJlcXVlc3QaIy5hcGkuc2VydmVyLnYxLlN0b3BJbnN0YW5jZVJlc3BvbnNlEmYKEUdldEluc3RhbmNlU3RhdHVzEicuYXBpLnNlcnZlci52MS5HZXRJbnN0YW5jZVN0YXR1c1JlcXVlc3QaKC5hcGkuc2VydmVyLnYxLkdldEluc3RhbmNlU3RhdHVzUmVzcG9uc2UyzQIKC1RlYW1TZXJ2aWNlElEKCkNyZWF0ZVRlYW0SIC5hcGkuc2VydmVyLnYxLkNyZWF0ZVRlYW1SZXF1ZXN0GiEuYXBpLnNlcnZlci52MS5DcmVhdGVUZWFtUmVzcG9uc2USSwoISm9pblRlYW0SHi5hcGkuc2VydmVyLnYxLkpvaW5UZWFtUmVxdWVzdBofLmFwaS5zZXJ2ZXIudjEuSm9pblRlYW1SZXNwb25zZRJOCglMZWF2ZVRlYW0SHy5hcGkuc2VydmVyLnYxLkxlYXZlVGVhbVJlcXVlc3QaIC5hcGkuc2VydmVyLnYxLkxlYXZlVGVhbVJlc3BvbnNlEk4KCUdldE15VGVhbRIfLmFwaS5zZXJ2ZXIudjEuR2V0TXlUZWFtUmVxdWVzdBogLmFwaS5zZXJ2ZXIudjEuR2V0TXlUZWFtUmVzcG9uc2Uy9wMKD1VzZXJBdXRoU2VydmljZRJCCgVMb2dpbhIbLmFwaS5zZXJ2ZXIudjEuTG9naW5SZXF1ZXN0GhwuYXBpLnNlcnZlci52MS5Mb2dpblJlc3BvbnNlEksKCFJlZ2lzdGVyEh4uYXBpLnNlcnZlci52MS5SZWdpc3RlclJlcXVlc3QaHy5hcGkuc2VydmVyLnYxLlJlZ2lzdGVyUmVzcG9uc2USRQoGTG9nb3V0EhwuYXBpLnNlcnZlci52MS5Mb2dvdXRSZXF1ZXN0Gh0uYXBpLnNlcnZlci52MS5Mb2dvdXRSZXNwb25zZRJRCgpHZXRQcm9maWxlEiAuYXBpLnNlcnZlci52MS5HZXRQcm9maWxlUmVxdWVzdBohLmFwaS5zZXJ2ZXIudjEuR2V0UHJvZmlsZVJlc3BvbnNlEloKDVVwZGF0ZVByb2ZpbGUSIy5hcGkuc2VydmVyLnYxLlVwZGF0ZVByb2ZpbGVSZXF1ZXN0GiQuYXBpLnNlcnZlci52MS5VcGRhdGVQcm9maWxlUmVzcG9uc2USXQoOQ2hhbmdlUGFzc3dvcmQSJC5hcGkuc2VydmVyLnYxLkNoYW5nZVBhc3N3b3JkUmVxdWVzdBolLmFwaS5zZXJ2ZXIudjEuQ2hhbmdlUGFzc3dvcmRSZXNwb25zZUKyAQoRY29tLmFwaS5zZXJ2ZXIudjFCC0NsaWVudFByb3RvUAFaOmdpdGh1Yi5jb20va2F2b3MxMTMvcXVpY2tjdGYvZ2VuL2dvL2FwaS9zZXJ2ZXIvdjE7c2VydmVydjGiAgNBU1iqAg1BcGkuU2VydmVyLlYxygINQXBpXFNlcnZlclxWMeICGUFwaVxTZXJ2ZXJcVjFcR1BCTWV0YWRhdGHqAg9BcGk6OlNlcnZlcjo6VjFiBnByb3RvMw", [file_api_server_v1_model]);
 
 /**
  * @generated from message api.server.v1.GetChallengesRequest
@@ -84,6 +84,13 @@ export type SubmitFlagResponse = Message<"api.server.v1.SubmitFlagResponse"> & {
    * @generated from field: string error_message = 3;
    */
   errorMessage: string;
+
+  /**
+   * set when the submission was rate limited
+   *
+   * @generated from field: int32 retry_after_seconds = 4;
+   */
+  retryAfterSeconds: number;
 };
 
 /**
@@ -93,6 +100,92 @@ export type SubmitFlagResponse = Message<"api.server.v1.SubmitFlagResponse"> & {
 export const SubmitFlagResponseSchema: GenMessage<SubmitFlagResponse> = /*@__PURE__*/
   messageDesc(file_api_server_v1_client, 3);
 
+/**
+ * @generated from message api.server.v1.GetScoreboardRequest
+ */
+export type GetScoreboardRequest = Message<"api.server.v1.GetScoreboardRequest"> & {
+};
+
+/**
+ * Describes the message api.server.v1.GetScoreboardRequest.
+ * Use `create(GetScoreboardRequestSchema)` to create a new message.
+ */
+export const GetScoreboardRequestSchema: GenMessage<GetScoreboardRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 4);
+
+/**
+ * @generated from message api.server.v1.GetScoreboardResponse
+ */
+export type GetScoreboardResponse = Message<"api.server.v1.GetScoreboardResponse"> & {
+  /**
+   * @generated from field: repeated api.server.v1.ScoreboardEntry entries = 1;
+   */
+  entries: ScoreboardEntry[];
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+
+  /**
+   * @generated from field: bool frozen = 3;
+   */
+  frozen: boolean;
+};
+
+/**
+ * Describes the message api.server.v1.GetScoreboardResponse.
+ * Use `create(GetScoreboardResponseSchema)` to create a new message.
+ */
+export const GetScoreboardResponseSchema: GenMessage<GetScoreboardResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 5);
+
+/**
+ * @generated from message api.server.v1.GetScoreHistoryRequest
+ */
+export type GetScoreHistoryRequest = Message<"api.server.v1.GetScoreHistoryRequest"> & {
+  /**
+   * number of top competitors, defaults to 10 when 0
+   *
+   * @generated from field: int32 top = 1;
+   */
+  top: number;
+};
+
+/**
+ * Describes the message api.server.v1.GetScoreHistoryRequest.
+ * Use `create(GetScoreHistoryRequestSchema)` to create a new message.
+ */
+export const GetScoreHistoryRequestSchema: GenMessage<GetScoreHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 6);
+
+/**
+ * @generated from message api.server.v1.GetScoreHistoryResponse
+ */
+export type GetScoreHistoryResponse = Message<"api.server.v1.GetScoreHistoryResponse"> & {
+  /**
+   * @generated from field: repeated api.server.v1.ScoreHistory histories = 1;
+   */
+  histories: ScoreHistory[];
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+
+  /**
+   * @generated from field: bool frozen = 3;
+   */
+  frozen: boolean;
+};
+
+/**
+ * Describes the message api.server.v1.GetScoreHistoryResponse.
+ * Use `create(GetScoreHistoryResponseSchema)` to create a new message.
+ */
+export const GetScoreHistoryResponseSchema: GenMessage<GetScoreHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 7);
+
 /**
  * @generated from message api.server.v1.StartInstanceRequest
  */
@@ -108,7 +201,7 @@ export type StartInstanceRequest = Message<"api.server.v1.StartInstanceRequest">
  * Use `create(StartInstanceRequestSchema)` to create a new message.
  */
 export const StartInstanceRequestSchema: GenMessage<StartInstanceRequest> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 4);
+  messageDesc(file_api_server_v1_client, 8);
 
 /**
  * @generated from message api.server.v1.StartInstanceResponse
@@ -135,7 +228,7 @@ export type StartInstanceResponse = Message<"api.server.v1.StartInstanceResponse
  * Use `create(StartInstanceResponseSchema)` to create a new message.
  */
 export const StartInstanceResponseSchema: GenMessage<StartInstanceResponse> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 5);
+  messageDesc(file_api_server_v1_client, 9);
 
 /**
  * @generated from message api.server.v1.StopInstanceRequest
@@ -152,7 +245,7 @@ export type StopInstanceRequest = Message<"api.server.v1.StopInstanceRequest"> &
  * Use `create(StopInstanceRequestSchema)` to create a new message.
  */
 export const StopInstanceRequestSchema: GenMessage<StopInstanceRequest> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 6);
+  messageDesc(file_api_server_v1_client, 10);
 
 /**
  * @generated from message api.server.v1.StopInstanceResponse
@@ -169,7 +262,7 @@ export type StopInstanceResponse = Message<"api.server.v1.StopInstanceResponse">
  * Use `create(StopInstanceResponseSchema)` to create a new message.
  */
 export const StopInstanceResponseSchema: GenMessage<StopInstanceResponse> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 7);
+  messageDesc(file_api_server_v1_client, 11);
 
 /**
  * @generated from message api.server.v1.GetInstanceStatusRequest
@@ -186,7 +279,7 @@ export type GetInstanceStatusRequest = Message<"api.server.v1.GetInstanceStatusR
  * Use `create(GetInstanceStatusRequestSchema)` to create a new message.
  */
 export const GetInstanceStatusRequestSchema: GenMessage<GetInstanceStatusRequest> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 8);
+  messageDesc(file_api_server_v1_client, 12);
 
 /**
  * @generated from message api.server.v1.GetInstanceStatusResponse
@@ -218,7 +311,7 @@ export type GetInstanceStatusResponse = Message<"api.server.v1.GetInstanceStatus
  * Use `create(GetInstanceStatusResponseSchema)` to create a new message.
  */
 export const GetInstanceStatusResponseSchema: GenMessage<GetInstanceStatusResponse> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 9);
+  messageDesc(file_api_server_v1_client, 13);
 
 /**
  * @generated from enum api.server.v1.GetInstanceStatusResponse.Status
@@ -249,7 +342,293 @@ export enum GetInstanceStatusResponse_Status {
  * Describes the enum api.server.v1.GetInstanceStatusResponse.Status.
  */
 export const GetInstanceStatusResponse_StatusSchema: GenEnum<GetInstanceStatusResponse_Status> = /*@__PURE__*/
-  enumDesc(file_api_server_v1_client, 9, 0);
+  enumDesc(file_api_server_v1_client, 13, 0);
+
+/**
+ * @generated from message api.server.v1.GetHintsRequest
+ */
+export type GetHintsRequest = Message<"api.server.v1.GetHintsRequest"> & {
+  /**
+   * @generated from field: string challenge_id = 1;
+   */
+  challengeId: string;
+};
+
+/**
+ * Describes the message api.server.v1.GetHintsRequest.
+ * Use `create(GetHintsRequestSchema)` to create a new message.
+ */
+export const GetHintsRequestSchema: GenMessage<GetHintsRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 14);
+
+/**
+ * @generated from message api.server.v1.GetHintsResponse
+ */
+export type GetHintsResponse = Message<"api.server.v1.GetHintsResponse"> & {
+  /**
+   * @generated from field: repeated api.server.v1.Hint hints = 1;
+   */
+  hints: Hint[];
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.GetHintsResponse.
+ * Use `create(GetHintsResponseSchema)` to create a new message.
+ */
+export const GetHintsResponseSchema: GenMessage<GetHintsResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 15);
+
+/**
+ * @generated from message api.server.v1.UnlockHintRequest
+ */
+export type UnlockHintRequest = Message<"api.server.v1.UnlockHintRequest"> & {
+  /**
+   * @generated from field: string hint_id = 1;
+   */
+  hintId: string;
+};
+
+/**
+ * Describes the message api.server.v1.UnlockHintRequest.
+ * Use `create(UnlockHintRequestSchema)` to create a new message.
+ */
+export const UnlockHintRequestSchema: GenMessage<UnlockHintRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 16);
+
+/**
+ * @generated from message api.server.v1.UnlockHintResponse
+ */
+export type UnlockHintResponse = Message<"api.server.v1.UnlockHintResponse"> & {
+  /**
+   * @generated from field: api.server.v1.Hint hint = 1;
+   */
+  hint?: Hint;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.UnlockHintResponse.
+ * Use `create(UnlockHintResponseSchema)` to create a new message.
+ */
+export const UnlockHintResponseSchema: GenMessage<UnlockHintResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 17);
+
+/**
+ * @generated from message api.server.v1.GetAnnouncementsRequest
+ */
+export type GetAnnouncementsRequest = Message<"api.server.v1.GetAnnouncementsRequest"> & {
+};
+
+/**
+ * Describes the message api.server.v1.GetAnnouncementsRequest.
+ * Use `create(GetAnnouncementsRequestSchema)` to create a new message.
+ */
+export const GetAnnouncementsRequestSchema: GenMessage<GetAnnouncementsRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 18);
+
+/**
+ * @generated from message api.server.v1.GetAnnouncementsResponse
+ */
+export type GetAnnouncementsResponse = Message<"api.server.v1.GetAnnouncementsResponse"> & {
+  /**
+   * @generated from field: repeated api.server.v1.Announcement announcements = 1;
+   */
+  announcements: Announcement[];
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.GetAnnouncementsResponse.
+ * Use `create(GetAnnouncementsResponseSchema)` to create a new message.
+ */
+export const GetAnnouncementsResponseSchema: GenMessage<GetAnnouncementsResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 19);
+
+/**
+ * @generated from message api.server.v1.StreamEventsRequest
+ */
+export type StreamEventsRequest = Message<"api.server.v1.StreamEventsRequest"> & {
+};
+
+/**
+ * Describes the message api.server.v1.StreamEventsRequest.
+ * Use `create(StreamEventsRequestSchema)` to create a new message.
+ */
+export const StreamEventsRequestSchema: GenMessage<StreamEventsRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 20);
+
+/**
+ * @generated from message api.server.v1.StreamEventsResponse
+ */
+export type StreamEventsResponse = Message<"api.server.v1.StreamEventsResponse"> & {
+  /**
+   * @generated from field: api.server.v1.LiveEvent event = 1;
+   */
+  event?: LiveEvent;
+};
+
+/**
+ * Describes the message api.server.v1.StreamEventsResponse.
+ * Use `create(StreamEventsResponseSchema)` to create a new message.
+ */
+export const StreamEventsResponseSchema: GenMessage<StreamEventsResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 21);
+
+/**
+ * @generated from message api.server.v1.CreateTeamRequest
+ */
+export type CreateTeamRequest = Message<"api.server.v1.CreateTeamRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message api.server.v1.CreateTeamRequest.
+ * Use `create(CreateTeamRequestSchema)` to create a new message.
+ */
+export const CreateTeamRequestSchema: GenMessage<CreateTeamRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 22);
+
+/**
+ * @generated from message api.server.v1.CreateTeamResponse
+ */
+export type CreateTeamResponse = Message<"api.server.v1.CreateTeamResponse"> & {
+  /**
+   * @generated from field: api.server.v1.Team team = 1;
+   */
+  team?: Team;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.CreateTeamResponse.
+ * Use `create(CreateTeamResponseSchema)` to create a new message.
+ */
+export const CreateTeamResponseSchema: GenMessage<CreateTeamResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 23);
+
+/**
+ * @generated from message api.server.v1.JoinTeamRequest
+ */
+export type JoinTeamRequest = Message<"api.server.v1.JoinTeamRequest"> & {
+  /**
+   * @generated from field: string invite_code = 1;
+   */
+  inviteCode: string;
+};
+
+/**
+ * Describes the message api.server.v1.JoinTeamRequest.
+ * Use `create(JoinTeamRequestSchema)` to create a new message.
+ */
+export const JoinTeamRequestSchema: GenMessage<JoinTeamRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 24);
+
+/**
+ * @generated from message api.server.v1.JoinTeamResponse
+ */
+export type JoinTeamResponse = Message<"api.server.v1.JoinTeamResponse"> & {
+  /**
+   * @generated from field: api.server.v1.Team team = 1;
+   */
+  team?: Team;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.JoinTeamResponse.
+ * Use `create(JoinTeamResponseSchema)` to create a new message.
+ */
+export const JoinTeamResponseSchema: GenMessage<JoinTeamResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 25);
+
+/**
+ * @generated from message api.server.v1.LeaveTeamRequest
+ */
+export type LeaveTeamRequest = Message<"api.server.v1.LeaveTeamRequest"> & {
+};
+
+/**
+ * Describes the message api.server.v1.LeaveTeamRequest.
+ * Use `create(LeaveTeamRequestSchema)` to create a new message.
+ */
+export const LeaveTeamRequestSchema: GenMessage<LeaveTeamRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 26);
+
+/**
+ * @generated from message api.server.v1.LeaveTeamResponse
+ */
+export type LeaveTeamResponse = Message<"api.server.v1.LeaveTeamResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.LeaveTeamResponse.
+ * Use `create(LeaveTeamResponseSchema)` to create a new message.
+ */
+export const LeaveTeamResponseSchema: GenMessage<LeaveTeamResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 27);
+
+/**
+ * @generated from message api.server.v1.GetMyTeamRequest
+ */
+export type GetMyTeamRequest = Message<"api.server.v1.GetMyTeamRequest"> & {
+};
+
+/**
+ * Describes the message api.server.v1.GetMyTeamRequest.
+ * Use `create(GetMyTeamRequestSchema)` to create a new message.
+ */
+export const GetMyTeamRequestSchema: GenMessage<GetMyTeamRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 28);
+
+/**
+ * @generated from message api.server.v1.GetMyTeamResponse
+ */
+export type GetMyTeamResponse = Message<"api.server.v1.GetMyTeamResponse"> & {
+  /**
+   * @generated from field: api.server.v1.Team team = 1;
+   */
+  team?: Team;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.GetMyTeamResponse.
+ * Use `create(GetMyTeamResponseSchema)` to create a new message.
+ */
+export const GetMyTeamResponseSchema: GenMessage<GetMyTeamResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 29);
 
 /**
  * @generated from message api.server.v1.LoginRequest
@@ -271,7 +650,7 @@ export type LoginRequest = Message<"api.server.v1.LoginRequest"> & {
  * Use `create(LoginRequestSchema)` to create a new message.
  */
 export const LoginRequestSchema: GenMessage<LoginRequest> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 10);
+  messageDesc(file_api_server_v1_client, 30);
 
 /**
  * @generated from message api.server.v1.LoginResponse
@@ -293,7 +672,7 @@ export type LoginResponse = Message<"api.server.v1.LoginResponse"> & {
  * Use `create(LoginResponseSchema)` to create a new message.
  */
 export const LoginResponseSchema: GenMessage<LoginResponse> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 11);
+  messageDesc(file_api_server_v1_client, 31);
 
 /**
  * @generated from message api.server.v1.RegisterRequest
@@ -305,6 +684,8 @@ export type RegisterRequest = Message<"api.server.v1.RegisterRequest"> & {
   username: string;
 
   /**
+   * at least 8 characters and at most 72 bytes
+   *
    * @generated from field: string password = 2;
    */
   password: string;
@@ -315,7 +696,7 @@ export type RegisterRequest = Message<"api.server.v1.RegisterRequest"> & {
  * Use `create(RegisterRequestSchema)` to create a new message.
  */
 export const RegisterRequestSchema: GenMessage<RegisterRequest> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 12);
+  messageDesc(file_api_server_v1_client, 32);
 
 /**
  * @generated from message api.server.v1.RegisterResponse
@@ -337,7 +718,7 @@ export type RegisterResponse = Message<"api.server.v1.RegisterResponse"> & {
  * Use `create(RegisterResponseSchema)` to create a new message.
  */
 export const RegisterResponseSchema: GenMessage<RegisterResponse> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 13);
+  messageDesc(file_api_server_v1_client, 33);
 
 /**
  * @generated from message api.server.v1.LogoutRequest
@@ -354,7 +735,7 @@ export type LogoutRequest = Message<"api.server.v1.LogoutRequest"> & {
  * Use `create(LogoutRequestSchema)` to create a new message.
  */
 export const LogoutRequestSchema: GenMessage<LogoutRequest> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 14);
+  messageDesc(file_api_server_v1_client, 34);
 
 /**
  * @generated from message api.server.v1.LogoutResponse
@@ -371,7 +752,189 @@ export type LogoutResponse = Message<"api.server.v1.LogoutResponse"> & {
  * Use `create(LogoutResponseSchema)` to create a new message.
  */
 export const LogoutResponseSchema: GenMessage<LogoutResponse> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_client, 15);
+  messageDesc(file_api_server_v1_client, 35);
+
+/**
+ * @generated from message api.server.v1.UserProfile
+ */
+export type UserProfile = Message<"api.server.v1.UserProfile"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string display_name = 4;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: string affiliation = 5;
+   */
+  affiliation: string;
+
+  /**
+   * ISO 3166-1 alpha-2 code such as JP
+   *
+   * @generated from field: string country = 6;
+   */
+  country: string;
+};
+
+/**
+ * Describes the message api.server.v1.UserProfile.
+ * Use `create(UserProfileSchema)` to create a new message.
+ */
+export const UserProfileSchema: GenMessage<UserProfile> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 36);
+
+/**
+ * @generated from message api.server.v1.GetProfileRequest
+ */
+export type GetProfileRequest = Message<"api.server.v1.GetProfileRequest"> & {
+};
+
+/**
+ * Describes the message api.server.v1.GetProfileRequest.
+ * Use `create(GetProfileRequestSchema)` to create a new message.
+ */
+export const GetProfileRequestSchema: GenMessage<GetProfileRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 37);
+
+/**
+ * @generated from message api.server.v1.GetProfileResponse
+ */
+export type GetProfileResponse = Message<"api.server.v1.GetProfileResponse"> & {
+  /**
+   * @generated from field: api.server.v1.UserProfile profile = 1;
+   */
+  profile?: UserProfile;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.GetProfileResponse.
+ * Use `create(GetProfileResponseSchema)` to create a new message.
+ */
+export const GetProfileResponseSchema: GenMessage<GetProfileResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 38);
+
+/**
+ * UpdateProfileRequest replaces every profile field. Empty values clear the field
+ *
+ * @generated from message api.server.v1.UpdateProfileRequest
+ */
+export type UpdateProfileRequest = Message<"api.server.v1.UpdateProfileRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+
+  /**
+   * at most 64 characters
+   *
+   * @generated from field: string display_name = 2;
+   */
+  displayName: string;
+
+  /**
+   * at most 128 characters
+   *
+   * @generated from field: string affiliation = 3;
+   */
+  affiliation: string;
+
+  /**
+   * @generated from field: string country = 4;
+   */
+  country: string;
+};
+
+/**
+ * Describes the message api.server.v1.UpdateProfileRequest.
+ * Use `create(UpdateProfileRequestSchema)` to create a new message.
+ */
+export const UpdateProfileRequestSchema: GenMessage<UpdateProfileRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 39);
+
+/**
+ * @generated from message api.server.v1.UpdateProfileResponse
+ */
+export type UpdateProfileResponse = Message<"api.server.v1.UpdateProfileResponse"> & {
+  /**
+   * @generated from field: api.server.v1.UserProfile profile = 1;
+   */
+  profile?: UserProfile;
+
+  /**
+   * @generated from field: string error_message = 2;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.UpdateProfileResponse.
+ * Use `create(UpdateProfileResponseSchema)` to create a new message.
+ */
+export const UpdateProfileResponseSchema: GenMessage<UpdateProfileResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 40);
+
+/**
+ * ChangePasswordRequest signs out every other session of the user
+ *
+ * @generated from message api.server.v1.ChangePasswordRequest
+ */
+export type ChangePasswordRequest = Message<"api.server.v1.ChangePasswordRequest"> & {
+  /**
+   * @generated from field: string current_password = 1;
+   */
+  currentPassword: string;
+
+  /**
+   * at least 8 characters and at most 72 bytes
+   *
+   * @generated from field: string new_password = 2;
+   */
+  newPassword: string;
+};
+
+/**
+ * Describes the message api.server.v1.ChangePasswordRequest.
+ * Use `create(ChangePasswordRequestSchema)` to create a new message.
+ */
+export const ChangePasswordRequestSchema: GenMessage<ChangePasswordRequest> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 41);
+
+/**
+ * @generated from message api.server.v1.ChangePasswordResponse
+ */
+export type ChangePasswordResponse = Message<"api.server.v1.ChangePasswordResponse"> & {
+  /**
+   * @generated from field: string error_message = 1;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message api.server.v1.ChangePasswordResponse.
+ * Use `create(ChangePasswordResponseSchema)` to create a new message.
+ */
+export const ChangePasswordResponseSchema: GenMessage<ChangePasswordResponse> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_client, 42);
 
 /**
  * @generated from service api.server.v1.ClientChallengeService
@@ -393,6 +956,54 @@ export const ClientChallengeService: GenService<{
     input: typeof SubmitFlagRequestSchema;
     output: typeof SubmitFlagResponseSchema;
   },
+  /**
+   * @generated from rpc api.server.v1.ClientChallengeService.GetScoreboard
+   */
+  getScoreboard: {
+    methodKind: "unary";
+    input: typeof GetScoreboardRequestSchema;
+    output: typeof GetScoreboardResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.ClientChallengeService.GetScoreHistory
+   */
+  getScoreHistory: {
+    methodKind: "unary";
+    input: typeof GetScoreHistoryRequestSchema;
+    output: typeof GetScoreHistoryResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.ClientChallengeService.GetHints
+   */
+  getHints: {
+    methodKind: "unary";
+    input: typeof GetHintsRequestSchema;
+    output: typeof GetHintsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.ClientChallengeService.UnlockHint
+   */
+  unlockHint: {
+    methodKind: "unary";
+    input: typeof UnlockHintRequestSchema;
+    output: typeof UnlockHintResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.ClientChallengeService.GetAnnouncements
+   */
+  getAnnouncements: {
+    methodKind: "unary";
+    input: typeof GetAnnouncementsRequestSchema;
+    output: typeof GetAnnouncementsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.ClientChallengeService.StreamEvents
+   */
+  streamEvents: {
+    methodKind: "server_streaming";
+    input: typeof StreamEventsRequestSchema;
+    output: typeof StreamEventsResponseSchema;
+  },
   /**
    * @generated from rpc api.server.v1.ClientChallengeService.StartInstance
    */
@@ -420,6 +1031,45 @@ export const ClientChallengeService: GenService<{
 }> = /*@__PURE__*/
   serviceDesc(file_api_server_v1_client, 0);
 
+/**
+ * @generated from service api.server.v1.TeamService
+ */
+export const TeamService: GenService<{
+  /**
+   * @generated from rpc api.server.v1.TeamService.CreateTeam
+   */
+  createTeam: {
+    methodKind: "unary";
+    input: typeof CreateTeamRequestSchema;
+    output: typeof CreateTeamResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.TeamService.JoinTeam
+   */
+  joinTeam: {
+    methodKind: "unary";
+    input: typeof JoinTeamRequestSchema;
+    output: typeof JoinTeamResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.TeamService.LeaveTeam
+   */
+  leaveTeam: {
+    methodKind: "unary";
+    input: typeof LeaveTeamRequestSchema;
+    output: typeof LeaveTeamResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.TeamService.GetMyTeam
+   */
+  getMyTeam: {
+    methodKind: "unary";
+    input: typeof GetMyTeamRequestSchema;
+    output: typeof GetMyTeamResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_server_v1_client, 1);
+
 /**
  * @generated from service api.server.v1.UserAuthService
  */
@@ -448,6 +1098,30 @@ export const UserAuthService: GenService<{
     input: typeof LogoutRequestSchema;
     output: typeof LogoutResponseSchema;
   },
+  /**
+   * @generated from rpc api.server.v1.UserAuthService.GetProfile
+   */
+  getProfile: {
+    methodKind: "unary";
+    input: typeof GetProfileRequestSchema;
+    output: typeof GetProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.UserAuthService.UpdateProfile
+   */
+  updateProfile: {
+    methodKind: "unary";
+    input: typeof UpdateProfileRequestSchema;
+    output: typeof UpdateProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc api.server.v1.UserAuthService.ChangePassword
+   */
+  changePassword: {
+    methodKind: "unary";
+    input: typeof ChangePasswordRequestSchema;
+    output: typeof ChangePasswordResponseSchema;
+  },
 }> = /*@__PURE__*/
-  serviceDesc(file_api_server_v1_client, 1);
+  serviceDesc(file_api_server_v1_client, 2);
 
@@ -2,15 +2,15 @@
 // @generated from file api/server/v1/model.proto (package api.server.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file api/server/v1/model.proto.
  */
 export const file_api_server_v1_model: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvc2VydmVyL3YxL21vZGVsLnByb3RvEg1hcGkuc2VydmVyLnYxIrwBCglDaGFsbGVuZ2USFAoMY2hhbGxlbmdlX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDAoEZmxhZxgEIAEoCRIOCgZwb2ludHMYBSABKAUSDQoFZ2VucmUYBiABKAkSLgoLYXR0YWNobWVudHMYByADKAsyGS5hcGkuc2VydmVyLnYxLkF0dGFjaG1lbnQSGQoRcmVxdWlyZXNfaW5zdGFuY2UYCCABKAgiUAoKQXR0YWNobWVudBIVCg1hdHRhY2htZW50X2lkGAEgASgJEhAKCGZpbGVuYW1lGAIgASgJEgwKBHNpemUYAyABKAMSCwoDdXJsGAQgASgJIn0KEENoYWxsZW5nZVJlcXVlc3QSDAoEbmFtZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIMCgRmbGFnGAMgASgJEg4KBnBvaW50cxgEIAEoBRINCgVnZW5yZRgFIAEoCRIZChFyZXF1aXJlc19pbnN0YW5jZRgGIAEoCCJeCgpTdWJtaXNzaW9uEhQKDGNoYWxsZW5nZV9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhYKDnN1Ym1pdHRlZF9mbGFnGAMgASgJEhEKCXRpbWVzdGFtcBgEIAEoA0KxAQoRY29tLmFwaS5zZXJ2ZXIudjFCCk1vZGVsUHJvdG9QAVo6Z2l0aHViLmNvbS9rYXZvczExMy9xdWlja2N0Zi9nZW4vZ28vYXBpL3NlcnZlci92MTtzZXJ2ZXJ2MaICA0FTWKoCDUFwaS5TZXJ2ZXIuVjHKAg1BcGlcU2VydmVyXFYx4gIZQXBpXFNlcnZlclxWMVxHUEJNZXRhZGF0YeoCD0FwaTo6U2VydmVyOjpWMWIGcHJvdG8z");
+  fileDesc("ChlhcGkvc2VydmVyL3YxL21vZGVsLnByb3RvEg1hcGkuc2VydmVyLnYxIvEFCglDaGFsbGVuZ2USFAoMY2hhbGxlbmdlX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDAoEZmxhZxgEIAEoCRIOCgZwb2ludHMYBSABKAUSDQoFZ2VucmUYBiABKAkSLgoLYXR0YWNobWVudHMYByADKAsyGS5hcGkuc2VydmVyLnYxLkF0dGFjaG1lbnQSGQoRcmVxdWlyZXNfaW5zdGFuY2UYCCABKAgSMAoMc2NvcmluZ190eXBlGAkgASgOMhouYXBpLnNlcnZlci52MS5TY29yaW5nVHlwZRIWCg5pbml0aWFsX3BvaW50cxgKIAEoBRIWCg5taW5pbXVtX3BvaW50cxgLIAEoBRINCgVkZWNheRgMIAEoBRIUCgxkeW5hbWljX2ZsYWcYDSABKAgSNQoPZmxhZ19tYXRjaF9tb2RlGA4gASgOMhwuYXBpLnNlcnZlci52MS5GbGFnTWF0Y2hNb2RlEhYKDmFjY2VwdGVkX2ZsYWdzGA8gAygJEhgKEHByZXJlcXVpc2l0ZV9pZHMYECADKAkSOgoRcHJlcmVxdWlzaXRlX21vZGUYESABKA4yHy5hcGkuc2VydmVyLnYxLlByZXJlcXVpc2l0ZU1vZGUSDgoGbG9ja2VkGBIgASgIEjYKCnZpc2liaWxpdHkYEyABKA4yIi5hcGkuc2VydmVyLnYxLkNoYWxsZW5nZVZpc2liaWxpdHkSEgoKcmVsZWFzZV9hdBgUIAEoAxImCgVwYXJ0cxgVIAMoCzIXLmFwaS5zZXJ2ZXIudjEuRmxhZ1BhcnQSEwoLc29sdmVfY291bnQYFiABKAUSFAoMc29sdmVkX2J5X21lGBcgASgIEi4KC2ZpcnN0X2Jsb29kGBggASgLMhkuYXBpLnNlcnZlci52MS5GaXJzdEJsb29kEhUKDWJsb29kX2JvbnVzZXMYGSADKAUSEQoJYXV0aG9yX2lkGBogASgJImYKCkZpcnN0Qmxvb2QSDwoHdXNlcl9pZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRIPCgd0ZWFtX2lkGAMgASgJEhEKCXRlYW1fbmFtZRgEIAEoCRIRCglzb2x2ZWRfYXQYBSABKAMiVwoIRmxhZ1BhcnQSDwoHcGFydF9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBGZsYWcYAyABKAkSDgoGcG9pbnRzGAQgASgFEg4KBnNvbHZlZBgFIAEoCCJQCgpBdHRhY2htZW50EhUKDWF0dGFjaG1lbnRfaWQYASABKAkSEAoIZmlsZW5hbWUYAiABKAkSDAoEc2l6ZRgDIAEoAxILCgN1cmwYBCABKAkitAQKEENoYWxsZW5nZVJlcXVlc3QSDAoEbmFtZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIMCgRmbGFnGAMgASgJEg4KBnBvaW50cxgEIAEoBRINCgVnZW5yZRgFIAEoCRIZChFyZXF1aXJlc19pbnN0YW5jZRgGIAEoCBIwCgxzY29yaW5nX3R5cGUYByABKA4yGi5hcGkuc2VydmVyLnYxLlNjb3JpbmdUeXBlEhYKDmluaXRpYWxfcG9pbnRzGAggASgFEhYKDm1pbmltdW1fcG9pbnRzGAkgASgFEg0KBWRlY2F5GAogASgFEhQKDGR5bmFtaWNfZmxhZxgLIAEoCBI1Cg9mbGFnX21hdGNoX21vZGUYDCABKA4yHC5hcGkuc2VydmVyLnYxLkZsYWdNYXRjaE1vZGUSFgoOYWNjZXB0ZWRfZmxhZ3MYDSADKAkSGAoQcHJlcmVxdWlzaXRlX2lkcxgOIAMoCRI6ChFwcmVyZXF1aXNpdGVfbW9kZRgPIAEoDjIfLmFwaS5zZXJ2ZXIudjEuUHJlcmVxdWlzaXRlTW9kZRI2Cgp2aXNpYmlsaXR5GBAgASgOMiIuYXBpLnNlcnZlci52MS5DaGFsbGVuZ2VWaXNpYmlsaXR5EhIKCnJlbGVhc2VfYXQYESABKAMSJgoFcGFydHMYEiADKAsyFy5hcGkuc2VydmVyLnYxLkZsYWdQYXJ0EhUKDWJsb29kX2JvbnVzZXMYEyADKAUiXgoKU3VibWlzc2lvbhIUCgxjaGFsbGVuZ2VfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIWCg5zdWJtaXR0ZWRfZmxhZxgDIAEoCRIRCgl0aW1lc3RhbXAYBCABKAMioQEKD1Njb3JlYm9hcmRFbnRyeRIMCgRyYW5rGAEgASgFEg8KB3VzZXJfaWQYAiABKAkSEAoIdXNlcm5hbWUYAyABKAkSDQoFc2NvcmUYBCABKAUSEwoLc29sdmVfY291bnQYBSABKAUSFQoNbGFzdF9zb2x2ZV9hdBgGIAEoAxIPCgd0ZWFtX2lkGAcgASgJEhEKCXRlYW1fbmFtZRgIIAEoCSJoCgxTY29yZUhpc3RvcnkSLQoFZW50cnkYASABKAsyHi5hcGkuc2VydmVyLnYxLlNjb3JlYm9hcmRFbnRyeRIpCgZwb2ludHMYAiADKAsyGS5hcGkuc2VydmVyLnYxLlNjb3JlUG9pbnQiJwoKU2NvcmVQb2ludBIKCgJhdBgBIAEoAxINCgVzY29yZRgCIAEoBSJwCgRIaW50Eg8KB2hpbnRfaWQYASABKAkSFAoMY2hhbGxlbmdlX2lkGAIgASgJEg8KB2NvbnRlbnQYAyABKAkSDAoEY29zdBgEIAEoBRIQCghwb3NpdGlvbhgFIAEoBRIQCgh1bmxvY2tlZBgGIAEoCCJCCgtFdmVudENvbmZpZxIQCghzdGFydF9hdBgBIAEoAxIOCgZlbmRfYXQYAiABKAMSEQoJZnJlZXplX2F0GAMgASgDImYKBFRlYW0SDwoHdGVhbV9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2ludml0ZV9jb2RlGAMgASgJEioKB21lbWJlcnMYBCADKAsyGS5hcGkuc2VydmVyLnYxLlRlYW1NZW1iZXIiQgoKVGVhbU1lbWJlchIPCgd1c2VyX2lkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEhEKCWpvaW5lZF9hdBgDIAEoAyLSAQoJTGl2ZUV2ZW50EioKBHR5cGUYASABKA4yHC5hcGkuc2VydmVyLnYxLkxpdmVFdmVudFR5cGUSFAoMY2hhbGxlbmdlX2lkGAIgASgJEhYKDmNoYWxsZW5nZV9uYW1lGAMgASgJEg8KB3VzZXJfaWQYBCABKAkSEAoIdXNlcm5hbWUYBSABKAkSDwoHdGVhbV9pZBgGIAEoCRIRCgl0ZWFtX25hbWUYByABKAkSDwoHbWVzc2FnZRgIIAEoCRITCgtvY2N1cnJlZF9hdBgJIAEoAyKFAQoMQW5ub3VuY2VtZW50EhcKD2Fubm91bmNlbWVudF9pZBgBIAEoCRIUCgxjaGFsbGVuZ2VfaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSDwoHY29udGVudBgEIAEoCRISCgpjcmVhdGVkX2F0GAUgASgDEhIKCnVwZGF0ZWRfYXQYBiABKAMqXgoLU2NvcmluZ1R5cGUSHAoYU0NPUklOR19UWVBFX1VOU1BFQ0lGSUVEEAASFwoTU0NPUklOR19UWVBFX1NUQVRJQxABEhgKFFNDT1JJTkdfVFlQRV9EWU5BTUlDEAIqjAEKDUZsYWdNYXRjaE1vZGUSHwobRkxBR19NQVRDSF9NT0RFX1VOU1BFQ0lGSUVEEAASGQoVRkxBR19NQVRDSF9NT0RFX0VYQUNUEAESJAogRkxBR19NQVRDSF9NT0RFX0NBU0VfSU5TRU5TSVRJVkUQAhIZChVGTEFHX01BVENIX01PREVfUkVHRVgQAyprChBQcmVyZXF1aXNpdGVNb2RlEiEKHVBSRVJFUVVJU0lURV9NT0RFX1VOU1BFQ0lGSUVEEAASGQoVUFJFUkVRVUlTSVRFX01PREVfQUxMEAESGQoVUFJFUkVRVUlTSVRFX01PREVfQU5ZEAIqwgEKE0NoYWxsZW5nZVZpc2liaWxpdHkSJAogQ0hBTExFTkdFX1ZJU0lCSUxJVFlfVU5TUEVDSUZJRUQQABIeChpDSEFMTEVOR0VfVklTSUJJTElUWV9EUkFGVBABEh8KG0NIQUxMRU5HRV9WSVNJQklMSVRZX0hJRERFThACEiAKHENIQUxMRU5HRV9WSVNJQklMSVRZX1ZJU0lCTEUQAxIiCh5DSEFMTEVOR0VfVklTSUJJTElUWV9TQ0hFRFVMRUQQBCqxAQoNTGl2ZUV2ZW50VHlwZRIfChtMSVZFX0VWRU5UX1RZUEVfVU5TUEVDSUZJRUQQABIZChVMSVZFX0VWRU5UX1RZUEVfU09MVkUQARIfChtMSVZFX0VWRU5UX1RZUEVfRklSU1RfQkxPT0QQAhIhCh1MSVZFX0VWRU5UX1RZUEVfTkVXX0NIQUxMRU5HRRADEiAKHExJVkVfRVZFTlRfVFlQRV9BTk5PVU5DRU1FTlQQBEKxAQoRY29tLmFwaS5zZXJ2ZXIudjFCCk1vZGVsUHJvdG9QAVo6Z2l0aHViLmNvbS9rYXZvczExMy9xdWlja2N0Zi9nZW4vZ28vYXBpL3NlcnZlci92MTtzZXJ2ZXJ2MaICA0FTWKoCDUFwaS5TZXJ2ZXIuVjHKAg1BcGlcU2VydmVyXFYx4gIZQXBpXFNlcnZlclxWMVxHUEJNZXRhZGF0YeoCD0FwaTo6U2VydmVyOjpWMWIGcHJvdG8z");
 
 /**
  * @generated from message api.server.v1.Challenge
@@ -55,6 +55,108 @@ export type Challenge = Message<"api.server.v1.Challenge"> & {
    * @generated from field: bool requires_instance = 8;
    */
   requiresInstance: boolean;
+
+  /**
+   * @generated from field: api.server.v1.ScoringType scoring_type = 9;
+   */
+  scoringType: ScoringType;
+
+  /**
+   * @generated from field: int32 initial_points = 10;
+   */
+  initialPoints: number;
+
+  /**
+   * @generated from field: int32 minimum_points = 11;
+   */
+  minimumPoints: number;
+
+  /**
+   * @generated from field: int32 decay = 12;
+   */
+  decay: number;
+
+  /**
+   * @generated from field: bool dynamic_flag = 13;
+   */
+  dynamicFlag: boolean;
+
+  /**
+   * @generated from field: api.server.v1.FlagMatchMode flag_match_mode = 14;
+   */
+  flagMatchMode: FlagMatchMode;
+
+  /**
+   * accepted in addition to flag
+   *
+   * @generated from field: repeated string accepted_flags = 15;
+   */
+  acceptedFlags: string[];
+
+  /**
+   * @generated from field: repeated string prerequisite_ids = 16;
+   */
+  prerequisiteIds: string[];
+
+  /**
+   * @generated from field: api.server.v1.PrerequisiteMode prerequisite_mode = 17;
+   */
+  prerequisiteMode: PrerequisiteMode;
+
+  /**
+   * true until the prerequisites are solved
+   *
+   * @generated from field: bool locked = 18;
+   */
+  locked: boolean;
+
+  /**
+   * @generated from field: api.server.v1.ChallengeVisibility visibility = 19;
+   */
+  visibility: ChallengeVisibility;
+
+  /**
+   * unix seconds, 0 means not set
+   *
+   * @generated from field: int64 release_at = 20;
+   */
+  releaseAt: bigint;
+
+  /**
+   * @generated from field: repeated api.server.v1.FlagPart parts = 21;
+   */
+  parts: FlagPart[];
+
+  /**
+   * @generated from field: int32 solve_count = 22;
+   */
+  solveCount: number;
+
+  /**
+   * @generated from field: bool solved_by_me = 23;
+   */
+  solvedByMe: boolean;
+
+  /**
+   * unset when nobody has solved it
+   *
+   * @generated from field: api.server.v1.FirstBlood first_blood = 24;
+   */
+  firstBlood?: FirstBlood;
+
+  /**
+   * bonus for the 1st, 2nd and 3rd solver
+   *
+   * @generated from field: repeated int32 blood_bonuses = 25;
+   */
+  bloodBonuses: number[];
+
+  /**
+   * staff who created the challenge, admin only
+   *
+   * @generated from field: string author_id = 26;
+   */
+  authorId: string;
 };
 
 /**
@@ -64,6 +166,84 @@ export type Challenge = Message<"api.server.v1.Challenge"> & {
 export const ChallengeSchema: GenMessage<Challenge> = /*@__PURE__*/
   messageDesc(file_api_server_v1_model, 0);
 
+/**
+ * @generated from message api.server.v1.FirstBlood
+ */
+export type FirstBlood = Message<"api.server.v1.FirstBlood"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string team_id = 3;
+   */
+  teamId: string;
+
+  /**
+   * @generated from field: string team_name = 4;
+   */
+  teamName: string;
+
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 solved_at = 5;
+   */
+  solvedAt: bigint;
+};
+
+/**
+ * Describes the message api.server.v1.FirstBlood.
+ * Use `create(FirstBloodSchema)` to create a new message.
+ */
+export const FirstBloodSchema: GenMessage<FirstBlood> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_model, 1);
+
+/**
+ * a named part of a multi-part challenge; flag is empty for players
+ *
+ * @generated from message api.server.v1.FlagPart
+ */
+export type FlagPart = Message<"api.server.v1.FlagPart"> & {
+  /**
+   * @generated from field: string part_id = 1;
+   */
+  partId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string flag = 3;
+   */
+  flag: string;
+
+  /**
+   * @generated from field: int32 points = 4;
+   */
+  points: number;
+
+  /**
+   * @generated from field: bool solved = 5;
+   */
+  solved: boolean;
+};
+
+/**
+ * Describes the message api.server.v1.FlagPart.
+ * Use `create(FlagPartSchema)` to create a new message.
+ */
+export const FlagPartSchema: GenMessage<FlagPart> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_model, 2);
+
 /**
  * @generated from message api.server.v1.Attachment
  */
@@ -94,7 +274,7 @@ export type Attachment = Message<"api.server.v1.Attachment"> & {
  * Use `create(AttachmentSchema)` to create a new message.
  */
 export const AttachmentSchema: GenMessage<Attachment> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_model, 1);
+  messageDesc(file_api_server_v1_model, 3);
 
 /**
  * @generated from message api.server.v1.ChallengeRequest
@@ -129,6 +309,79 @@ export type ChallengeRequest = Message<"api.server.v1.ChallengeRequest"> & {
    * @generated from field: bool requires_instance = 6;
    */
   requiresInstance: boolean;
+
+  /**
+   * @generated from field: api.server.v1.ScoringType scoring_type = 7;
+   */
+  scoringType: ScoringType;
+
+  /**
+   * @generated from field: int32 initial_points = 8;
+   */
+  initialPoints: number;
+
+  /**
+   * @generated from field: int32 minimum_points = 9;
+   */
+  minimumPoints: number;
+
+  /**
+   * @generated from field: int32 decay = 10;
+   */
+  decay: number;
+
+  /**
+   * @generated from field: bool dynamic_flag = 11;
+   */
+  dynamicFlag: boolean;
+
+  /**
+   * @generated from field: api.server.v1.FlagMatchMode flag_match_mode = 12;
+   */
+  flagMatchMode: FlagMatchMode;
+
+  /**
+   * accepted in addition to flag
+   *
+   * @generated from field: repeated string accepted_flags = 13;
+   */
+  acceptedFlags: string[];
+
+  /**
+   * @generated from field: repeated string prerequisite_ids = 14;
+   */
+  prerequisiteIds: string[];
+
+  /**
+   * @generated from field: api.server.v1.PrerequisiteMode prerequisite_mode = 15;
+   */
+  prerequisiteMode: PrerequisiteMode;
+
+  /**
+   * @generated from field: api.server.v1.ChallengeVisibility visibility = 16;
+   */
+  visibility: ChallengeVisibility;
+
+  /**
+   * unix seconds, 0 means not set
+   *
+   * @generated from field: int64 release_at = 17;
+   */
+  releaseAt: bigint;
+
+  /**
+   * points of the challenge become a completion bonus
+   *
+   * @generated from field: repeated api.server.v1.FlagPart parts = 18;
+   */
+  parts: FlagPart[];
+
+  /**
+   * bonus for the 1st, 2nd and 3rd solver, at most 3 entries
+   *
+   * @generated from field: repeated int32 blood_bonuses = 19;
+   */
+  bloodBonuses: number[];
 };
 
 /**
@@ -136,7 +389,7 @@ export type ChallengeRequest = Message<"api.server.v1.ChallengeRequest"> & {
  * Use `create(ChallengeRequestSchema)` to create a new message.
  */
 export const ChallengeRequestSchema: GenMessage<ChallengeRequest> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_model, 2);
+  messageDesc(file_api_server_v1_model, 4);
 
 /**
  * @generated from message api.server.v1.Submission
@@ -168,5 +421,497 @@ export type Submission = Message<"api.server.v1.Submission"> & {
  * Use `create(SubmissionSchema)` to create a new message.
  */
 export const SubmissionSchema: GenMessage<Submission> = /*@__PURE__*/
-  messageDesc(file_api_server_v1_model, 3);
+  messageDesc(file_api_server_v1_model, 5);
+
+/**
+ * @generated from message api.server.v1.ScoreboardEntry
+ */
+export type ScoreboardEntry = Message<"api.server.v1.ScoreboardEntry"> & {
+  /**
+   * @generated from field: int32 rank = 1;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * @generated from field: int32 score = 4;
+   */
+  score: number;
+
+  /**
+   * @generated from field: int32 solve_count = 5;
+   */
+  solveCount: number;
+
+  /**
+   * @generated from field: int64 last_solve_at = 6;
+   */
+  lastSolveAt: bigint;
+
+  /**
+   * @generated from field: string team_id = 7;
+   */
+  teamId: string;
+
+  /**
+   * @generated from field: string team_name = 8;
+   */
+  teamName: string;
+};
+
+/**
+ * Describes the message api.server.v1.ScoreboardEntry.
+ * Use `create(ScoreboardEntrySchema)` to create a new message.
+ */
+export const ScoreboardEntrySchema: GenMessage<ScoreboardEntry> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_model, 6);
+
+/**
+ * cumulative score of a scoreboard entry over time
+ *
+ * @generated from message api.server.v1.ScoreHistory
+ */
+export type ScoreHistory = Message<"api.server.v1.ScoreHistory"> & {
+  /**
+   * @generated from field: api.server.v1.ScoreboardEntry entry = 1;
+   */
+  entry?: ScoreboardEntry;
+
+  /**
+   * @generated from field: repeated api.server.v1.ScorePoint points = 2;
+   */
+  points: ScorePoint[];
+};
+
+/**
+ * Describes the message api.server.v1.ScoreHistory.
+ * Use `create(ScoreHistorySchema)` to create a new message.
+ */
+export const ScoreHistorySchema: GenMessage<ScoreHistory> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_model, 7);
+
+/**
+ * @generated from message api.server.v1.ScorePoint
+ */
+export type ScorePoint = Message<"api.server.v1.ScorePoint"> & {
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 at = 1;
+   */
+  at: bigint;
+
+  /**
+   * @generated from field: int32 score = 2;
+   */
+  score: number;
+};
+
+/**
+ * Describes the message api.server.v1.ScorePoint.
+ * Use `create(ScorePointSchema)` to create a new message.
+ */
+export const ScorePointSchema: GenMessage<ScorePoint> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_model, 8);
+
+/**
+ * content is empty for players until the hint is unlocked
+ *
+ * @generated from message api.server.v1.Hint
+ */
+export type Hint = Message<"api.server.v1.Hint"> & {
+  /**
+   * @generated from field: string hint_id = 1;
+   */
+  hintId: string;
+
+  /**
+   * @generated from field: string challenge_id = 2;
+   */
+  challengeId: string;
+
+  /**
+   * @generated from field: string content = 3;
+   */
+  content: string;
+
+  /**
+   * @generated from field: int32 cost = 4;
+   */
+  cost: number;
+
+  /**
+   * @generated from field: int32 position = 5;
+   */
+  position: number;
+
+  /**
+   * @generated from field: bool unlocked = 6;
+   */
+  unlocked: boolean;
+};
+
+/**
+ * Describes the message api.server.v1.Hint.
+ * Use `create(HintSchema)` to create a new message.
+ */
+export const HintSchema: GenMessage<Hint> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_model, 9);
+
+/**
+ * unix seconds, 0 means not set
+ *
+ * @generated from message api.server.v1.EventConfig
+ */
+export type EventConfig = Message<"api.server.v1.EventConfig"> & {
+  /**
+   * @generated from field: int64 start_at = 1;
+   */
+  startAt: bigint;
+
+  /**
+   * @generated from field: int64 end_at = 2;
+   */
+  endAt: bigint;
+
+  /**
+   * @generated from field: int64 freeze_at = 3;
+   */
+  freezeAt: bigint;
+};
+
+/**
+ * Describes the message api.server.v1.EventConfig.
+ * Use `create(EventConfigSchema)` to create a new message.
+ */
+export const EventConfigSchema: GenMessage<EventConfig> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_model, 10);
+
+/**
+ * @generated from message api.server.v1.Team
+ */
+export type Team = Message<"api.server.v1.Team"> & {
+  /**
+   * @generated from field: string team_id = 1;
+   */
+  teamId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string invite_code = 3;
+   */
+  inviteCode: string;
+
+  /**
+   * @generated from field: repeated api.server.v1.TeamMember members = 4;
+   */
+  members: TeamMember[];
+};
+
+/**
+ * Describes the message api.server.v1.Team.
+ * Use `create(TeamSchema)` to create a new message.
+ */
+export const TeamSchema: GenMessage<Team> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_model, 11);
+
+/**
+ * @generated from message api.server.v1.TeamMember
+ */
+export type TeamMember = Message<"api.server.v1.TeamMember"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: int64 joined_at = 3;
+   */
+  joinedAt: bigint;
+};
+
+/**
+ * Describes the message api.server.v1.TeamMember.
+ * Use `create(TeamMemberSchema)` to create a new message.
+ */
+export const TeamMemberSchema: GenMessage<TeamMember> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_model, 12);
+
+/**
+ * @generated from message api.server.v1.LiveEvent
+ */
+export type LiveEvent = Message<"api.server.v1.LiveEvent"> & {
+  /**
+   * @generated from field: api.server.v1.LiveEventType type = 1;
+   */
+  type: LiveEventType;
+
+  /**
+   * @generated from field: string challenge_id = 2;
+   */
+  challengeId: string;
+
+  /**
+   * @generated from field: string challenge_name = 3;
+   */
+  challengeName: string;
+
+  /**
+   * @generated from field: string user_id = 4;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string username = 5;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string team_id = 6;
+   */
+  teamId: string;
+
+  /**
+   * @generated from field: string team_name = 7;
+   */
+  teamName: string;
+
+  /**
+   * announcement title
+   *
+   * @generated from field: string message = 8;
+   */
+  message: string;
+
+  /**
+   * @generated from field: int64 occurred_at = 9;
+   */
+  occurredAt: bigint;
+};
+
+/**
+ * Describes the message api.server.v1.LiveEvent.
+ * Use `create(LiveEventSchema)` to create a new message.
+ */
+export const LiveEventSchema: GenMessage<LiveEvent> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_model, 13);
+
+/**
+ * challenge_id is empty for an event-wide announcement
+ *
+ * @generated from message api.server.v1.Announcement
+ */
+export type Announcement = Message<"api.server.v1.Announcement"> & {
+  /**
+   * @generated from field: string announcement_id = 1;
+   */
+  announcementId: string;
+
+  /**
+   * @generated from field: string challenge_id = 2;
+   */
+  challengeId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string content = 4;
+   */
+  content: string;
+
+  /**
+   * @generated from field: int64 created_at = 5;
+   */
+  createdAt: bigint;
+
+  /**
+   * @generated from field: int64 updated_at = 6;
+   */
+  updatedAt: bigint;
+};
+
+/**
+ * Describes the message api.server.v1.Announcement.
+ * Use `create(AnnouncementSchema)` to create a new message.
+ */
+export const AnnouncementSchema: GenMessage<Announcement> = /*@__PURE__*/
+  messageDesc(file_api_server_v1_model, 14);
+
+/**
+ * @generated from enum api.server.v1.ScoringType
+ */
+export enum ScoringType {
+  /**
+   * @generated from enum value: SCORING_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCORING_TYPE_STATIC = 1;
+   */
+  STATIC = 1,
+
+  /**
+   * @generated from enum value: SCORING_TYPE_DYNAMIC = 2;
+   */
+  DYNAMIC = 2,
+}
+
+/**
+ * Describes the enum api.server.v1.ScoringType.
+ */
+export const ScoringTypeSchema: GenEnum<ScoringType> = /*@__PURE__*/
+  enumDesc(file_api_server_v1_model, 0);
+
+/**
+ * @generated from enum api.server.v1.FlagMatchMode
+ */
+export enum FlagMatchMode {
+  /**
+   * @generated from enum value: FLAG_MATCH_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FLAG_MATCH_MODE_EXACT = 1;
+   */
+  EXACT = 1,
+
+  /**
+   * @generated from enum value: FLAG_MATCH_MODE_CASE_INSENSITIVE = 2;
+   */
+  CASE_INSENSITIVE = 2,
+
+  /**
+   * @generated from enum value: FLAG_MATCH_MODE_REGEX = 3;
+   */
+  REGEX = 3,
+}
+
+/**
+ * Describes the enum api.server.v1.FlagMatchMode.
+ */
+export const FlagMatchModeSchema: GenEnum<FlagMatchMode> = /*@__PURE__*/
+  enumDesc(file_api_server_v1_model, 1);
+
+/**
+ * @generated from enum api.server.v1.PrerequisiteMode
+ */
+export enum PrerequisiteMode {
+  /**
+   * @generated from enum value: PREREQUISITE_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PREREQUISITE_MODE_ALL = 1;
+   */
+  ALL = 1,
+
+  /**
+   * @generated from enum value: PREREQUISITE_MODE_ANY = 2;
+   */
+  ANY = 2,
+}
+
+/**
+ * Describes the enum api.server.v1.PrerequisiteMode.
+ */
+export const PrerequisiteModeSchema: GenEnum<PrerequisiteMode> = /*@__PURE__*/
+  enumDesc(file_api_server_v1_model, 2);
+
+/**
+ * @generated from enum api.server.v1.ChallengeVisibility
+ */
+export enum ChallengeVisibility {
+  /**
+   * @generated from enum value: CHALLENGE_VISIBILITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CHALLENGE_VISIBILITY_DRAFT = 1;
+   */
+  DRAFT = 1,
+
+  /**
+   * @generated from enum value: CHALLENGE_VISIBILITY_HIDDEN = 2;
+   */
+  HIDDEN = 2,
+
+  /**
+   * @generated from enum value: CHALLENGE_VISIBILITY_VISIBLE = 3;
+   */
+  VISIBLE = 3,
+
+  /**
+   * becomes visible at release_at
+   *
+   * @generated from enum value: CHALLENGE_VISIBILITY_SCHEDULED = 4;
+   */
+  SCHEDULED = 4,
+}
+
+/**
+ * Describes the enum api.server.v1.ChallengeVisibility.
+ */
+export const ChallengeVisibilitySchema: GenEnum<ChallengeVisibility> = /*@__PURE__*/
+  enumDesc(file_api_server_v1_model, 3);
+
+/**
+ * @generated from enum api.server.v1.LiveEventType
+ */
+export enum LiveEventType {
+  /**
+   * @generated from enum value: LIVE_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: LIVE_EVENT_TYPE_SOLVE = 1;
+   */
+  SOLVE = 1,
+
+  /**
+   * @generated from enum value: LIVE_EVENT_TYPE_FIRST_BLOOD = 2;
+   */
+  FIRST_BLOOD = 2,
+
+  /**
+   * @generated from enum value: LIVE_EVENT_TYPE_NEW_CHALLENGE = 3;
+   */
+  NEW_CHALLENGE = 3,
+
+  /**
+   * @generated from enum value: LIVE_EVENT_TYPE_ANNOUNCEMENT = 4;
+   */
+  ANNOUNCEMENT = 4,
+}
+
+/**
+ * Describes the enum api.server.v1.LiveEventType.
+ */
+export const LiveEventTypeSchema: GenEnum<LiveEventType> = /*@__PURE__*/
+  enumDesc(file_api_server_v1_model, 4);
 
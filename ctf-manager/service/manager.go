@@ -119,6 +119,7 @@ func (s *ManagerService) StartInstance(ctx context.Context, req *managerPb.Start
 	runnerReq := &runnerPb.StartInstanceRequest{
 		ImageTag:      req.ImageTag,
 		ContainerName: fmt.Sprintf("ctf-%s", instanceID),
+		Flag:          req.Flag,
 	}
 
 	resp, err := runner.Client.StartInstance(ctx, runnerReq)
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -9,6 +11,7 @@ import (
 	"log"
 	"net/netip"
 	"os"
+	"path"
 	"strconv"
 
 	pb "github.com/kavos113/quickctf/gen/go/api/runner/v1"
@@ -25,6 +28,8 @@ type RunnerService struct {
 	maxPort      int
 	usedPorts    []bool
 	internalPort network.Port
+	flagEnvName  string
+	flagFilePath string
 }
 
 func NewRunnerService(registryURL string) *RunnerService {
@@ -52,6 +57,16 @@ func NewRunnerService(registryURL string) *RunnerService {
 		}
 	}
 
+	flagEnvName := os.Getenv("FLAG_ENV_NAME")
+	if flagEnvName == "" {
+		flagEnvName = "FLAG"
+	}
+
+	flagFilePath := os.Getenv("FLAG_FILE_PATH")
+	if flagFilePath == "" {
+		flagFilePath = "/flag.txt"
+	}
+
 	return &RunnerService{
 		dockerClient: cli,
 		registryURL:  registryURL,
@@ -59,6 +74,8 @@ func NewRunnerService(registryURL string) *RunnerService {
 		maxPort:      maxPort,
 		usedPorts:    make([]bool, maxPort-minPort+1),
 		internalPort: internalPort,
+		flagEnvName:  flagEnvName,
+		flagFilePath: flagFilePath,
 	}
 }
 
@@ -115,6 +132,9 @@ func (s *RunnerService) StartInstance(ctx context.Context, req *pb.StartInstance
 			s.internalPort: struct{}{},
 		},
 	}
+	if req.Flag != "" {
+		containerConfig.Env = []string{fmt.Sprintf("%s=%s", s.flagEnvName, req.Flag)}
+	}
 
 	networkConfig := &network.NetworkingConfig{}
 
@@ -133,6 +153,18 @@ func (s *RunnerService) StartInstance(ctx context.Context, req *pb.StartInstance
 		}, nil
 	}
 
+	// フラグは環境変数に加えてファイルとしてもコンテナ内に配置する
+	if req.Flag != "" {
+		if err := s.copyFlag(ctx, resp.ID, req.Flag); err != nil {
+			s.dockerClient.ContainerRemove(ctx, resp.ID, client.ContainerRemoveOptions{Force: true})
+			s.freePort(port)
+			return &pb.StartInstanceResponse{
+				Status:       "failed",
+				ErrorMessage: fmt.Sprintf("failed to copy flag: %v", err),
+			}, nil
+		}
+	}
+
 	startOptions := client.ContainerStartOptions{}
 	if _, err := s.dockerClient.ContainerStart(ctx, resp.ID, startOptions); err != nil {
 		return &pb.StartInstanceResponse{
@@ -169,6 +201,43 @@ func (s *RunnerService) StartInstance(ctx context.Context, req *pb.StartInstance
 	}, nil
 }
 
+func (s *RunnerService) copyFlag(ctx context.Context, containerID, flag string) error {
+	archive, err := flagArchive(path.Base(s.flagFilePath), flag)
+	if err != nil {
+		return err
+	}
+
+	copyOptions := client.CopyToContainerOptions{
+		DestinationPath: path.Dir(s.flagFilePath),
+		Content:         archive,
+	}
+	_, err = s.dockerClient.CopyToContainer(ctx, containerID, copyOptions)
+	return err
+}
+
+// flagArchive はフラグを name というファイル1つだけを含むtarにする
+func flagArchive(name, flag string) (io.Reader, error) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	header := &tar.Header{
+		Name: name,
+		Mode: 0444,
+		Size: int64(len(flag)),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return nil, err
+	}
+	if _, err := tw.Write([]byte(flag)); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return &buf, nil
+}
+
 func (s *RunnerService) StopInstance(ctx context.Context, req *pb.StopInstanceRequest) (*pb.StopInstanceResponse, error) {
 	timeout := 10
 	stopOptions := client.ContainerStopOptions{
@@ -1,7 +1,9 @@
 package service
 
 import (
+	"archive/tar"
 	"context"
+	"io"
 	"log"
 	"net"
 	"testing"
@@ -121,3 +123,31 @@ func TestRunnerService_StopInstance(t *testing.T) {
 		t.Errorf("Expected failed status, got %s", resp.Status)
 	}
 }
+
+func TestFlagArchive(t *testing.T) {
+	archive, err := flagArchive("flag.txt", "flag{test}")
+	if err != nil {
+		t.Fatalf("flagArchive failed: %v", err)
+	}
+
+	tr := tar.NewReader(archive)
+	header, err := tr.Next()
+	if err != nil {
+		t.Fatalf("failed to read tar header: %v", err)
+	}
+	if header.Name != "flag.txt" {
+		t.Errorf("header.Name = %s, want flag.txt", header.Name)
+	}
+
+	content, err := io.ReadAll(tr)
+	if err != nil {
+		t.Fatalf("failed to read tar content: %v", err)
+	}
+	if string(content) != "flag{test}" {
+		t.Errorf("content = %s, want flag{test}", content)
+	}
+
+	if _, err := tr.Next(); err != io.EOF {
+		t.Errorf("archive should contain exactly one file")
+	}
+}
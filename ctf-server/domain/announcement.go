@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Announcement は運営から参加者へのお知らせ。ChallengeID が空の場合は全体へのお知らせ
+type Announcement struct {
+	AnnouncementID string
+	ChallengeID    string
+	Title          string
+	Content        string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+var (
+	ErrAnnouncementNotFound    = errors.New("announcement not found")
+	ErrInvalidAnnouncementData = errors.New("invalid announcement data")
+)
+
+func (a *Announcement) Validate() error {
+	if a.Title == "" || a.Content == "" {
+		return ErrInvalidAnnouncementData
+	}
+	return nil
+}
+
+type AnnouncementRepository interface {
+	Create(ctx context.Context, announcement *Announcement) error
+	FindByID(ctx context.Context, announcementID string) (*Announcement, error)
+	// FindAll は作成日時の新しい順にお知らせを返す
+	FindAll(ctx context.Context) ([]*Announcement, error)
+	Update(ctx context.Context, announcement *Announcement) error
+	Delete(ctx context.Context, announcementID string) error
+}
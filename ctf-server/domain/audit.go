@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type AuditTargetType string
+
+const (
+	AuditTargetChallenge    AuditTargetType = "challenge"
+	AuditTargetHint         AuditTargetType = "hint"
+	AuditTargetAnnouncement AuditTargetType = "announcement"
+	AuditTargetEventConfig  AuditTargetType = "event_config"
+	AuditTargetSubmission   AuditTargetType = "submission"
+	AuditTargetUser         AuditTargetType = "user"
+)
+
+// 監査ログの文字列カラムの最大文字数。超えた部分は切り捨てて記録する
+const (
+	MaxAuditActorNameLength    = 255
+	MaxAuditActionLength       = 100
+	MaxAuditTargetIDLength     = 255
+	MaxAuditErrorMessageLength = 1024
+	MaxAuditClientIPLength     = 64
+)
+
+// RedactedValue は監査ログに残さない値の代わりに記録する
+const RedactedValue = "[REDACTED]"
+
+// auditSecretFields は値を監査ログに残さないフィールド。変更されたことだけを記録する
+var auditSecretFields = map[string]bool{
+	"flag":           true,
+	"accepted_flags": true,
+	"part_flags":     true,
+	"password_hash":  true,
+}
+
+// AuditSnapshot は監査対象の状態をフィールド名と値の組で表す。対象が存在しない場合は nil
+type AuditSnapshot map[string]string
+
+// AuditChange は1つのフィールドの変更前後の値
+type AuditChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// AuditEvent は管理画面での変更操作の記録。記録後に変更・削除はしない
+type AuditEvent struct {
+	AuditEventID string
+	ActorID      string
+	ActorName    string // 記録時点のユーザー名。ユーザーが削除されても残す
+	Action       string // AdminService のメソッド名
+	TargetType   AuditTargetType
+	TargetID     string
+	Changes      []*AuditChange
+	ErrorMessage string // 失敗した操作も記録する
+	ClientIP     string
+	OccurredAt   time.Time
+}
+
+// Truncate は文字列をカラムの長さに収める。長すぎる値で記録に失敗しないようにする
+func (e *AuditEvent) Truncate() {
+	e.ActorName = truncateRunes(e.ActorName, MaxAuditActorNameLength)
+	e.Action = truncateRunes(e.Action, MaxAuditActionLength)
+	e.TargetID = truncateRunes(e.TargetID, MaxAuditTargetIDLength)
+	e.ErrorMessage = truncateRunes(e.ErrorMessage, MaxAuditErrorMessageLength)
+	e.ClientIP = truncateRunes(e.ClientIP, MaxAuditClientIPLength)
+}
+
+// truncateRunes は s を先頭から maxLength 文字までに切り詰める。不正な UTF-8 は置き換える
+func truncateRunes(s string, maxLength int) string {
+	if !utf8.ValidString(s) {
+		s = strings.ToValidUTF8(s, string(utf8.RuneError))
+	}
+	if utf8.RuneCountInString(s) <= maxLength {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:maxLength])
+}
+
+// DiffAuditSnapshots は変更されたフィールドを名前順に返す。秘密のフィールドの値は RedactedValue に置き換える
+func DiffAuditSnapshots(before, after AuditSnapshot) []*AuditChange {
+	fields := make(map[string]bool, len(before)+len(after))
+	for f := range before {
+		fields[f] = true
+	}
+	for f := range after {
+		fields[f] = true
+	}
+
+	changes := []*AuditChange{}
+	for f := range fields {
+		b, a := before[f], after[f]
+		if b == a {
+			continue
+		}
+		if auditSecretFields[f] {
+			b, a = redact(b), redact(a)
+		}
+		changes = append(changes, &AuditChange{Field: f, Before: b, After: a})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+// redact は空でない値を伏せる。空の場合は設定の有無がわかるようにそのまま返す
+func redact(value string) string {
+	if value == "" {
+		return ""
+	}
+	return RedactedValue
+}
+
+// AuditEventFilter は監査ログの検索条件。空のフィールドは条件に含めない。新しい順に返す
+type AuditEventFilter struct {
+	ActorID    string
+	Action     string
+	TargetType AuditTargetType
+	TargetID   string
+	Since      time.Time
+	Until      time.Time
+	After      *AuditEventCursor // このカーソルより古いイベントのみを返す
+	Limit      int
+}
+
+// AuditEventCursor は監査ログのページの位置を表す
+type AuditEventCursor struct {
+	OccurredAt   time.Time
+	AuditEventID string
+}
+
+func NewAuditEventCursor(event *AuditEvent) *AuditEventCursor {
+	return &AuditEventCursor{OccurredAt: event.OccurredAt, AuditEventID: event.AuditEventID}
+}
+
+func (c *AuditEventCursor) Encode() string {
+	return encodeCursor(c.OccurredAt, c.AuditEventID)
+}
+
+func DecodeAuditEventCursor(cursor string) (*AuditEventCursor, error) {
+	occurredAt, id, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditEventCursor{OccurredAt: occurredAt, AuditEventID: id}, nil
+}
+
+// AuditEventPage は監査ログの1ページ
+type AuditEventPage struct {
+	Events     []*AuditEvent
+	NextCursor string // 次のページがない場合は空
+}
+
+// AuditRepository は追記のみを行う。更新・削除の手段は提供しない
+type AuditRepository interface {
+	Create(ctx context.Context, event *AuditEvent) error
+	FindEvents(ctx context.Context, filter *AuditEventFilter) ([]*AuditEvent, error)
+}
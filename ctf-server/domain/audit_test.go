@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestDiffAuditSnapshots(t *testing.T) {
+	tests := []struct {
+		name   string
+		before AuditSnapshot
+		after  AuditSnapshot
+		want   []AuditChange
+	}{
+		{
+			name:   "changed fields are sorted by name",
+			before: AuditSnapshot{"name": "warmup", "points": "100", "genre": "web"},
+			after:  AuditSnapshot{"name": "warmup2", "points": "200", "genre": "web"},
+			want: []AuditChange{
+				{Field: "name", Before: "warmup", After: "warmup2"},
+				{Field: "points", Before: "100", After: "200"},
+			},
+		},
+		{
+			name:   "flags are redacted",
+			before: AuditSnapshot{"flag": "flag{old}", "accepted_flags": ""},
+			after:  AuditSnapshot{"flag": "flag{new}", "accepted_flags": "flag{alt}"},
+			want: []AuditChange{
+				{Field: "accepted_flags", Before: "", After: RedactedValue},
+				{Field: "flag", Before: RedactedValue, After: RedactedValue},
+			},
+		},
+		{
+			name:   "created target",
+			before: nil,
+			after:  AuditSnapshot{"title": "welcome", "content": ""},
+			want: []AuditChange{
+				{Field: "title", Before: "", After: "welcome"},
+			},
+		},
+		{
+			name:   "deleted target",
+			before: AuditSnapshot{"title": "welcome"},
+			after:  nil,
+			want: []AuditChange{
+				{Field: "title", Before: "welcome", After: ""},
+			},
+		},
+		{
+			name:   "no changes",
+			before: AuditSnapshot{"flag": "flag{same}"},
+			after:  AuditSnapshot{"flag": "flag{same}"},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []AuditChange
+			for _, c := range DiffAuditSnapshots(tt.before, tt.after) {
+				got = append(got, *c)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("DiffAuditSnapshots() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuditEvent_Truncate(t *testing.T) {
+	event := &AuditEvent{
+		ActorName:    strings.Repeat("あ", MaxAuditActorNameLength+1),
+		Action:       "UpdateChallenge",
+		ErrorMessage: strings.Repeat("e", MaxAuditErrorMessageLength+10),
+		ClientIP:     strings.Repeat("1", 100),
+	}
+	event.Truncate()
+
+	if got := utf8.RuneCountInString(event.ActorName); got != MaxAuditActorNameLength {
+		t.Errorf("ActorName length = %d, want %d", got, MaxAuditActorNameLength)
+	}
+	if event.Action != "UpdateChallenge" {
+		t.Errorf("Action = %q, want UpdateChallenge", event.Action)
+	}
+	if got := len(event.ErrorMessage); got != MaxAuditErrorMessageLength {
+		t.Errorf("ErrorMessage length = %d, want %d", got, MaxAuditErrorMessageLength)
+	}
+	if got := len(event.ClientIP); got != MaxAuditClientIPLength {
+		t.Errorf("ClientIP length = %d, want %d", got, MaxAuditClientIPLength)
+	}
+}
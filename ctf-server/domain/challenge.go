@@ -3,22 +3,166 @@ package domain
 import (
 	"context"
 	"errors"
+	"math"
 	"time"
 )
 
+type ScoringType string
+
+const (
+	ScoringTypeStatic  ScoringType = "static"
+	ScoringTypeDynamic ScoringType = "dynamic"
+)
+
+type FlagMatchMode string
+
+const (
+	FlagMatchModeExact           FlagMatchMode = "exact"
+	FlagMatchModeCaseInsensitive FlagMatchMode = "case_insensitive"
+	FlagMatchModeRegex           FlagMatchMode = "regex"
+)
+
+type Visibility string
+
+const (
+	VisibilityDraft     Visibility = "draft"     // 作成中
+	VisibilityHidden    Visibility = "hidden"    // 一時的に非公開
+	VisibilityVisible   Visibility = "visible"   // 公開中
+	VisibilityScheduled Visibility = "scheduled" // ReleaseAt に公開する
+)
+
+// MaxBloodBonuses は正解順位に応じたボーナスを設定できる順位の数
+const MaxBloodBonuses = 3
+
 type Challenge struct {
 	ChallengeID      string
 	Name             string
 	Description      string
 	Flag             string
-	Points           int
+	Points           int // dynamicの場合は現在の正解数から計算された値
 	Genre            string
 	RequiresInstance bool
+	ScoringType      ScoringType
+	InitialPoints    int
+	MinimumPoints    int
+	Decay            int
+	DynamicFlag      bool // trueの場合、ユーザー(チーム)ごとに異なるフラグをインスタンスに埋め込む
+	FlagMatchMode    FlagMatchMode
+	AcceptedFlags    []string    // Flagに加えて正解とするフラグ
+	Parts            []*FlagPart // 部分フラグがある場合、Pointsはすべて解いたときのボーナスになる
+	Prerequisites    []string    // 前提となる問題のID
+	PrerequisiteMode PrerequisiteMode
+	Locked           bool                 // 参加者が前提問題を解いていない場合にtrue。保存はしない
+	SolveStats       *ChallengeSolveStats // 参加者向けの正解状況。保存はしない
+	Visibility       Visibility
+	ReleaseAt        time.Time // Visibility が scheduled の場合の公開時刻
+	BloodBonuses     []int     // 1番目から順に、解いたユーザー(チーム)に与えるボーナス。最大 MaxBloodBonuses 件
 	Attachments      []*Attachment
+	AuthorID         string // 問題を作成した運営のユーザーID。作成者のみが author の役割で編集できる
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
 
+// CandidateFlags は正解として扱うフラグをすべて返す
+func (c *Challenge) CandidateFlags() []string {
+	flags := make([]string, 0, len(c.AcceptedFlags)+1)
+	flags = append(flags, c.Flag)
+	flags = append(flags, c.AcceptedFlags...)
+	return flags
+}
+
+func (c *Challenge) IsDynamic() bool {
+	return c.ScoringType == ScoringTypeDynamic
+}
+
+// ValidateScoring はdynamic scoringのパラメータを検証する
+func (c *Challenge) ValidateScoring() error {
+	switch c.ScoringType {
+	case ScoringTypeStatic:
+		return nil
+	case ScoringTypeDynamic:
+		if c.InitialPoints <= 0 || c.MinimumPoints < 0 || c.MinimumPoints > c.InitialPoints || c.Decay <= 0 {
+			return ErrInvalidChallengeData
+		}
+		return nil
+	default:
+		return ErrInvalidChallengeData
+	}
+}
+
+// ValidateFlag はdynamic flagの設定を検証する
+// ユーザーごとのフラグはインスタンスに埋め込むため、インスタンスを持つ問題でのみ有効
+func (c *Challenge) ValidateFlag() error {
+	if c.DynamicFlag && !c.RequiresInstance {
+		return ErrInvalidChallengeData
+	}
+	return nil
+}
+
+// BloodBonus は rank 番目に解いたときのボーナスを返す
+func (c *Challenge) BloodBonus(rank int) int {
+	if rank < 1 || rank > len(c.BloodBonuses) {
+		return 0
+	}
+	return c.BloodBonuses[rank-1]
+}
+
+// ValidateBloodBonuses は正解順位ボーナスの設定を検証する
+func (c *Challenge) ValidateBloodBonuses() error {
+	if len(c.BloodBonuses) > MaxBloodBonuses {
+		return ErrInvalidChallengeData
+	}
+	for _, bonus := range c.BloodBonuses {
+		if bonus < 0 {
+			return ErrInvalidChallengeData
+		}
+	}
+	return nil
+}
+
+// IsReleased は参加者に公開されているかを返す
+// 予約公開の問題はスケジューラーが状態を切り替える前でも公開時刻を過ぎていれば公開済みとして扱う
+func (c *Challenge) IsReleased(now time.Time) bool {
+	switch c.Visibility {
+	case VisibilityVisible:
+		return true
+	case VisibilityScheduled:
+		return !now.Before(c.ReleaseAt)
+	default:
+		return false
+	}
+}
+
+// ValidateVisibility は公開状態の設定を検証する
+func (c *Challenge) ValidateVisibility() error {
+	switch c.Visibility {
+	case VisibilityDraft, VisibilityHidden, VisibilityVisible:
+		return nil
+	case VisibilityScheduled:
+		if c.ReleaseAt.IsZero() {
+			return ErrInvalidChallengeData
+		}
+		return nil
+	default:
+		return ErrInvalidChallengeData
+	}
+}
+
+// DynamicPoints は正解数solveCountのときの得点を返す
+// 最初の正解者はInitialPointsを得て、正解数がDecayに達するとMinimumPointsまで二次関数的に減少する
+func (c *Challenge) DynamicPoints(solveCount int) int {
+	if solveCount > 0 {
+		solveCount--
+	}
+
+	value := float64(c.MinimumPoints-c.InitialPoints)/float64(c.Decay*c.Decay)*float64(solveCount*solveCount) + float64(c.InitialPoints)
+	points := int(math.Ceil(value))
+	if points < c.MinimumPoints {
+		return c.MinimumPoints
+	}
+	return points
+}
+
 type Attachment struct {
 	AttachmentID string
 	ChallengeID  string
@@ -40,6 +184,8 @@ type ChallengeRepository interface {
 	FindByID(ctx context.Context, challengeID string) (*Challenge, error)
 	FindAll(ctx context.Context) ([]*Challenge, error)
 	Update(ctx context.Context, challenge *Challenge) error
+	// ReleaseScheduled は公開時刻を過ぎた予約公開の問題を公開状態にし、公開した問題のIDを返す
+	ReleaseScheduled(ctx context.Context, now time.Time) ([]string, error)
 	Delete(ctx context.Context, challengeID string) error
 }
 
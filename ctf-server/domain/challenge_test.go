@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChallenge_DynamicPoints(t *testing.T) {
+	challenge := &Challenge{
+		ScoringType:   ScoringTypeDynamic,
+		InitialPoints: 500,
+		MinimumPoints: 100,
+		Decay:         10,
+	}
+
+	tests := []struct {
+		name       string
+		solveCount int
+		want       int
+	}{
+		{name: "no solves", solveCount: 0, want: 500},
+		{name: "first solve", solveCount: 1, want: 500},
+		{name: "second solve", solveCount: 2, want: 496},
+		{name: "half decay", solveCount: 6, want: 400},
+		{name: "reaches minimum", solveCount: 11, want: 100},
+		{name: "below minimum is clamped", solveCount: 50, want: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := challenge.DynamicPoints(tt.solveCount); got != tt.want {
+				t.Errorf("Challenge.DynamicPoints(%d) = %v, want %v", tt.solveCount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChallenge_ValidateScoring(t *testing.T) {
+	tests := []struct {
+		name      string
+		challenge Challenge
+		wantErr   bool
+	}{
+		{
+			name:      "static",
+			challenge: Challenge{ScoringType: ScoringTypeStatic, Points: 100},
+			wantErr:   false,
+		},
+		{
+			name:      "valid dynamic",
+			challenge: Challenge{ScoringType: ScoringTypeDynamic, InitialPoints: 500, MinimumPoints: 100, Decay: 10},
+			wantErr:   false,
+		},
+		{
+			name:      "minimum greater than initial",
+			challenge: Challenge{ScoringType: ScoringTypeDynamic, InitialPoints: 100, MinimumPoints: 500, Decay: 10},
+			wantErr:   true,
+		},
+		{
+			name:      "zero decay",
+			challenge: Challenge{ScoringType: ScoringTypeDynamic, InitialPoints: 500, MinimumPoints: 100, Decay: 0},
+			wantErr:   true,
+		},
+		{
+			name:      "unknown scoring type",
+			challenge: Challenge{ScoringType: "unknown"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.challenge.ValidateScoring()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Challenge.ValidateScoring() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChallenge_IsReleased(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		challenge Challenge
+		want      bool
+	}{
+		{name: "visible", challenge: Challenge{Visibility: VisibilityVisible}, want: true},
+		{name: "draft", challenge: Challenge{Visibility: VisibilityDraft}, want: false},
+		{name: "hidden", challenge: Challenge{Visibility: VisibilityHidden}, want: false},
+		{name: "scheduled before release", challenge: Challenge{Visibility: VisibilityScheduled, ReleaseAt: now.Add(time.Minute)}, want: false},
+		{name: "scheduled at release", challenge: Challenge{Visibility: VisibilityScheduled, ReleaseAt: now}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.challenge.IsReleased(now); got != tt.want {
+				t.Errorf("Challenge.IsReleased() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChallenge_ValidateVisibility(t *testing.T) {
+	tests := []struct {
+		name      string
+		challenge Challenge
+		wantErr   bool
+	}{
+		{name: "draft", challenge: Challenge{Visibility: VisibilityDraft}, wantErr: false},
+		{name: "scheduled with release time", challenge: Challenge{Visibility: VisibilityScheduled, ReleaseAt: time.Now()}, wantErr: false},
+		{name: "scheduled without release time", challenge: Challenge{Visibility: VisibilityScheduled}, wantErr: true},
+		{name: "unknown", challenge: Challenge{Visibility: "public"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.challenge.ValidateVisibility()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Challenge.ValidateVisibility() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChallenge_BloodBonus(t *testing.T) {
+	challenge := &Challenge{BloodBonuses: []int{30, 20, 10}}
+
+	tests := []struct {
+		rank int
+		want int
+	}{
+		{rank: 0, want: 0},
+		{rank: 1, want: 30},
+		{rank: 2, want: 20},
+		{rank: 3, want: 10},
+		{rank: 4, want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := challenge.BloodBonus(tt.rank); got != tt.want {
+			t.Errorf("Challenge.BloodBonus(%d) = %v, want %v", tt.rank, got, tt.want)
+		}
+	}
+}
+
+func TestChallenge_ValidateBloodBonuses(t *testing.T) {
+	tests := []struct {
+		name    string
+		bonuses []int
+		wantErr bool
+	}{
+		{name: "none", bonuses: nil, wantErr: false},
+		{name: "three", bonuses: []int{30, 20, 10}, wantErr: false},
+		{name: "too many", bonuses: []int{40, 30, 20, 10}, wantErr: true},
+		{name: "negative", bonuses: []int{-10}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Challenge{BloodBonuses: tt.bonuses}
+			if err := c.ValidateBloodBonuses(); (err != nil) != tt.wantErr {
+				t.Errorf("Challenge.ValidateBloodBonuses() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
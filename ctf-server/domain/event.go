@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// EventConfig は競技の開催期間とスコアボードの凍結時刻を表す
+// ゼロ値の時刻は未設定として扱い、制限をかけない
+type EventConfig struct {
+	StartAt   time.Time
+	EndAt     time.Time
+	FreezeAt  time.Time
+	UpdatedAt time.Time
+}
+
+var (
+	ErrEventNotStarted    = errors.New("event has not started")
+	ErrEventEnded         = errors.New("event has ended")
+	ErrInvalidEventConfig = errors.New("invalid event config")
+)
+
+func (e *EventConfig) Validate() error {
+	if !e.StartAt.IsZero() && !e.EndAt.IsZero() && !e.StartAt.Before(e.EndAt) {
+		return ErrInvalidEventConfig
+	}
+	if !e.FreezeAt.IsZero() {
+		if !e.StartAt.IsZero() && e.FreezeAt.Before(e.StartAt) {
+			return ErrInvalidEventConfig
+		}
+		if !e.EndAt.IsZero() && e.FreezeAt.After(e.EndAt) {
+			return ErrInvalidEventConfig
+		}
+	}
+	return nil
+}
+
+// CheckRunning は now が開催期間内でなければエラーを返す
+func (e *EventConfig) CheckRunning(now time.Time) error {
+	if !e.HasStarted(now) {
+		return ErrEventNotStarted
+	}
+	if !e.EndAt.IsZero() && !now.Before(e.EndAt) {
+		return ErrEventEnded
+	}
+	return nil
+}
+
+func (e *EventConfig) HasStarted(now time.Time) bool {
+	return e.StartAt.IsZero() || !now.Before(e.StartAt)
+}
+
+func (e *EventConfig) IsFrozen(now time.Time) bool {
+	return !e.FreezeAt.IsZero() && !now.Before(e.FreezeAt)
+}
+
+type EventConfigRepository interface {
+	// Get は設定が保存されていない場合ゼロ値の EventConfig を返す
+	Get(ctx context.Context) (*EventConfig, error)
+	Save(ctx context.Context, config *EventConfig) error
+}
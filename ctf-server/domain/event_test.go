@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEventConfig_Validate(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		config  EventConfig
+		wantErr bool
+	}{
+		{name: "not configured", config: EventConfig{}, wantErr: false},
+		{name: "valid window", config: EventConfig{StartAt: base, EndAt: base.Add(time.Hour), FreezeAt: base.Add(30 * time.Minute)}, wantErr: false},
+		{name: "end before start", config: EventConfig{StartAt: base, EndAt: base.Add(-time.Hour)}, wantErr: true},
+		{name: "end equals start", config: EventConfig{StartAt: base, EndAt: base}, wantErr: true},
+		{name: "freeze before start", config: EventConfig{StartAt: base, FreezeAt: base.Add(-time.Minute)}, wantErr: true},
+		{name: "freeze after end", config: EventConfig{EndAt: base, FreezeAt: base.Add(time.Minute)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EventConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEventConfig_CheckRunning(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	config := &EventConfig{StartAt: base, EndAt: base.Add(time.Hour)}
+
+	tests := []struct {
+		name    string
+		now     time.Time
+		wantErr error
+	}{
+		{name: "before start", now: base.Add(-time.Second), wantErr: ErrEventNotStarted},
+		{name: "at start", now: base, wantErr: nil},
+		{name: "during event", now: base.Add(30 * time.Minute), wantErr: nil},
+		{name: "at end", now: base.Add(time.Hour), wantErr: ErrEventEnded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := config.CheckRunning(tt.now); err != tt.wantErr {
+				t.Errorf("EventConfig.CheckRunning() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
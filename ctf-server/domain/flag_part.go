@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// FlagPart は複数段階の問題の部分フラグ。部分ごとに得点を得られる
+type FlagPart struct {
+	PartID      string
+	ChallengeID string
+	Name        string
+	Flag        string
+	Points      int
+	Position    int
+	Solved      bool // 参加者が解いた場合にtrue。保存はしない
+}
+
+// FlagPartSolve は部分フラグの正解記録。チームモードではチーム単位で記録する
+type FlagPartSolve struct {
+	SolveID     string
+	PartID      string
+	ChallengeID string
+	UserID      string
+	TeamID      string
+	OwnerID     string
+	SolvedAt    time.Time
+}
+
+var ErrFlagPartNotFound = errors.New("flag part not found")
+
+// IsMultiPart は部分フラグを持つ問題かを返す
+func (c *Challenge) IsMultiPart() bool {
+	return len(c.Parts) > 0
+}
+
+// ValidateParts は部分フラグの設定を検証する
+// 部分フラグはユーザーごとのフラグと併用できない
+func (c *Challenge) ValidateParts() error {
+	if !c.IsMultiPart() {
+		return nil
+	}
+	if c.DynamicFlag {
+		return ErrInvalidChallengeData
+	}
+
+	names := make(map[string]bool, len(c.Parts))
+	for _, p := range c.Parts {
+		if p.Name == "" || p.Flag == "" || p.Points < 0 || names[p.Name] {
+			return ErrInvalidChallengeData
+		}
+		names[p.Name] = true
+	}
+	return nil
+}
+
+type FlagPartSolveRepository interface {
+	// FindSolvedPartIDs は ownerID が解いた部分フラグのIDを返す
+	FindSolvedPartIDs(ctx context.Context, ownerID string) (map[string]bool, error)
+}
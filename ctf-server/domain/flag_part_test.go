@@ -0,0 +1,27 @@
+package domain
+
+import "testing"
+
+func TestChallenge_ValidateParts(t *testing.T) {
+	tests := []struct {
+		name      string
+		challenge Challenge
+		wantErr   bool
+	}{
+		{name: "no parts", challenge: Challenge{}, wantErr: false},
+		{name: "valid parts", challenge: Challenge{Parts: []*FlagPart{{Name: "user", Flag: "flag{a}", Points: 100}, {Name: "root", Flag: "flag{b}", Points: 200}}}, wantErr: false},
+		{name: "duplicate name", challenge: Challenge{Parts: []*FlagPart{{Name: "user", Flag: "flag{a}"}, {Name: "user", Flag: "flag{b}"}}}, wantErr: true},
+		{name: "empty flag", challenge: Challenge{Parts: []*FlagPart{{Name: "user"}}}, wantErr: true},
+		{name: "negative points", challenge: Challenge{Parts: []*FlagPart{{Name: "user", Flag: "flag{a}", Points: -1}}}, wantErr: true},
+		{name: "dynamic flag", challenge: Challenge{DynamicFlag: true, RequiresInstance: true, Parts: []*FlagPart{{Name: "user", Flag: "flag{a}"}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.challenge.ValidateParts()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Challenge.ValidateParts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+type FlagSharingReason string
+
+const (
+	// FlagSharingReasonSameWrongAnswer は異なるアカウントが同じ誤答を提出したもの
+	FlagSharingReasonSameWrongAnswer FlagSharingReason = "same_wrong_answer"
+	// FlagSharingReasonCloseSolves は異なるアカウントが短時間のうちに同じ問題を解いたもの
+	FlagSharingReasonCloseSolves FlagSharingReason = "close_solves"
+)
+
+// FlagSharingCluster はフラグ共有が疑われる提出のまとまり
+type FlagSharingCluster struct {
+	Reason        FlagSharingReason
+	ChallengeID   string
+	SubmittedFlag string // FlagSharingReasonSameWrongAnswer の場合のみ
+	Submissions   []*Submission
+}
+
+func (c *FlagSharingCluster) FirstSubmittedAt() time.Time {
+	return c.Submissions[0].SubmittedAt
+}
+
+func (c *FlagSharingCluster) LastSubmittedAt() time.Time {
+	return c.Submissions[len(c.Submissions)-1].SubmittedAt
+}
+
+// FlagSharingReport はフラグ共有の検出結果。表示用に問題名とユーザー名を引けるようにする
+type FlagSharingReport struct {
+	Clusters       []*FlagSharingCluster
+	ChallengeNames map[string]string
+	Usernames      map[string]string
+}
+
+// submissionOwner は提出の主体を返す。チームで提出されたものは同じチームを1つの主体とみなす
+func submissionOwner(s *Submission) string {
+	if s.TeamID != "" {
+		return "team:" + s.TeamID
+	}
+	return "user:" + s.UserID
+}
+
+func countOwners(submissions []*Submission) int {
+	owners := make(map[string]bool)
+	for _, s := range submissions {
+		owners[submissionOwner(s)] = true
+	}
+	return len(owners)
+}
+
+func sortBySubmittedAt(submissions []*Submission) {
+	sort.SliceStable(submissions, func(i, j int) bool {
+		return submissions[i].SubmittedAt.Before(submissions[j].SubmittedAt)
+	})
+}
+
+// DetectSameWrongAnswers は同じ問題に同じ誤答を提出した複数の主体をまとめる
+func DetectSameWrongAnswers(submissions []*Submission) []*FlagSharingCluster {
+	type key struct {
+		challengeID string
+		flag        string
+	}
+
+	groups := make(map[key][]*Submission)
+	var order []key
+	for _, s := range submissions {
+		if s.IsCorrect {
+			continue
+		}
+		k := key{challengeID: s.ChallengeID, flag: s.SubmittedFlag}
+		if _, ok := groups[k]; !ok {
+			order = append(order, k)
+		}
+		groups[k] = append(groups[k], s)
+	}
+
+	var clusters []*FlagSharingCluster
+	for _, k := range order {
+		group := groups[k]
+		if countOwners(group) < 2 {
+			continue
+		}
+		sortBySubmittedAt(group)
+		clusters = append(clusters, &FlagSharingCluster{
+			Reason:        FlagSharingReasonSameWrongAnswer,
+			ChallengeID:   k.challengeID,
+			SubmittedFlag: k.flag,
+			Submissions:   group,
+		})
+	}
+
+	return clusters
+}
+
+// DetectCloseSolves は同じ問題の正解のうち、直前の正解から window 以内に続いたものを1つにまとめる
+func DetectCloseSolves(solves []*Submission, window time.Duration) []*FlagSharingCluster {
+	byChallenge := make(map[string][]*Submission)
+	var order []string
+	for _, s := range solves {
+		if !s.IsCorrect {
+			continue
+		}
+		if _, ok := byChallenge[s.ChallengeID]; !ok {
+			order = append(order, s.ChallengeID)
+		}
+		byChallenge[s.ChallengeID] = append(byChallenge[s.ChallengeID], s)
+	}
+
+	var clusters []*FlagSharingCluster
+	for _, challengeID := range order {
+		group := byChallenge[challengeID]
+		sortBySubmittedAt(group)
+
+		start := 0
+		for i := 1; i <= len(group); i++ {
+			if i < len(group) && group[i].SubmittedAt.Sub(group[i-1].SubmittedAt) <= window {
+				continue
+			}
+
+			run := group[start:i]
+			if countOwners(run) >= 2 {
+				clusters = append(clusters, &FlagSharingCluster{
+					Reason:      FlagSharingReasonCloseSolves,
+					ChallengeID: challengeID,
+					Submissions: run,
+				})
+			}
+			start = i
+		}
+	}
+
+	return clusters
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDetectSameWrongAnswers(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	submissions := []*Submission{
+		{SubmissionID: "1", UserID: "alice", ChallengeID: "c1", SubmittedFlag: "flag{typo}", SubmittedAt: base.Add(time.Minute)},
+		{SubmissionID: "2", UserID: "bob", ChallengeID: "c1", SubmittedFlag: "flag{typo}", SubmittedAt: base},
+		{SubmissionID: "3", UserID: "carol", ChallengeID: "c1", SubmittedFlag: "flag{other}", SubmittedAt: base},
+		// 同じユーザーの重複は共有とみなさない
+		{SubmissionID: "4", UserID: "dave", ChallengeID: "c2", SubmittedFlag: "flag{x}", SubmittedAt: base},
+		{SubmissionID: "5", UserID: "dave", ChallengeID: "c2", SubmittedFlag: "flag{x}", SubmittedAt: base.Add(time.Second)},
+		// 同じチーム内の重複は共有とみなさない
+		{SubmissionID: "6", UserID: "erin", TeamID: "t1", ChallengeID: "c3", SubmittedFlag: "flag{y}", SubmittedAt: base},
+		{SubmissionID: "7", UserID: "frank", TeamID: "t1", ChallengeID: "c3", SubmittedFlag: "flag{y}", SubmittedAt: base},
+		// 正解は対象外
+		{SubmissionID: "8", UserID: "alice", ChallengeID: "c4", SubmittedFlag: "flag{ok}", IsCorrect: true, SubmittedAt: base},
+		{SubmissionID: "9", UserID: "bob", ChallengeID: "c4", SubmittedFlag: "flag{ok}", IsCorrect: true, SubmittedAt: base},
+	}
+
+	clusters := DetectSameWrongAnswers(submissions)
+	if len(clusters) != 1 {
+		t.Fatalf("DetectSameWrongAnswers() returned %d clusters, want 1", len(clusters))
+	}
+
+	c := clusters[0]
+	if c.ChallengeID != "c1" || c.SubmittedFlag != "flag{typo}" {
+		t.Errorf("cluster = %s/%s, want c1/flag{typo}", c.ChallengeID, c.SubmittedFlag)
+	}
+	if len(c.Submissions) != 2 || c.Submissions[0].SubmissionID != "2" {
+		t.Errorf("cluster submissions should be sorted by time, got first = %s", c.Submissions[0].SubmissionID)
+	}
+	if !c.FirstSubmittedAt().Equal(base) || !c.LastSubmittedAt().Equal(base.Add(time.Minute)) {
+		t.Errorf("cluster range = %v - %v, want %v - %v", c.FirstSubmittedAt(), c.LastSubmittedAt(), base, base.Add(time.Minute))
+	}
+}
+
+func TestDetectCloseSolves(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	solves := []*Submission{
+		{SubmissionID: "1", UserID: "alice", ChallengeID: "c1", IsCorrect: true, SubmittedAt: base},
+		{SubmissionID: "2", UserID: "bob", ChallengeID: "c1", IsCorrect: true, SubmittedAt: base.Add(3 * time.Second)},
+		{SubmissionID: "3", UserID: "carol", ChallengeID: "c1", IsCorrect: true, SubmittedAt: base.Add(8 * time.Second)},
+		{SubmissionID: "4", UserID: "dave", ChallengeID: "c1", IsCorrect: true, SubmittedAt: base.Add(time.Hour)},
+		{SubmissionID: "5", UserID: "alice", ChallengeID: "c2", IsCorrect: true, SubmittedAt: base},
+		{SubmissionID: "6", UserID: "bob", ChallengeID: "c2", IsCorrect: true, SubmittedAt: base.Add(time.Minute)},
+	}
+
+	clusters := DetectCloseSolves(solves, 5*time.Second)
+	if len(clusters) != 1 {
+		t.Fatalf("DetectCloseSolves() returned %d clusters, want 1", len(clusters))
+	}
+
+	c := clusters[0]
+	if c.ChallengeID != "c1" {
+		t.Errorf("cluster ChallengeID = %s, want c1", c.ChallengeID)
+	}
+
+	want := []string{"1", "2", "3"}
+	if len(c.Submissions) != len(want) {
+		t.Fatalf("cluster has %d submissions, want %d", len(c.Submissions), len(want))
+	}
+	for i, s := range c.Submissions {
+		if s.SubmissionID != want[i] {
+			t.Errorf("cluster.Submissions[%d] = %s, want %s", i, s.SubmissionID, want[i])
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type Hint struct {
+	HintID      string
+	ChallengeID string
+	Content     string
+	Cost        int
+	Position    int // 小さい順に公開する
+	CreatedAt   time.Time
+}
+
+// HintUnlock はヒントの公開記録。チームモードではチーム単位で記録する
+type HintUnlock struct {
+	UnlockID   string
+	HintID     string
+	UserID     string
+	TeamID     string
+	OwnerID    string
+	UnlockedAt time.Time
+}
+
+var (
+	ErrHintNotFound       = errors.New("hint not found")
+	ErrInvalidHintData    = errors.New("invalid hint data")
+	ErrPreviousHintLocked = errors.New("previous hint must be unlocked first")
+)
+
+func (h *Hint) Validate() error {
+	if h.Content == "" || h.Cost < 0 || h.Position < 1 {
+		return ErrInvalidHintData
+	}
+	return nil
+}
+
+type HintRepository interface {
+	Create(ctx context.Context, hint *Hint) error
+	FindByID(ctx context.Context, hintID string) (*Hint, error)
+	// FindByChallengeID は Position の昇順でヒントを返す
+	FindByChallengeID(ctx context.Context, challengeID string) ([]*Hint, error)
+	Update(ctx context.Context, hint *Hint) error
+	Delete(ctx context.Context, hintID string) error
+	CreateUnlock(ctx context.Context, unlock *HintUnlock) error
+	// FindUnlockedHintIDs は ownerID が公開済みの challengeID のヒントのIDを返す
+	FindUnlockedHintIDs(ctx context.Context, challengeID, ownerID string) (map[string]bool, error)
+}
@@ -36,6 +36,8 @@ type InstanceRepository interface {
 	Create(ctx context.Context, instance *Instance) error
 	FindByID(ctx context.Context, instanceID string) (*Instance, error)
 	FindByUserAndChallenge(ctx context.Context, userID, challengeID string) (*Instance, error)
+	// FindByUserID は破棄されていないインスタンスを起動日時の新しい順に返す
+	FindByUserID(ctx context.Context, userID string) ([]*Instance, error)
 	Update(ctx context.Context, instance *Instance) error
 	Delete(ctx context.Context, instanceID string) error
 }
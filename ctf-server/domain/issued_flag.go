@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// IssuedFlag はdynamic flagの問題でユーザー(チームモードではチーム)ごとに発行したフラグ
+type IssuedFlag struct {
+	IssuedFlagID string
+	ChallengeID  string
+	OwnerID      string
+	Flag         string
+	IssuedAt     time.Time
+}
+
+var (
+	ErrIssuedFlagNotFound = errors.New("issued flag not found")
+)
+
+type IssuedFlagRepository interface {
+	Create(ctx context.Context, issuedFlag *IssuedFlag) error
+	FindByOwner(ctx context.Context, challengeID, ownerID string) (*IssuedFlag, error)
+}
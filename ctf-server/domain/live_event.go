@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type LiveEventType string
+
+const (
+	LiveEventSolve        LiveEventType = "solve"
+	LiveEventFirstBlood   LiveEventType = "first_blood"
+	LiveEventNewChallenge LiveEventType = "new_challenge"
+	LiveEventAnnouncement LiveEventType = "announcement"
+)
+
+// LiveEvent は参加者に配信するイベント。種類によって使わないフィールドは空になる
+type LiveEvent struct {
+	Type          LiveEventType
+	ChallengeID   string
+	ChallengeName string
+	UserID        string
+	Username      string
+	TeamID        string
+	TeamName      string
+	Message       string
+	OccurredAt    time.Time
+}
+
+// EventHub はイベントをすべての購読者に配信する
+type EventHub interface {
+	Publish(ctx context.Context, event *LiveEvent) error
+	// Subscribe は ctx が終了するまで、配信されたイベントごとに handler を呼び出す
+	Subscribe(ctx context.Context, handler func(event *LiveEvent)) error
+}
@@ -0,0 +1,47 @@
+package domain
+
+const (
+	// DefaultPageSize は件数が指定されなかった場合の1ページの件数
+	DefaultPageSize = 50
+	// MaxPageSize は1ページで返す件数の上限
+	MaxPageSize = 200
+)
+
+// FetchPage はカーソル以降の要素を1ページ分取得し、次のページがある場合はそのカーソルを返す
+// cursor が空の場合は最初のページを取得し、fetch には decode のゼロ値を渡す
+// limit は DefaultPageSize と MaxPageSize の範囲に収めてから使う
+func FetchPage[T any, C interface{ Encode() string }](
+	cursor string,
+	limit int,
+	decode func(cursor string) (C, error),
+	newCursor func(last T) C,
+	fetch func(after C, limit int) ([]T, error),
+) ([]T, string, error) {
+	var after C
+	if cursor != "" {
+		var err error
+		after, err = decode(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	// 1件多く取得して次のページがあるかを判定する
+	items, err := fetch(after, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(items) <= limit {
+		return items, "", nil
+	}
+
+	items = items[:limit]
+	return items, newCursor(items[limit-1]).Encode(), nil
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFetchPage(t *testing.T) {
+	submissions := make([]*Submission, 0, MaxPageSize+10)
+	for i := 0; i < MaxPageSize+10; i++ {
+		submissions = append(submissions, &Submission{
+			SubmissionID: fmt.Sprintf("s%03d", i),
+			SubmittedAt:  time.Unix(int64(i), 0),
+		})
+	}
+	fetch := func(after *SubmissionCursor, limit int) ([]*Submission, error) {
+		start := 0
+		if after != nil {
+			for i, s := range submissions {
+				if s.SubmissionID == after.SubmissionID {
+					start = i + 1
+				}
+			}
+		}
+		end := min(start+limit, len(submissions))
+		return submissions[start:end], nil
+	}
+
+	tests := []struct {
+		name     string
+		limit    int
+		wantLen  int
+		wantNext bool
+	}{
+		{name: "default size", limit: 0, wantLen: DefaultPageSize, wantNext: true},
+		{name: "clamped to max", limit: MaxPageSize + 100, wantLen: MaxPageSize, wantNext: true},
+		{name: "requested size", limit: 5, wantLen: 5, wantNext: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, next, err := FetchPage("", tt.limit, DecodeSubmissionCursor, NewSubmissionCursor, fetch)
+			if err != nil {
+				t.Fatalf("FetchPage() error = %v", err)
+			}
+			if len(items) != tt.wantLen || (next != "") != tt.wantNext {
+				t.Errorf("FetchPage() = %d items, next %q, want %d items, next %v", len(items), next, tt.wantLen, tt.wantNext)
+			}
+		})
+	}
+
+	first, next, _ := FetchPage("", MaxPageSize, DecodeSubmissionCursor, NewSubmissionCursor, fetch)
+	rest, last, err := FetchPage(next, MaxPageSize, DecodeSubmissionCursor, NewSubmissionCursor, fetch)
+	if err != nil {
+		t.Fatalf("FetchPage() second page error = %v", err)
+	}
+	if len(first)+len(rest) != len(submissions) || last != "" {
+		t.Errorf("FetchPage() pages = %d + %d items, next %q, want %d items and no next page", len(first), len(rest), last, len(submissions))
+	}
+
+	if _, _, err := FetchPage("!!!", 0, DecodeSubmissionCursor, NewSubmissionCursor, fetch); err != ErrInvalidCursor {
+		t.Errorf("FetchPage() invalid cursor error = %v, want %v", err, ErrInvalidCursor)
+	}
+}
@@ -0,0 +1,91 @@
+package domain
+
+import "errors"
+
+type PrerequisiteMode string
+
+const (
+	PrerequisiteModeAll PrerequisiteMode = "all" // すべての前提問題を解く必要がある
+	PrerequisiteModeAny PrerequisiteMode = "any" // いずれかの前提問題を解けばよい
+)
+
+var (
+	ErrChallengeLocked   = errors.New("challenge is locked")
+	ErrPrerequisiteCycle = errors.New("prerequisites must not form a cycle")
+)
+
+// IsUnlocked は solved (解いた問題IDの集合) に対して前提条件を満たしているかを返す
+func (c *Challenge) IsUnlocked(solved map[string]bool) bool {
+	if len(c.Prerequisites) == 0 {
+		return true
+	}
+
+	if c.PrerequisiteMode == PrerequisiteModeAny {
+		for _, id := range c.Prerequisites {
+			if solved[id] {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, id := range c.Prerequisites {
+		if !solved[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidatePrerequisites は challenge を challenges に反映したときの前提問題の依存関係を検証する
+// 存在しない問題や自身を前提にした場合は ErrInvalidChallengeData、循環する場合は ErrPrerequisiteCycle を返す
+func ValidatePrerequisites(challenge *Challenge, challenges []*Challenge) error {
+	switch challenge.PrerequisiteMode {
+	case PrerequisiteModeAll, PrerequisiteModeAny:
+	default:
+		return ErrInvalidChallengeData
+	}
+
+	graph := make(map[string][]string, len(challenges)+1)
+	for _, c := range challenges {
+		graph[c.ChallengeID] = c.Prerequisites
+	}
+	graph[challenge.ChallengeID] = challenge.Prerequisites
+
+	seen := make(map[string]bool, len(challenge.Prerequisites))
+	for _, id := range challenge.Prerequisites {
+		if _, exists := graph[id]; !exists || id == challenge.ChallengeID || seen[id] {
+			return ErrInvalidChallengeData
+		}
+		seen[id] = true
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(graph))
+	var visit func(id string) bool
+	visit = func(id string) bool {
+		switch state[id] {
+		case visiting:
+			return false
+		case visited:
+			return true
+		}
+		state[id] = visiting
+		for _, next := range graph[id] {
+			if !visit(next) {
+				return false
+			}
+		}
+		state[id] = visited
+		return true
+	}
+
+	if !visit(challenge.ChallengeID) {
+		return ErrPrerequisiteCycle
+	}
+	return nil
+}
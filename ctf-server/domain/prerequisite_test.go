@@ -0,0 +1,57 @@
+package domain
+
+import "testing"
+
+func TestChallenge_IsUnlocked(t *testing.T) {
+	solved := map[string]bool{"a": true}
+
+	tests := []struct {
+		name      string
+		challenge Challenge
+		want      bool
+	}{
+		{name: "no prerequisites", challenge: Challenge{}, want: true},
+		{name: "all solved", challenge: Challenge{Prerequisites: []string{"a"}, PrerequisiteMode: PrerequisiteModeAll}, want: true},
+		{name: "all partially solved", challenge: Challenge{Prerequisites: []string{"a", "b"}, PrerequisiteMode: PrerequisiteModeAll}, want: false},
+		{name: "any partially solved", challenge: Challenge{Prerequisites: []string{"a", "b"}, PrerequisiteMode: PrerequisiteModeAny}, want: true},
+		{name: "any unsolved", challenge: Challenge{Prerequisites: []string{"b", "c"}, PrerequisiteMode: PrerequisiteModeAny}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.challenge.IsUnlocked(solved); got != tt.want {
+				t.Errorf("Challenge.IsUnlocked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePrerequisites(t *testing.T) {
+	// a <- b <- c (c は b を、b は a を前提にする)
+	challenges := []*Challenge{
+		{ChallengeID: "a"},
+		{ChallengeID: "b", Prerequisites: []string{"a"}},
+		{ChallengeID: "c", Prerequisites: []string{"b"}},
+	}
+
+	tests := []struct {
+		name      string
+		challenge *Challenge
+		wantErr   error
+	}{
+		{name: "new challenge", challenge: &Challenge{ChallengeID: "d", Prerequisites: []string{"c"}, PrerequisiteMode: PrerequisiteModeAll}, wantErr: nil},
+		{name: "any mode", challenge: &Challenge{ChallengeID: "d", Prerequisites: []string{"a", "c"}, PrerequisiteMode: PrerequisiteModeAny}, wantErr: nil},
+		{name: "cycle", challenge: &Challenge{ChallengeID: "a", Prerequisites: []string{"c"}, PrerequisiteMode: PrerequisiteModeAll}, wantErr: ErrPrerequisiteCycle},
+		{name: "self reference", challenge: &Challenge{ChallengeID: "a", Prerequisites: []string{"a"}, PrerequisiteMode: PrerequisiteModeAll}, wantErr: ErrInvalidChallengeData},
+		{name: "unknown prerequisite", challenge: &Challenge{ChallengeID: "d", Prerequisites: []string{"x"}, PrerequisiteMode: PrerequisiteModeAll}, wantErr: ErrInvalidChallengeData},
+		{name: "invalid mode", challenge: &Challenge{ChallengeID: "d", PrerequisiteMode: "some"}, wantErr: ErrInvalidChallengeData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidatePrerequisites(tt.challenge, challenges); err != tt.wantErr {
+				t.Errorf("ValidatePrerequisites() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
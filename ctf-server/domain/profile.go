@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MaxEmailLength       = 255
+	MaxDisplayNameLength = 64
+	MaxAffiliationLength = 128
+	MinPasswordLength    = 8
+	MaxPasswordLength    = 72 // bcrypt は72バイトを超える部分を無視する
+)
+
+var (
+	ErrInvalidEmail       = errors.New("invalid email address")
+	ErrEmailAlreadyExists = errors.New("email already exists")
+	ErrInvalidDisplayName = errors.New("display name must be at most 64 characters without control characters")
+	ErrInvalidAffiliation = errors.New("affiliation must be at most 128 characters without control characters")
+	ErrInvalidCountry     = errors.New("country must be an ISO 3166-1 alpha-2 code")
+	ErrPasswordTooShort   = errors.New("password must be at least 8 characters")
+	ErrPasswordTooLong    = errors.New("password must be at most 72 bytes")
+	ErrPasswordUnchanged  = errors.New("new password must differ from the current password")
+)
+
+// UserProfile はユーザー自身が編集できるプロフィール。すべて空にできる
+type UserProfile struct {
+	Email       string
+	DisplayName string
+	Affiliation string
+	Country     string // ISO 3166-1 alpha-2 の国コード
+}
+
+// Normalize は前後の空白を取り除き、国コードを大文字にそろえる
+func (p *UserProfile) Normalize() {
+	p.Email = strings.TrimSpace(p.Email)
+	p.DisplayName = strings.TrimSpace(p.DisplayName)
+	p.Affiliation = strings.TrimSpace(p.Affiliation)
+	p.Country = strings.ToUpper(strings.TrimSpace(p.Country))
+}
+
+func (p *UserProfile) Validate() error {
+	if p.Email != "" {
+		if len(p.Email) > MaxEmailLength {
+			return ErrInvalidEmail
+		}
+		// 表示名付きの形式は受け付けない
+		addr, err := mail.ParseAddress(p.Email)
+		if err != nil || addr.Address != p.Email {
+			return ErrInvalidEmail
+		}
+	}
+	if !isValidProfileText(p.DisplayName, MaxDisplayNameLength) {
+		return ErrInvalidDisplayName
+	}
+	if !isValidProfileText(p.Affiliation, MaxAffiliationLength) {
+		return ErrInvalidAffiliation
+	}
+	if p.Country != "" && !isCountryCode(p.Country) {
+		return ErrInvalidCountry
+	}
+	return nil
+}
+
+func isValidProfileText(s string, maxLength int) bool {
+	if !utf8.ValidString(s) || utf8.RuneCountInString(s) > maxLength {
+		return false
+	}
+	for _, r := range s {
+		if unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isCountryCode は2文字の英大文字かを返す。割り当て済みのコードかまでは確認しない
+func isCountryCode(s string) bool {
+	if len(s) != 2 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidatePassword は登録とパスワードの変更で使うパスワードの長さを確認する
+func ValidatePassword(password string) error {
+	if utf8.RuneCountInString(password) < MinPasswordLength {
+		return ErrPasswordTooShort
+	}
+	if len(password) > MaxPasswordLength {
+		return ErrPasswordTooLong
+	}
+	return nil
+}
+
+// Profile はユーザーのプロフィールを返す
+func (u *User) Profile() *UserProfile {
+	return &UserProfile{
+		Email:       u.Email,
+		DisplayName: u.DisplayName,
+		Affiliation: u.Affiliation,
+		Country:     u.Country,
+	}
+}
+
+// SetProfile はプロフィールを profile の内容に置き換える
+func (u *User) SetProfile(profile *UserProfile) {
+	u.Email = profile.Email
+	u.DisplayName = profile.DisplayName
+	u.Affiliation = profile.Affiliation
+	u.Country = profile.Country
+}
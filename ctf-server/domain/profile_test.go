@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUserProfile_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile UserProfile
+		want    UserProfile
+		wantErr error
+	}{
+		{
+			name:    "empty profile",
+			profile: UserProfile{},
+			want:    UserProfile{},
+		},
+		{
+			name:    "normalized",
+			profile: UserProfile{Email: " alice@example.com ", DisplayName: " Alice ", Affiliation: "東京大学", Country: "jp"},
+			want:    UserProfile{Email: "alice@example.com", DisplayName: "Alice", Affiliation: "東京大学", Country: "JP"},
+		},
+		{
+			name:    "email without domain",
+			profile: UserProfile{Email: "alice"},
+			wantErr: ErrInvalidEmail,
+		},
+		{
+			name:    "email with display name",
+			profile: UserProfile{Email: "Alice <alice@example.com>"},
+			wantErr: ErrInvalidEmail,
+		},
+		{
+			name:    "display name with 64 characters",
+			profile: UserProfile{DisplayName: strings.Repeat("あ", 64)},
+			want:    UserProfile{DisplayName: strings.Repeat("あ", 64)},
+		},
+		{
+			name:    "display name too long",
+			profile: UserProfile{DisplayName: strings.Repeat("a", 65)},
+			wantErr: ErrInvalidDisplayName,
+		},
+		{
+			name:    "display name with control character",
+			profile: UserProfile{DisplayName: "ali\x00ce"},
+			wantErr: ErrInvalidDisplayName,
+		},
+		{
+			name:    "affiliation too long",
+			profile: UserProfile{Affiliation: strings.Repeat("a", 129)},
+			wantErr: ErrInvalidAffiliation,
+		},
+		{
+			name:    "country name instead of code",
+			profile: UserProfile{Country: "Japan"},
+			wantErr: ErrInvalidCountry,
+		},
+		{
+			name:    "country code with digits",
+			profile: UserProfile{Country: "J1"},
+			wantErr: ErrInvalidCountry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.profile
+			p.Normalize()
+			err := p.Validate()
+			if err != tt.wantErr {
+				t.Fatalf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && p != tt.want {
+				t.Errorf("Normalize() = %+v, want %+v", p, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		wantErr  error
+	}{
+		{name: "8 characters", password: "abcdefgh"},
+		{name: "7 characters", password: "abcdefg", wantErr: ErrPasswordTooShort},
+		{name: "multibyte characters are counted as one", password: "パスワードです", wantErr: ErrPasswordTooShort},
+		{name: "72 bytes", password: strings.Repeat("a", 72)},
+		{name: "73 bytes", password: strings.Repeat("a", 73), wantErr: ErrPasswordTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidatePassword(tt.password); err != tt.wantErr {
+				t.Errorf("ValidatePassword() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
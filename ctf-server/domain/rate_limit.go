@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RateLimiter は key ごとに一定時間内の試行回数を制限する
+type RateLimiter interface {
+	// Allow は試行を記録する。制限を超えている場合は記録せず、再試行できるまでの時間を返す
+	Allow(ctx context.Context, key string) (bool, time.Duration, error)
+}
+
+// RateLimitedError は試行回数の制限を超えたことを表す
+type RateLimitedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("too many attempts, retry after %s", e.RetryAfter.Round(time.Second))
+}
@@ -0,0 +1,78 @@
+package domain
+
+import "errors"
+
+// Role は運営アカウントの役割。空文字列は一般の参加者
+type Role string
+
+const (
+	RoleNone       Role = ""
+	RoleSuperadmin Role = "superadmin" // すべての操作ができる
+	RoleAuthor     Role = "author"     // 自分が作成した問題のみ管理できる
+	RoleSupport    Role = "support"    // ユーザーと提出の閲覧のみできる
+)
+
+type Permission string
+
+const (
+	PermissionManageOwnChallenges Permission = "manage_own_challenges" // 問題の作成と、自分が作成した問題の編集
+	PermissionManageAllChallenges Permission = "manage_all_challenges" // 他人が作成した問題の編集
+	PermissionViewUsers           Permission = "view_users"
+	PermissionManageUsers         Permission = "manage_users" // 利用停止・パスワードの再設定・アカウントの削除
+	PermissionViewSubmissions     Permission = "view_submissions"
+	PermissionManageSubmissions   Permission = "manage_submissions"
+	PermissionManageEvent         Permission = "manage_event" // イベント設定とお知らせ
+	PermissionManageStaff         Permission = "manage_staff"
+	PermissionViewAuditLog        Permission = "view_audit_log"
+	PermissionViewLiveScoreboard  Permission = "view_live_scoreboard" // 凍結中の最新の順位
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleSuperadmin: {
+		PermissionManageOwnChallenges,
+		PermissionManageAllChallenges,
+		PermissionViewUsers,
+		PermissionManageUsers,
+		PermissionViewSubmissions,
+		PermissionManageSubmissions,
+		PermissionManageEvent,
+		PermissionManageStaff,
+		PermissionViewAuditLog,
+		PermissionViewLiveScoreboard,
+	},
+	RoleAuthor: {
+		PermissionManageOwnChallenges,
+	},
+	RoleSupport: {
+		PermissionViewUsers,
+		PermissionViewSubmissions,
+	},
+}
+
+var (
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidRole      = errors.New("invalid role")
+)
+
+// IsStaff は管理画面を使える役割かを返す
+func (r Role) IsStaff() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+func (r Role) HasPermission(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// CanManageChallenge は問題を編集できるかを返す。作成者が記録されていない問題は全体の管理権限が必要
+func (u *User) CanManageChallenge(challenge *Challenge) bool {
+	if u.Role.HasPermission(PermissionManageAllChallenges) {
+		return true
+	}
+	return u.Role.HasPermission(PermissionManageOwnChallenges) && challenge.AuthorID != "" && challenge.AuthorID == u.UserID
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+type ScoreboardEntry struct {
+	Rank        int
+	UserID      string
+	Username    string
+	TeamID      string
+	TeamName    string
+	Score       int
+	SolveCount  int
+	LastSolveAt time.Time
+}
+
+// RankScoreboard は得点の降順、同点の場合は最終正解時刻が早い順に並べて順位を付ける
+func RankScoreboard(entries []*ScoreboardEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		if !entries[i].LastSolveAt.Equal(entries[j].LastSolveAt) {
+			return entries[i].LastSolveAt.Before(entries[j].LastSolveAt)
+		}
+		if entries[i].TeamID != entries[j].TeamID {
+			return entries[i].TeamID < entries[j].TeamID
+		}
+		return entries[i].UserID < entries[j].UserID
+	})
+
+	for i, e := range entries {
+		e.Rank = i + 1
+	}
+}
+
+// OwnerID はエントリーの主体のIDを返す。チームのスコアボードではチームID
+func (e *ScoreboardEntry) OwnerID() string {
+	if e.TeamID != "" {
+		return e.TeamID
+	}
+	return e.UserID
+}
+
+// ScoreEvent は得点の増減を表す。ヒントの公開は負の Points を持つ
+type ScoreEvent struct {
+	OwnerID    string
+	Points     int
+	OccurredAt time.Time
+}
+
+type ScorePoint struct {
+	At    time.Time
+	Score int
+}
+
+// ScoreHistory はスコアボードのエントリーと、その累計得点の推移を表す
+type ScoreHistory struct {
+	Entry  *ScoreboardEntry
+	Points []*ScorePoint
+}
+
+// BuildScoreHistory は時刻順に並んだ events を entries ごとに累積し、得点の推移を返す
+// 同じ時刻の複数のイベントは1つの点にまとめる
+func BuildScoreHistory(entries []*ScoreboardEntry, events []*ScoreEvent) []*ScoreHistory {
+	histories := make([]*ScoreHistory, 0, len(entries))
+	byOwner := make(map[string]*ScoreHistory, len(entries))
+	for _, e := range entries {
+		h := &ScoreHistory{Entry: e, Points: []*ScorePoint{}}
+		histories = append(histories, h)
+		byOwner[e.OwnerID()] = h
+	}
+
+	scores := make(map[string]int, len(entries))
+	for _, ev := range events {
+		h, ok := byOwner[ev.OwnerID]
+		if !ok {
+			continue
+		}
+
+		scores[ev.OwnerID] += ev.Points
+		if n := len(h.Points); n > 0 && h.Points[n-1].At.Equal(ev.OccurredAt) {
+			h.Points[n-1].Score = scores[ev.OwnerID]
+			continue
+		}
+		h.Points = append(h.Points, &ScorePoint{At: ev.OccurredAt, Score: scores[ev.OwnerID]})
+	}
+
+	return histories
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildScoreHistory(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	entries := []*ScoreboardEntry{
+		{Rank: 1, UserID: "user-a"},
+		{Rank: 2, TeamID: "team-b"},
+	}
+	events := []*ScoreEvent{
+		{OwnerID: "user-a", Points: 100, OccurredAt: base},
+		{OwnerID: "team-b", Points: 200, OccurredAt: base.Add(time.Minute)},
+		{OwnerID: "user-a", Points: 300, OccurredAt: base.Add(2 * time.Minute)},
+		{OwnerID: "user-a", Points: -50, OccurredAt: base.Add(2 * time.Minute)},
+		{OwnerID: "user-x", Points: 999, OccurredAt: base.Add(3 * time.Minute)},
+	}
+
+	histories := BuildScoreHistory(entries, events)
+	if len(histories) != 2 {
+		t.Fatalf("BuildScoreHistory() returned %d histories, want 2", len(histories))
+	}
+
+	tests := []struct {
+		owner      string
+		wantScores []int
+	}{
+		{owner: "user-a", wantScores: []int{100, 350}},
+		{owner: "team-b", wantScores: []int{200}},
+	}
+
+	for i, tt := range tests {
+		h := histories[i]
+		if h.Entry.OwnerID() != tt.owner {
+			t.Errorf("BuildScoreHistory()[%d] owner = %v, want %v", i, h.Entry.OwnerID(), tt.owner)
+		}
+		if len(h.Points) != len(tt.wantScores) {
+			t.Errorf("BuildScoreHistory()[%d] returned %d points, want %d", i, len(h.Points), len(tt.wantScores))
+			continue
+		}
+		for j, p := range h.Points {
+			if p.Score != tt.wantScores[j] {
+				t.Errorf("BuildScoreHistory()[%d].Points[%d].Score = %v, want %v", i, j, p.Score, tt.wantScores[j])
+			}
+		}
+	}
+}
@@ -9,21 +9,156 @@ import (
 type Submission struct {
 	SubmissionID  string
 	UserID        string
+	TeamID        string
 	ChallengeID   string
 	SubmittedFlag string
 	IsCorrect     bool
-	SubmittedAt   time.Time
+	PartID        string // 部分フラグに正解したが問題を解き終えていない場合に設定する
+	// CompletedPartID は最後の部分フラグに正解して問題を解き終えた提出に設定する
+	CompletedPartID string
+	SolveRank       int // 問題を何番目に解いたか。解いた提出以外は0
+	SubmittedAt     time.Time
+	InvalidatedAt   time.Time // 運営が正解を取り消した日時。取り消されていない場合はゼロ値
+}
+
+// OwnerID は問題を解いた主体のIDを返す。チームで提出した場合はチームID
+func (s *Submission) OwnerID() string {
+	if s.TeamID != "" {
+		return s.TeamID
+	}
+	return s.UserID
+}
+
+// IsSolve は問題を解いたことを表す提出かを返す
+func (s *Submission) IsSolve() bool {
+	return s.IsCorrect && s.PartID == ""
+}
+
+// ChallengeSolveStats は問題ごとの正解状況を表す
+type ChallengeSolveStats struct {
+	ChallengeID string
+	SolveCount  int
+	SolvedByMe  bool
+	FirstBlood  *FirstBlood // 誰も解いていない場合は nil
+}
+
+// FirstBlood は問題を最初に解いたユーザー(チームで解いた場合はチーム)を表す
+type FirstBlood struct {
+	UserID   string
+	Username string
+	TeamID   string
+	TeamName string
+	SolvedAt time.Time
+}
+
+// SubmissionResult は提出の検索で正解・不正解を絞り込む条件。空の場合は絞り込まない
+type SubmissionResult string
+
+const (
+	SubmissionResultCorrect   SubmissionResult = "correct"
+	SubmissionResultIncorrect SubmissionResult = "incorrect"
+)
+
+type SubmissionOrder string
+
+const (
+	SubmissionOrderNewestFirst SubmissionOrder = "newest_first"
+	SubmissionOrderOldestFirst SubmissionOrder = "oldest_first"
+)
+
+// SubmissionFilter は提出の検索条件。空のフィールドは条件に含めない
+type SubmissionFilter struct {
+	UserID      string
+	ChallengeID string
+	Result      SubmissionResult
+	Since       time.Time // Since 以降に提出されたもの
+	Until       time.Time // Until より前に提出されたもの
+	Order       SubmissionOrder
+	After       *SubmissionCursor // 並び順でこのカーソルより後の提出のみを返す
+	Limit       int
+}
+
+// SubmissionCursor は提出一覧のページの位置を表す。提出日時とIDの組で並び順が一意に決まる
+type SubmissionCursor struct {
+	SubmittedAt  time.Time
+	SubmissionID string
+}
+
+func NewSubmissionCursor(submission *Submission) *SubmissionCursor {
+	return &SubmissionCursor{SubmittedAt: submission.SubmittedAt, SubmissionID: submission.SubmissionID}
+}
+
+// Encode はクライアントに渡す文字列に変換する
+func (c *SubmissionCursor) Encode() string {
+	return encodeCursor(c.SubmittedAt, c.SubmissionID)
+}
+
+func DecodeSubmissionCursor(cursor string) (*SubmissionCursor, error) {
+	submittedAt, id, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &SubmissionCursor{SubmittedAt: submittedAt, SubmissionID: id}, nil
+}
+
+// SubmissionPage は提出一覧の1ページ。表示用に問題名とユーザー名を引けるようにする
+type SubmissionPage struct {
+	Submissions    []*Submission
+	NextCursor     string // 次のページがない場合は空
+	ChallengeNames map[string]string
+	Usernames      map[string]string
 }
 
 var (
-	ErrSubmissionNotFound = errors.New("submission not found")
-	ErrIncorrectFlag      = errors.New("incorrect flag")
+	ErrSubmissionNotFound   = errors.New("submission not found")
+	ErrIncorrectFlag        = errors.New("incorrect flag")
+	ErrAlreadySolved        = errors.New("challenge already solved")
+	ErrSubmissionNotCorrect = errors.New("submission is not correct")
+	ErrInvalidCursor        = errors.New("invalid cursor")
 )
 
 type SubmissionRepository interface {
 	Create(ctx context.Context, submission *Submission) error
+	// CreateSolve は問題を解いた提出を記録し、同時に解かれても重複しない順位を SolveRank に設定する
+	// 同じ主体(チームモードではチーム)がすでに解いている場合は何も記録せず ErrAlreadySolved を返す
+	// dynamic scoring の問題の得点は同じトランザクションで再計算する
+	CreateSolve(ctx context.Context, submission *Submission) error
+	// CreatePartSolve は部分フラグの正解を記録し、すべての部分を解き終えた場合は CreateSolve と同じく問題を解いた提出として記録する
+	// 解き終えたかどうかは問題の行をロックしてから判定し、解き終えていなければ submission.PartID を設定する
+	// 同じ主体がその部分をすでに解いている場合は何も記録せず ErrAlreadySolved を返す
+	CreatePartSolve(ctx context.Context, submission *Submission, solve *FlagPartSolve) error
 	FindByID(ctx context.Context, submissionID string) (*Submission, error)
 	FindByUserID(ctx context.Context, userID string) ([]*Submission, error)
 	FindByChallengeID(ctx context.Context, challengeID string) ([]*Submission, error)
 	FindByUserAndChallenge(ctx context.Context, userID, challengeID string) ([]*Submission, error)
+	FindByTeamAndChallenge(ctx context.Context, teamID, challengeID string) ([]*Submission, error)
+	// FindSolvedChallengeIDs は解いた問題IDの集合を返す。teamID が空でない場合はチームで解いた問題を返す
+	FindSolvedChallengeIDs(ctx context.Context, userID, teamID string) (map[string]bool, error)
+	// CountSolves は問題を解いたユーザー数を返す。チームで提出されたものはチーム単位で数える。利用停止中のユーザーは数えない
+	CountSolves(ctx context.Context, challengeID string) (int, error)
+	// Rescore は利用停止中のユーザーを除いて解いた順位を振り直し、dynamic scoring の問題の得点を正解数から再計算する
+	// 正解や取り消しと同時に呼ばれても古い値で上書きしない。利用停止中のユーザーの正解は順位を持たない
+	Rescore(ctx context.Context, challengeID string) error
+	// GetChallengeSolveStats は問題ごとの正解数・最初の正解者と、userID (teamID が空でない場合はチーム) が解いたかを1クエリで返す
+	// 利用停止中のユーザーの正解は正解数と最初の正解者に含めない
+	// 正解数と最初の正解者は until より前の正解のみを集計する。until がゼロ値の場合は全件
+	GetChallengeSolveStats(ctx context.Context, userID, teamID string, until time.Time) (map[string]*ChallengeSolveStats, error)
+	// GetScoreboard は until より前の正解のみを集計する。until がゼロ値の場合は全件
+	// points に含まれる問題は現在の得点の代わりにその得点で集計する。凍結時点の dynamic scoring の得点を渡すために使う
+	// 利用停止中のユーザーの正解は、チームのスコアボードでも集計しない
+	GetScoreboard(ctx context.Context, until time.Time, points map[string]int) ([]*ScoreboardEntry, error)
+	GetTeamScoreboard(ctx context.Context, until time.Time, points map[string]int) ([]*ScoreboardEntry, error)
+	// FindScoreEvents は userIDs の得点の増減を時刻順に返す。集計の対象は GetScoreboard と同じ
+	FindScoreEvents(ctx context.Context, userIDs []string, until time.Time, points map[string]int) ([]*ScoreEvent, error)
+	// FindTeamScoreEvents は teamIDs の得点の増減を時刻順に返す。集計の対象は GetTeamScoreboard と同じ
+	FindTeamScoreEvents(ctx context.Context, teamIDs []string, until time.Time, points map[string]int) ([]*ScoreEvent, error)
+	// FindSharedIncorrect は複数の主体から提出された誤答をすべて返す
+	FindSharedIncorrect(ctx context.Context) ([]*Submission, error)
+	// FindSolves は主体ごと・問題ごとの最初の正解を返す
+	FindSolves(ctx context.Context) ([]*Submission, error)
+	// FindSubmissions は filter に一致する提出を filter.Order の順に最大 filter.Limit 件返す
+	FindSubmissions(ctx context.Context, filter *SubmissionFilter) ([]*Submission, error)
+	// Invalidate は正解の提出を不正解として扱うよう取り消し、得点と順位の集計から外す
+	// 問題を解いた提出の場合は、それより後に解いた主体の順位を1つずつ繰り上げ、dynamic scoring の得点を再計算する
+	Invalidate(ctx context.Context, submissionID string, invalidatedAt time.Time) error
 }
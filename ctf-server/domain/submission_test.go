@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSubmissionCursor_EncodeDecode(t *testing.T) {
+	cursor := &SubmissionCursor{
+		SubmittedAt:  time.Unix(1700000000, 123),
+		SubmissionID: "b3f1c2d4-0000-4000-8000-000000000000",
+	}
+
+	decoded, err := DecodeSubmissionCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("DecodeSubmissionCursor() error = %v", err)
+	}
+	if !decoded.SubmittedAt.Equal(cursor.SubmittedAt) || decoded.SubmissionID != cursor.SubmissionID {
+		t.Errorf("DecodeSubmissionCursor() = %+v, want %+v", decoded, cursor)
+	}
+}
+
+func TestDecodeSubmissionCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "!!!"},
+		{name: "missing separator", cursor: "MTIz"},
+		{name: "missing id", cursor: "MTIzOg"},
+		{name: "non numeric time", cursor: "YWJjOmlk"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeSubmissionCursor(tt.cursor); err != ErrInvalidCursor {
+				t.Errorf("DecodeSubmissionCursor() error = %v, want %v", err, ErrInvalidCursor)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type Team struct {
+	TeamID     string
+	Name       string
+	InviteCode string
+	Members    []*TeamMember
+	CreatedAt  time.Time
+}
+
+type TeamMember struct {
+	UserID   string
+	Username string
+	JoinedAt time.Time
+}
+
+var (
+	ErrTeamNotFound      = errors.New("team not found")
+	ErrTeamAlreadyExists = errors.New("team already exists")
+	ErrInvalidTeamName   = errors.New("invalid team name")
+	ErrAlreadyInTeam     = errors.New("user already belongs to a team")
+	ErrNotInTeam         = errors.New("user does not belong to a team")
+	ErrInvalidInviteCode = errors.New("invalid invite code")
+)
+
+type TeamRepository interface {
+	// Create はチームと作成者のメンバー登録を1つのトランザクションで行う
+	// 作成者がすでにいずれかのチームに所属している場合は何も登録せず ErrAlreadyInTeam を返す
+	Create(ctx context.Context, team *Team, ownerID string) error
+	FindByID(ctx context.Context, teamID string) (*Team, error)
+	FindByName(ctx context.Context, name string) (*Team, error)
+	FindByInviteCode(ctx context.Context, inviteCode string) (*Team, error)
+	// FindByUserID はユーザーが所属するチームを返す
+	FindByUserID(ctx context.Context, userID string) (*Team, error)
+	FindMembers(ctx context.Context, teamID string) ([]*TeamMember, error)
+	// AddMember はチームが削除されていた場合は ErrTeamNotFound、すでにチームに所属している場合は ErrAlreadyInTeam を返す
+	AddMember(ctx context.Context, teamID, userID string, joinedAt time.Time) error
+	// RemoveMember はメンバーを削除し、最後のメンバーだった場合はチームも削除する
+	// チームの行をロックするため、同時に参加したメンバーがチームごと削除されることはない
+	RemoveMember(ctx context.Context, teamID, userID string) error
+}
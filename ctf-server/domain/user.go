@@ -9,11 +9,22 @@ import (
 type User struct {
 	UserID       string
 	Username     string
+	Email        string // 設定していない場合は空
+	DisplayName  string
+	Affiliation  string
+	Country      string
 	PasswordHash string
+	Role         Role      // 管理画面を使うにはセッションでも管理者モードを有効にする
+	BannedAt     time.Time // 運営が利用を停止した日時。停止されていない場合はゼロ値
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
 
+// IsBanned は利用停止中かを返す。利用停止中はログインできず、スコアボードにも表示しない
+func (u *User) IsBanned() bool {
+	return !u.BannedAt.IsZero()
+}
+
 type Session struct {
 	SessionID string
 	UserID    string
@@ -30,8 +41,56 @@ var (
 	ErrSessionNotFound       = errors.New("session not found")
 	ErrSessionExpired        = errors.New("session expired")
 	ErrInvalidActivationCode = errors.New("invalid activation code")
+	ErrAdminAlreadyExists    = errors.New("admin already exists")
+	ErrLastAdmin             = errors.New("cannot revoke the last superadmin")
+	ErrUserBanned            = errors.New("user is banned")
+	ErrUserIsStaff           = errors.New("revoke the user's role first")
 )
 
+// UserFilter はユーザーの検索条件。登録日時の新しい順に並べる
+type UserFilter struct {
+	Query string      // ユーザー名の部分一致。空の場合は絞り込まない
+	After *UserCursor // 並び順でこのカーソルより後のユーザーのみを返す
+	Limit int
+}
+
+// UserCursor はユーザー一覧のページの位置を表す。登録日時とIDの組で並び順が一意に決まる
+type UserCursor struct {
+	CreatedAt time.Time
+	UserID    string
+}
+
+func NewUserCursor(user *User) *UserCursor {
+	return &UserCursor{CreatedAt: user.CreatedAt, UserID: user.UserID}
+}
+
+// Encode はクライアントに渡す文字列に変換する
+func (c *UserCursor) Encode() string {
+	return encodeCursor(c.CreatedAt, c.UserID)
+}
+
+func DecodeUserCursor(cursor string) (*UserCursor, error) {
+	createdAt, id, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &UserCursor{CreatedAt: createdAt, UserID: id}, nil
+}
+
+// UserPage はユーザー一覧の1ページ
+type UserPage struct {
+	Users      []*User
+	NextCursor string // 次のページがない場合は空
+}
+
+// UserDetail は管理画面で表示するユーザーの詳細。表示用に問題名を引けるようにする
+type UserDetail struct {
+	User           *User
+	Solves         []*Submission
+	Instances      []*Instance
+	ChallengeNames map[string]string
+}
+
 func (s *Session) IsExpired() bool {
 	return time.Now().After(s.ExpiresAt)
 }
@@ -40,8 +99,22 @@ type UserRepository interface {
 	Create(ctx context.Context, user *User) error
 	FindByID(ctx context.Context, userID string) (*User, error)
 	FindByUsername(ctx context.Context, username string) (*User, error)
-	Update(ctx context.Context, user *User) error
+	FindByEmail(ctx context.Context, email string) (*User, error)
+	// UpdateProfile はメールアドレスと表示名・所属・国だけを更新する。メールアドレスが他のユーザーと重複する場合は ErrEmailAlreadyExists を返す
+	UpdateProfile(ctx context.Context, user *User) error
+	// UpdatePassword はパスワードのハッシュだけを更新する
+	UpdatePassword(ctx context.Context, userID, passwordHash string) error
 	Delete(ctx context.Context, userID string) error
+	// FindStaff は役割を持つユーザーを返す
+	FindStaff(ctx context.Context) ([]*User, error)
+	// SetRole は役割を変更する。RoleNone で役割を外す。最後の1人の superadmin を外す場合は ErrLastAdmin を返す
+	SetRole(ctx context.Context, userID string, role Role) error
+	// BootstrapAdmin は superadmin が1人もいない場合のみ userID を superadmin にする。既にいる場合は ErrAdminAlreadyExists を返す
+	BootstrapAdmin(ctx context.Context, userID string) error
+	// FindUsers は filter に一致するユーザーを最大 filter.Limit 件返す
+	FindUsers(ctx context.Context, filter *UserFilter) ([]*User, error)
+	// SetBanned は利用停止の日時を設定する。ゼロ値で利用停止を解除する
+	SetBanned(ctx context.Context, userID string, bannedAt time.Time) error
 }
 
 type SessionRepository interface {
@@ -50,4 +123,8 @@ type SessionRepository interface {
 	Update(ctx context.Context, session *Session) error
 	Delete(ctx context.Context, token string) error
 	DeleteByUserID(ctx context.Context, userID string) error
+	// DeleteOthersByUserID は userID のセッションのうち sessionID 以外をすべて削除する
+	DeleteOthersByUserID(ctx context.Context, userID, sessionID string) error
+	// RevokeAdminByUserID は userID のすべてのセッションの管理者モードを解除する
+	RevokeAdminByUserID(ctx context.Context, userID string) error
 }
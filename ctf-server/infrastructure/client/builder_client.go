@@ -162,6 +162,24 @@ func (c *BuilderClient) GetBuildResult(ctx context.Context, jobID string) (*Buil
 	return &result, nil
 }
 
+// GetBuildJobInfo はビルドジョブの情報を返す。見つからない場合は nil を返す
+func (c *BuilderClient) GetBuildJobInfo(ctx context.Context, jobID string) (*BuildJobInfo, error) {
+	data, err := c.redisClient.Get(ctx, BuildJobInfoKey+jobID).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job info: %w", err)
+	}
+
+	var info BuildJobInfo
+	if err := json.Unmarshal([]byte(data), &info); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal job info: %w", err)
+	}
+
+	return &info, nil
+}
+
 func (c *BuilderClient) SubscribeBuildLogs(ctx context.Context, jobID string, callback func(logLine string)) error {
 	channel := BuildLogChannel + jobID
 	pubsub := c.redisClient.Subscribe(ctx, channel)
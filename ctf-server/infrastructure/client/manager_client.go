@@ -43,10 +43,12 @@ func (c *ManagerClient) Close() error {
 	return nil
 }
 
-func (c *ManagerClient) StartInstance(ctx context.Context, imageTag string, ttlSeconds int64) (string, *pb.ConnectionInfo, error) {
+// StartInstance はインスタンスを起動する。flag が空でない場合はコンテナに埋め込まれる
+func (c *ManagerClient) StartInstance(ctx context.Context, imageTag string, ttlSeconds int64, flag string) (string, *pb.ConnectionInfo, error) {
 	resp, err := c.client.StartInstance(ctx, &pb.StartInstanceRequest{
 		ImageTag:   imageTag,
 		TtlSeconds: ttlSeconds,
+		Flag:       flag,
 	})
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to start instance: %w", err)
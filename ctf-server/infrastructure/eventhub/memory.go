@@ -0,0 +1,63 @@
+package eventhub
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+// subscriberBuffer は購読者ごとに溜めておけるイベントの数
+// 受信が追いつかない購読者へのイベントは捨て、他の購読者への配信を止めない
+const subscriberBuffer = 64
+
+// MemoryEventHub はプロセス内の購読者にイベントを配信する
+// サーバーを複数台で動かす場合は RedisEventHub を使う
+type MemoryEventHub struct {
+	mu          sync.RWMutex
+	subscribers map[chan *domain.LiveEvent]struct{}
+}
+
+func NewMemoryEventHub() *MemoryEventHub {
+	return &MemoryEventHub{
+		subscribers: make(map[chan *domain.LiveEvent]struct{}),
+	}
+}
+
+func (h *MemoryEventHub) Publish(ctx context.Context, event *domain.LiveEvent) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch := range h.subscribers {
+		select {
+		case ch <- event:
+		default:
+			log.Printf("Dropped live event %s for a slow subscriber", event.Type)
+		}
+	}
+	return nil
+}
+
+func (h *MemoryEventHub) Subscribe(ctx context.Context, handler func(event *domain.LiveEvent)) error {
+	ch := make(chan *domain.LiveEvent, subscriberBuffer)
+
+	h.mu.Lock()
+	h.subscribers[ch] = struct{}{}
+	h.mu.Unlock()
+
+	defer func() {
+		h.mu.Lock()
+		delete(h.subscribers, ch)
+		h.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event := <-ch:
+			handler(event)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package eventhub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+func TestMemoryEventHub_PublishSubscribe(t *testing.T) {
+	hub := NewMemoryEventHub()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	received := []chan *domain.LiveEvent{make(chan *domain.LiveEvent, 1), make(chan *domain.LiveEvent, 1)}
+	done := make(chan error, len(received))
+	for _, ch := range received {
+		go func(ch chan *domain.LiveEvent) {
+			done <- hub.Subscribe(ctx, func(event *domain.LiveEvent) { ch <- event })
+		}(ch)
+	}
+	waitSubscribers(t, hub, len(received))
+
+	event := &domain.LiveEvent{Type: domain.LiveEventSolve, ChallengeID: "1"}
+	if err := hub.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	for i, ch := range received {
+		select {
+		case got := <-ch:
+			if got != event {
+				t.Errorf("subscriber %d received %+v, want %+v", i, got, event)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("subscriber %d did not receive the event", i)
+		}
+	}
+
+	cancel()
+	for range received {
+		if err := <-done; err != context.Canceled {
+			t.Errorf("Subscribe() error = %v, want %v", err, context.Canceled)
+		}
+	}
+	waitSubscribers(t, hub, 0)
+}
+
+func TestMemoryEventHub_SlowSubscriber(t *testing.T) {
+	hub := NewMemoryEventHub()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	block := make(chan struct{})
+	go hub.Subscribe(ctx, func(event *domain.LiveEvent) { <-block })
+	waitSubscribers(t, hub, 1)
+
+	// 受信が止まった購読者がいても Publish はブロックしない
+	finished := make(chan struct{})
+	go func() {
+		for i := 0; i < subscriberBuffer*2; i++ {
+			hub.Publish(context.Background(), &domain.LiveEvent{Type: domain.LiveEventSolve})
+		}
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(time.Second):
+		t.Fatal("Publish() blocked on a slow subscriber")
+	}
+	close(block)
+}
+
+func waitSubscribers(t *testing.T, hub *MemoryEventHub, want int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		hub.mu.RLock()
+		n := len(hub.subscribers)
+		hub.mu.RUnlock()
+		if n == want {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("subscribers did not become %d", want)
+}
@@ -0,0 +1,95 @@
+package eventhub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+const liveEventChannel = "live-events"
+
+// RedisEventHub は Redis の Pub/Sub を介して、すべてのサーバーの購読者にイベントを配信する
+// Redis の購読はサーバーごとに1つだけ行い、受け取ったイベントをプロセス内の購読者に配る
+type RedisEventHub struct {
+	redisClient *redis.Client
+	local       *MemoryEventHub
+}
+
+func NewRedisEventHub() (*RedisEventHub, error) {
+	redisAddr := os.Getenv("REDIS_ADDRESS")
+	if redisAddr == "" {
+		redisAddr = "localhost:6379"
+	}
+
+	redisPassword := os.Getenv("REDIS_PASSWORD")
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     redisAddr,
+		Password: redisPassword,
+		DB:       0,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	return &RedisEventHub{
+		redisClient: client,
+		local:       NewMemoryEventHub(),
+	}, nil
+}
+
+func (h *RedisEventHub) Close() error {
+	return h.redisClient.Close()
+}
+
+// Publish はイベントを Redis に送る。自分のサーバーの購読者にも Run を通して配信される
+func (h *RedisEventHub) Publish(ctx context.Context, event *domain.LiveEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := h.redisClient.Publish(ctx, liveEventChannel, payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish live event: %w", err)
+	}
+	return nil
+}
+
+func (h *RedisEventHub) Subscribe(ctx context.Context, handler func(event *domain.LiveEvent)) error {
+	return h.local.Subscribe(ctx, handler)
+}
+
+// Run は ctx が終了するまで Redis からイベントを受け取り、プロセス内の購読者に配る
+func (h *RedisEventHub) Run(ctx context.Context) {
+	pubsub := h.redisClient.Subscribe(ctx, liveEventChannel)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+
+			event := &domain.LiveEvent{}
+			if err := json.Unmarshal([]byte(msg.Payload), event); err != nil {
+				log.Printf("Failed to decode live event: %v", err)
+				continue
+			}
+			h.local.Publish(ctx, event)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryRateLimiter はプロセス内で試行時刻を保持するスライディングウィンドウ方式のレートリミッター
+// サーバーを複数台で動かす場合は RedisRateLimiter を使う
+type MemoryRateLimiter struct {
+	limit  int
+	window time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	attempts  map[string][]time.Time
+	lastSweep time.Time
+}
+
+func NewMemoryRateLimiter(limit int, window time.Duration) *MemoryRateLimiter {
+	return &MemoryRateLimiter{
+		limit:    limit,
+		window:   window,
+		now:      time.Now,
+		attempts: make(map[string][]time.Time),
+	}
+}
+
+func (l *MemoryRateLimiter) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	windowStart := now.Add(-l.window)
+	l.sweep(now, windowStart)
+
+	attempts := pruneBefore(l.attempts[key], windowStart)
+	if len(attempts) >= l.limit {
+		l.attempts[key] = attempts
+		return false, attempts[0].Add(l.window).Sub(now), nil
+	}
+
+	l.attempts[key] = append(attempts, now)
+	return true, 0, nil
+}
+
+// sweep はウィンドウ1つ分ごとに、期限切れの試行しか残っていないキーを削除する
+func (l *MemoryRateLimiter) sweep(now, windowStart time.Time) {
+	if now.Sub(l.lastSweep) < l.window {
+		return
+	}
+	l.lastSweep = now
+
+	for key, attempts := range l.attempts {
+		if len(attempts) == 0 || !attempts[len(attempts)-1].After(windowStart) {
+			delete(l.attempts, key)
+		}
+	}
+}
+
+// pruneBefore は時刻順に並んだ attempts から windowStart 以前のものを取り除く
+func pruneBefore(attempts []time.Time, windowStart time.Time) []time.Time {
+	i := 0
+	for i < len(attempts) && !attempts[i].After(windowStart) {
+		i++
+	}
+	return attempts[i:]
+}
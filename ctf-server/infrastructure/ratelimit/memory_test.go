@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryRateLimiter_Allow(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := base
+
+	limiter := NewMemoryRateLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	tests := []struct {
+		name           string
+		at             time.Time
+		key            string
+		wantAllowed    bool
+		wantRetryAfter time.Duration
+	}{
+		{name: "first attempt", at: base, key: "a", wantAllowed: true},
+		{name: "second attempt", at: base.Add(10 * time.Second), key: "a", wantAllowed: true},
+		{name: "over limit", at: base.Add(20 * time.Second), key: "a", wantAllowed: false, wantRetryAfter: 40 * time.Second},
+		{name: "other key", at: base.Add(20 * time.Second), key: "b", wantAllowed: true},
+		{name: "oldest attempt expired", at: base.Add(time.Minute + time.Second), key: "a", wantAllowed: true},
+		{name: "over limit again", at: base.Add(time.Minute + 2*time.Second), key: "a", wantAllowed: false, wantRetryAfter: 8 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = tt.at
+			allowed, retryAfter, err := limiter.Allow(ctx, tt.key)
+			if err != nil {
+				t.Fatalf("Allow() error = %v", err)
+			}
+			if allowed != tt.wantAllowed {
+				t.Errorf("Allow() allowed = %v, want %v", allowed, tt.wantAllowed)
+			}
+			if retryAfter != tt.wantRetryAfter {
+				t.Errorf("Allow() retryAfter = %v, want %v", retryAfter, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestMemoryRateLimiter_Sweep(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	limiter := NewMemoryRateLimiter(1, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	limiter.Allow(ctx, "a")
+	now = now.Add(2 * time.Minute)
+	limiter.Allow(ctx, "b")
+
+	if _, exists := limiter.attempts["a"]; exists {
+		t.Errorf("Allow() did not remove expired key")
+	}
+}
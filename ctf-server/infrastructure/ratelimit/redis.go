@@ -0,0 +1,93 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const keyPrefix = "ratelimit:"
+
+// slidingWindowScript は試行時刻をソート済みセットに記録し、ウィンドウ内の件数を制限する
+// 制限を超えた場合は最も古い試行がウィンドウから外れるまでのミリ秒を返し、記録できた場合は0を返す
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+local member = ARGV[4]
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+if redis.call('ZCARD', key) >= limit then
+	local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+	local retry = tonumber(oldest[2]) + window - now
+	if retry < 1 then
+		retry = 1
+	end
+	return retry
+end
+
+redis.call('ZADD', key, now, member)
+redis.call('PEXPIRE', key, window)
+return 0
+`)
+
+// RedisRateLimiter はRedisのソート済みセットを使うスライディングウィンドウ方式のレートリミッター
+// 複数のサーバーで制限を共有できる
+type RedisRateLimiter struct {
+	redisClient *redis.Client
+	limit       int
+	window      time.Duration
+}
+
+func NewRedisRateLimiter(limit int, window time.Duration) (*RedisRateLimiter, error) {
+	redisAddr := os.Getenv("REDIS_ADDRESS")
+	if redisAddr == "" {
+		redisAddr = "localhost:6379"
+	}
+
+	redisPassword := os.Getenv("REDIS_PASSWORD")
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     redisAddr,
+		Password: redisPassword,
+		DB:       0,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	return &RedisRateLimiter{
+		redisClient: client,
+		limit:       limit,
+		window:      window,
+	}, nil
+}
+
+func (l *RedisRateLimiter) Close() error {
+	return l.redisClient.Close()
+}
+
+func (l *RedisRateLimiter) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	now := time.Now().UnixMilli()
+	retryMillis, err := slidingWindowScript.Run(ctx, l.redisClient,
+		[]string{keyPrefix + key},
+		now, l.window.Milliseconds(), l.limit, fmt.Sprintf("%d-%s", now, uuid.New().String()),
+	).Int64()
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to check rate limit: %w", err)
+	}
+
+	if retryMillis > 0 {
+		return false, time.Duration(retryMillis) * time.Millisecond, nil
+	}
+	return true, 0, nil
+}
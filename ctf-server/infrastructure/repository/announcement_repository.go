@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MySQLAnnouncementRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLAnnouncementRepository(db *sql.DB) *MySQLAnnouncementRepository {
+	return &MySQLAnnouncementRepository{db: db}
+}
+
+func (r *MySQLAnnouncementRepository) Create(ctx context.Context, announcement *domain.Announcement) error {
+	query := `
+		INSERT INTO announcements (id, challenge_id, title, content, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		announcement.AnnouncementID,
+		sql.NullString{String: announcement.ChallengeID, Valid: announcement.ChallengeID != ""},
+		announcement.Title,
+		announcement.Content,
+		announcement.CreatedAt,
+		announcement.UpdatedAt,
+	)
+	return err
+}
+
+func (r *MySQLAnnouncementRepository) FindByID(ctx context.Context, announcementID string) (*domain.Announcement, error) {
+	query := `
+		SELECT id, challenge_id, title, content, created_at, updated_at
+		FROM announcements
+		WHERE id = ?
+	`
+	announcement := &domain.Announcement{}
+	var challengeID sql.NullString
+	err := r.db.QueryRowContext(ctx, query, announcementID).Scan(
+		&announcement.AnnouncementID,
+		&challengeID,
+		&announcement.Title,
+		&announcement.Content,
+		&announcement.CreatedAt,
+		&announcement.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrAnnouncementNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	announcement.ChallengeID = challengeID.String
+	return announcement, nil
+}
+
+func (r *MySQLAnnouncementRepository) FindAll(ctx context.Context) ([]*domain.Announcement, error) {
+	query := `
+		SELECT id, challenge_id, title, content, created_at, updated_at
+		FROM announcements
+		ORDER BY created_at DESC, id ASC
+	`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var announcements []*domain.Announcement
+	for rows.Next() {
+		announcement := &domain.Announcement{}
+		var challengeID sql.NullString
+		if err := rows.Scan(
+			&announcement.AnnouncementID,
+			&challengeID,
+			&announcement.Title,
+			&announcement.Content,
+			&announcement.CreatedAt,
+			&announcement.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		announcement.ChallengeID = challengeID.String
+		announcements = append(announcements, announcement)
+	}
+
+	return announcements, rows.Err()
+}
+
+func (r *MySQLAnnouncementRepository) Update(ctx context.Context, announcement *domain.Announcement) error {
+	query := `
+		UPDATE announcements
+		SET challenge_id = ?, title = ?, content = ?, updated_at = ?
+		WHERE id = ?
+	`
+	result, err := r.db.ExecContext(ctx, query,
+		sql.NullString{String: announcement.ChallengeID, Valid: announcement.ChallengeID != ""},
+		announcement.Title,
+		announcement.Content,
+		announcement.UpdatedAt,
+		announcement.AnnouncementID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrAnnouncementNotFound
+	}
+
+	return nil
+}
+
+func (r *MySQLAnnouncementRepository) Delete(ctx context.Context, announcementID string) error {
+	query := `DELETE FROM announcements WHERE id = ?`
+	result, err := r.db.ExecContext(ctx, query, announcementID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrAnnouncementNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MySQLAuditRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLAuditRepository(db *sql.DB) *MySQLAuditRepository {
+	return &MySQLAuditRepository{db: db}
+}
+
+func (r *MySQLAuditRepository) Create(ctx context.Context, event *domain.AuditEvent) error {
+	changes, err := json.Marshal(event.Changes)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO audit_events (id, actor_id, actor_name, action, target_type, target_id, changes, error_message, client_ip, occurred_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err = r.db.ExecContext(ctx, query,
+		event.AuditEventID,
+		event.ActorID,
+		event.ActorName,
+		event.Action,
+		event.TargetType,
+		event.TargetID,
+		string(changes),
+		event.ErrorMessage,
+		event.ClientIP,
+		event.OccurredAt,
+	)
+	return err
+}
+
+func (r *MySQLAuditRepository) FindEvents(ctx context.Context, filter *domain.AuditEventFilter) ([]*domain.AuditEvent, error) {
+	var conditions []string
+	var args []any
+	if filter.ActorID != "" {
+		conditions = append(conditions, "actor_id = ?")
+		args = append(args, filter.ActorID)
+	}
+	if filter.Action != "" {
+		conditions = append(conditions, "action = ?")
+		args = append(args, filter.Action)
+	}
+	if filter.TargetType != "" {
+		conditions = append(conditions, "target_type = ?")
+		args = append(args, filter.TargetType)
+	}
+	if filter.TargetID != "" {
+		conditions = append(conditions, "target_id = ?")
+		args = append(args, filter.TargetID)
+	}
+	if !filter.Since.IsZero() {
+		conditions = append(conditions, "occurred_at >= ?")
+		args = append(args, filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		conditions = append(conditions, "occurred_at < ?")
+		args = append(args, filter.Until)
+	}
+	if filter.After != nil {
+		conditions = append(conditions, "(occurred_at < ? OR (occurred_at = ? AND id < ?))")
+		args = append(args, filter.After.OccurredAt, filter.After.OccurredAt, filter.After.AuditEventID)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := `
+		SELECT id, actor_id, actor_name, action, target_type, target_id, changes, error_message, client_ip, occurred_at
+		FROM audit_events
+		` + where + `
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT ?
+	`
+	args = append(args, filter.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*domain.AuditEvent
+	for rows.Next() {
+		event := &domain.AuditEvent{}
+		var changes string
+		if err := rows.Scan(
+			&event.AuditEventID,
+			&event.ActorID,
+			&event.ActorName,
+			&event.Action,
+			&event.TargetType,
+			&event.TargetID,
+			&changes,
+			&event.ErrorMessage,
+			&event.ClientIP,
+			&event.OccurredAt,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(changes), &event.Changes); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/kavos113/quickctf/ctf-server/domain"
@@ -22,11 +23,17 @@ func NewMySQLChallengeRepository(db *sql.DB) *MySQLChallengeRepository {
 
 func (r *MySQLChallengeRepository) Create(ctx context.Context, challenge *domain.Challenge) error {
 	query := `
-		INSERT INTO challenges (id, name, description, flag, points, genre, requires_instance, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO challenges (id, name, description, flag, points, genre, requires_instance, scoring_type, initial_points, minimum_points, decay, dynamic_flag, flag_match_mode, accepted_flags, prerequisite_mode, visibility, release_at, first_blood_bonus, second_blood_bonus, third_blood_bonus, author_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
+	acceptedFlags, err := encodeAcceptedFlags(challenge.AcceptedFlags)
+	if err != nil {
+		return err
+	}
+
+	bonuses := bloodBonusColumns(challenge.BloodBonuses)
 	now := time.Now()
-	_, err := r.db.ExecContext(ctx, query,
+	_, err = r.db.ExecContext(ctx, query,
 		challenge.ChallengeID,
 		challenge.Name,
 		challenge.Description,
@@ -34,12 +41,32 @@ func (r *MySQLChallengeRepository) Create(ctx context.Context, challenge *domain
 		challenge.Points,
 		challenge.Genre,
 		challenge.RequiresInstance,
+		challenge.ScoringType,
+		challenge.InitialPoints,
+		challenge.MinimumPoints,
+		challenge.Decay,
+		challenge.DynamicFlag,
+		challenge.FlagMatchMode,
+		acceptedFlags,
+		challenge.PrerequisiteMode,
+		challenge.Visibility,
+		sql.NullTime{Time: challenge.ReleaseAt, Valid: !challenge.ReleaseAt.IsZero()},
+		bonuses[0],
+		bonuses[1],
+		bonuses[2],
+		sql.NullString{String: challenge.AuthorID, Valid: challenge.AuthorID != ""},
 		now,
 		now,
 	)
 	if err != nil {
 		return err
 	}
+	if err := r.replacePrerequisites(ctx, challenge.ChallengeID, challenge.Prerequisites); err != nil {
+		return err
+	}
+	if err := r.replaceParts(ctx, challenge.ChallengeID, challenge.Parts); err != nil {
+		return err
+	}
 	challenge.CreatedAt = now
 	challenge.UpdatedAt = now
 	return nil
@@ -47,11 +74,15 @@ func (r *MySQLChallengeRepository) Create(ctx context.Context, challenge *domain
 
 func (r *MySQLChallengeRepository) FindByID(ctx context.Context, challengeID string) (*domain.Challenge, error) {
 	query := `
-		SELECT id, name, description, flag, points, genre, requires_instance, created_at, updated_at
+		SELECT id, name, description, flag, points, genre, requires_instance, scoring_type, initial_points, minimum_points, decay, dynamic_flag, flag_match_mode, accepted_flags, prerequisite_mode, visibility, release_at, first_blood_bonus, second_blood_bonus, third_blood_bonus, author_id, created_at, updated_at
 		FROM challenges
 		WHERE id = ?
 	`
 	challenge := &domain.Challenge{}
+	var acceptedFlags sql.NullString
+	var releaseAt sql.NullTime
+	var bonuses [domain.MaxBloodBonuses]int
+	var authorID sql.NullString
 	err := r.db.QueryRowContext(ctx, query, challengeID).Scan(
 		&challenge.ChallengeID,
 		&challenge.Name,
@@ -60,6 +91,20 @@ func (r *MySQLChallengeRepository) FindByID(ctx context.Context, challengeID str
 		&challenge.Points,
 		&challenge.Genre,
 		&challenge.RequiresInstance,
+		&challenge.ScoringType,
+		&challenge.InitialPoints,
+		&challenge.MinimumPoints,
+		&challenge.Decay,
+		&challenge.DynamicFlag,
+		&challenge.FlagMatchMode,
+		&acceptedFlags,
+		&challenge.PrerequisiteMode,
+		&challenge.Visibility,
+		&releaseAt,
+		&bonuses[0],
+		&bonuses[1],
+		&bonuses[2],
+		&authorID,
 		&challenge.CreatedAt,
 		&challenge.UpdatedAt,
 	)
@@ -70,18 +115,38 @@ func (r *MySQLChallengeRepository) FindByID(ctx context.Context, challengeID str
 		return nil, err
 	}
 
+	challenge.AcceptedFlags, err = decodeAcceptedFlags(acceptedFlags)
+	if err != nil {
+		return nil, err
+	}
+	challenge.ReleaseAt = releaseAt.Time
+	challenge.BloodBonuses = bloodBonusesFromColumns(bonuses)
+	challenge.AuthorID = authorID.String
+
 	attachments, err := r.attachmentRepo.FindByChallengeID(ctx, challengeID)
 	if err != nil {
 		return nil, err
 	}
 	challenge.Attachments = attachments
 
+	prerequisites, err := r.findPrerequisites(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	challenge.Prerequisites = prerequisites[challengeID]
+
+	parts, err := r.findParts(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	challenge.Parts = parts[challengeID]
+
 	return challenge, nil
 }
 
 func (r *MySQLChallengeRepository) FindAll(ctx context.Context) ([]*domain.Challenge, error) {
 	query := `
-		SELECT id, name, description, flag, points, genre, requires_instance, created_at, updated_at
+		SELECT id, name, description, flag, points, genre, requires_instance, scoring_type, initial_points, minimum_points, decay, dynamic_flag, flag_match_mode, accepted_flags, prerequisite_mode, visibility, release_at, first_blood_bonus, second_blood_bonus, third_blood_bonus, author_id, created_at, updated_at
 		FROM challenges
 		ORDER BY created_at DESC
 	`
@@ -94,6 +159,10 @@ func (r *MySQLChallengeRepository) FindAll(ctx context.Context) ([]*domain.Chall
 	var challenges []*domain.Challenge
 	for rows.Next() {
 		challenge := &domain.Challenge{}
+		var acceptedFlags sql.NullString
+		var releaseAt sql.NullTime
+		var bonuses [domain.MaxBloodBonuses]int
+		var authorID sql.NullString
 		if err := rows.Scan(
 			&challenge.ChallengeID,
 			&challenge.Name,
@@ -102,11 +171,35 @@ func (r *MySQLChallengeRepository) FindAll(ctx context.Context) ([]*domain.Chall
 			&challenge.Points,
 			&challenge.Genre,
 			&challenge.RequiresInstance,
+			&challenge.ScoringType,
+			&challenge.InitialPoints,
+			&challenge.MinimumPoints,
+			&challenge.Decay,
+			&challenge.DynamicFlag,
+			&challenge.FlagMatchMode,
+			&acceptedFlags,
+			&challenge.PrerequisiteMode,
+			&challenge.Visibility,
+			&releaseAt,
+			&bonuses[0],
+			&bonuses[1],
+			&bonuses[2],
+			&authorID,
 			&challenge.CreatedAt,
 			&challenge.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
+
+		flags, err := decodeAcceptedFlags(acceptedFlags)
+		if err != nil {
+			return nil, err
+		}
+		challenge.AcceptedFlags = flags
+		challenge.ReleaseAt = releaseAt.Time
+		challenge.BloodBonuses = bloodBonusesFromColumns(bonuses)
+		challenge.AuthorID = authorID.String
+
 		challenges = append(challenges, challenge)
 	}
 
@@ -114,12 +207,24 @@ func (r *MySQLChallengeRepository) FindAll(ctx context.Context) ([]*domain.Chall
 		return nil, err
 	}
 
+	prerequisites, err := r.findPrerequisites(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := r.findParts(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
 	for _, challenge := range challenges {
 		attachments, err := r.attachmentRepo.FindByChallengeID(ctx, challenge.ChallengeID)
 		if err != nil {
 			return nil, err
 		}
 		challenge.Attachments = attachments
+		challenge.Prerequisites = prerequisites[challenge.ChallengeID]
+		challenge.Parts = parts[challenge.ChallengeID]
 	}
 
 	return challenges, nil
@@ -128,9 +233,17 @@ func (r *MySQLChallengeRepository) FindAll(ctx context.Context) ([]*domain.Chall
 func (r *MySQLChallengeRepository) Update(ctx context.Context, challenge *domain.Challenge) error {
 	query := `
 		UPDATE challenges
-		SET name = ?, description = ?, flag = ?, points = ?, genre = ?, requires_instance = ?, updated_at = ?
+		SET name = ?, description = ?, flag = ?, points = ?, genre = ?, requires_instance = ?,
+			scoring_type = ?, initial_points = ?, minimum_points = ?, decay = ?, dynamic_flag = ?, flag_match_mode = ?, accepted_flags = ?, prerequisite_mode = ?, visibility = ?, release_at = ?,
+			first_blood_bonus = ?, second_blood_bonus = ?, third_blood_bonus = ?, updated_at = ?
 		WHERE id = ?
 	`
+	acceptedFlags, err := encodeAcceptedFlags(challenge.AcceptedFlags)
+	if err != nil {
+		return err
+	}
+
+	bonuses := bloodBonusColumns(challenge.BloodBonuses)
 	now := time.Now()
 	result, err := r.db.ExecContext(ctx, query,
 		challenge.Name,
@@ -139,6 +252,19 @@ func (r *MySQLChallengeRepository) Update(ctx context.Context, challenge *domain
 		challenge.Points,
 		challenge.Genre,
 		challenge.RequiresInstance,
+		challenge.ScoringType,
+		challenge.InitialPoints,
+		challenge.MinimumPoints,
+		challenge.Decay,
+		challenge.DynamicFlag,
+		challenge.FlagMatchMode,
+		acceptedFlags,
+		challenge.PrerequisiteMode,
+		challenge.Visibility,
+		sql.NullTime{Time: challenge.ReleaseAt, Valid: !challenge.ReleaseAt.IsZero()},
+		bonuses[0],
+		bonuses[1],
+		bonuses[2],
 		now,
 		challenge.ChallengeID,
 	)
@@ -155,6 +281,13 @@ func (r *MySQLChallengeRepository) Update(ctx context.Context, challenge *domain
 		return domain.ErrChallengeNotFound
 	}
 
+	if err := r.replacePrerequisites(ctx, challenge.ChallengeID, challenge.Prerequisites); err != nil {
+		return err
+	}
+	if err := r.replaceParts(ctx, challenge.ChallengeID, challenge.Parts); err != nil {
+		return err
+	}
+
 	challenge.UpdatedAt = now
 	return nil
 }
@@ -177,3 +310,247 @@ func (r *MySQLChallengeRepository) Delete(ctx context.Context, challengeID strin
 
 	return nil
 }
+
+// ReleaseScheduled は公開時刻を過ぎた予約公開の問題を公開状態にし、公開した問題のIDを返す
+// 複数のサーバーで同時に実行されても、それぞれの問題はいずれか1つのサーバーの結果にのみ含まれる
+func (r *MySQLChallengeRepository) ReleaseScheduled(ctx context.Context, now time.Time) ([]string, error) {
+	query := `
+		SELECT id
+		FROM challenges
+		WHERE visibility = ? AND release_at <= ?
+	`
+	rows, err := r.db.QueryContext(ctx, query, domain.VisibilityScheduled, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var released []string
+	for _, id := range candidates {
+		result, err := r.db.ExecContext(ctx,
+			`UPDATE challenges SET visibility = ?, updated_at = ? WHERE id = ? AND visibility = ?`,
+			domain.VisibilityVisible, now, id, domain.VisibilityScheduled,
+		)
+		if err != nil {
+			return released, err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return released, err
+		}
+		if rowsAffected > 0 {
+			released = append(released, id)
+		}
+	}
+
+	return released, nil
+}
+
+// findPrerequisites は問題IDごとの前提問題IDを返す。challengeID が空の場合はすべての問題について返す
+func (r *MySQLChallengeRepository) findPrerequisites(ctx context.Context, challengeID string) (map[string][]string, error) {
+	query := `
+		SELECT challenge_id, prerequisite_id
+		FROM challenge_prerequisites
+		WHERE ? = '' OR challenge_id = ?
+		ORDER BY challenge_id, prerequisite_id
+	`
+	rows, err := r.db.QueryContext(ctx, query, challengeID, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prerequisites := make(map[string][]string)
+	for rows.Next() {
+		var id, prerequisiteID string
+		if err := rows.Scan(&id, &prerequisiteID); err != nil {
+			return nil, err
+		}
+		prerequisites[id] = append(prerequisites[id], prerequisiteID)
+	}
+
+	return prerequisites, rows.Err()
+}
+
+// replacePrerequisites は前提問題をすべて置き換える
+func (r *MySQLChallengeRepository) replacePrerequisites(ctx context.Context, challengeID string, prerequisiteIDs []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM challenge_prerequisites WHERE challenge_id = ?`, challengeID); err != nil {
+		return err
+	}
+
+	for _, prerequisiteID := range prerequisiteIDs {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO challenge_prerequisites (challenge_id, prerequisite_id) VALUES (?, ?)`,
+			challengeID, prerequisiteID,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// findParts は問題IDごとの部分フラグを返す。challengeID が空の場合はすべての問題について返す
+func (r *MySQLChallengeRepository) findParts(ctx context.Context, challengeID string) (map[string][]*domain.FlagPart, error) {
+	query := `
+		SELECT id, challenge_id, name, flag, points, position
+		FROM flag_parts
+		WHERE ? = '' OR challenge_id = ?
+		ORDER BY challenge_id, position
+	`
+	rows, err := r.db.QueryContext(ctx, query, challengeID, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	parts := make(map[string][]*domain.FlagPart)
+	for rows.Next() {
+		part := &domain.FlagPart{}
+		if err := rows.Scan(
+			&part.PartID,
+			&part.ChallengeID,
+			&part.Name,
+			&part.Flag,
+			&part.Points,
+			&part.Position,
+		); err != nil {
+			return nil, err
+		}
+		parts[part.ChallengeID] = append(parts[part.ChallengeID], part)
+	}
+
+	return parts, rows.Err()
+}
+
+// replaceParts は部分フラグを parts の内容にする
+// IDが同じ部分フラグは更新するため、既存の部分フラグの正解記録は残る
+func (r *MySQLChallengeRepository) replaceParts(ctx context.Context, challengeID string, parts []*domain.FlagPart) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM flag_parts WHERE challenge_id = ?`, challengeID)
+	if err != nil {
+		return err
+	}
+	var existing []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		existing = append(existing, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	keep := make(map[string]bool, len(parts))
+	for _, p := range parts {
+		keep[p.PartID] = true
+	}
+	for _, id := range existing {
+		if keep[id] {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM flag_parts WHERE id = ?`, id); err != nil {
+			return err
+		}
+	}
+
+	// 名前の一意制約に引っかからないよう、名前を入れ替える場合に備えて一時的な名前にしてから更新する
+	for _, p := range parts {
+		if _, err := tx.ExecContext(ctx, `UPDATE flag_parts SET name = id WHERE id = ?`, p.PartID); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range parts {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO flag_parts (id, challenge_id, name, flag, points, position)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE name = VALUES(name), flag = VALUES(flag), points = VALUES(points), position = VALUES(position)
+		`,
+			p.PartID,
+			challengeID,
+			p.Name,
+			p.Flag,
+			p.Points,
+			p.Position,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// accepted_flags はJSON配列の文字列として保存する
+func encodeAcceptedFlags(flags []string) (sql.NullString, error) {
+	if len(flags) == 0 {
+		return sql.NullString{}, nil
+	}
+
+	data, err := json.Marshal(flags)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+func decodeAcceptedFlags(value sql.NullString) ([]string, error) {
+	if !value.Valid || value.String == "" {
+		return nil, nil
+	}
+
+	var flags []string
+	if err := json.Unmarshal([]byte(value.String), &flags); err != nil {
+		return nil, err
+	}
+	return flags, nil
+}
+
+// bloodBonusColumns は正解順位ボーナスを順位ごとのカラムの値に変換する
+func bloodBonusColumns(bonuses []int) [domain.MaxBloodBonuses]int {
+	var columns [domain.MaxBloodBonuses]int
+	copy(columns[:], bonuses)
+	return columns
+}
+
+// bloodBonusesFromColumns は順位ごとのカラムの値から、末尾の0を除いた正解順位ボーナスを返す
+func bloodBonusesFromColumns(columns [domain.MaxBloodBonuses]int) []int {
+	n := len(columns)
+	for n > 0 && columns[n-1] == 0 {
+		n--
+	}
+	if n == 0 {
+		return nil
+	}
+	bonuses := make([]int, n)
+	copy(bonuses, columns[:n])
+	return bonuses
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+// event_config は id = 1 の1行のみを持つ
+const eventConfigRowID = 1
+
+type MySQLEventConfigRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLEventConfigRepository(db *sql.DB) *MySQLEventConfigRepository {
+	return &MySQLEventConfigRepository{db: db}
+}
+
+func (r *MySQLEventConfigRepository) Get(ctx context.Context) (*domain.EventConfig, error) {
+	query := `
+		SELECT start_at, end_at, freeze_at, updated_at
+		FROM event_config
+		WHERE id = ?
+	`
+	var startAt, endAt, freezeAt sql.NullTime
+	config := &domain.EventConfig{}
+	err := r.db.QueryRowContext(ctx, query, eventConfigRowID).Scan(
+		&startAt,
+		&endAt,
+		&freezeAt,
+		&config.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return &domain.EventConfig{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	config.StartAt = startAt.Time
+	config.EndAt = endAt.Time
+	config.FreezeAt = freezeAt.Time
+	return config, nil
+}
+
+func (r *MySQLEventConfigRepository) Save(ctx context.Context, config *domain.EventConfig) error {
+	query := `
+		INSERT INTO event_config (id, start_at, end_at, freeze_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			start_at = VALUES(start_at),
+			end_at = VALUES(end_at),
+			freeze_at = VALUES(freeze_at),
+			updated_at = VALUES(updated_at)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		eventConfigRowID,
+		sql.NullTime{Time: config.StartAt, Valid: !config.StartAt.IsZero()},
+		sql.NullTime{Time: config.EndAt, Valid: !config.EndAt.IsZero()},
+		sql.NullTime{Time: config.FreezeAt, Valid: !config.FreezeAt.IsZero()},
+		config.UpdatedAt,
+	)
+	return err
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+)
+
+type MySQLFlagPartSolveRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLFlagPartSolveRepository(db *sql.DB) *MySQLFlagPartSolveRepository {
+	return &MySQLFlagPartSolveRepository{db: db}
+}
+
+func (r *MySQLFlagPartSolveRepository) FindSolvedPartIDs(ctx context.Context, ownerID string) (map[string]bool, error) {
+	query := `SELECT part_id FROM flag_part_solves WHERE owner_id = ?`
+	rows, err := r.db.QueryContext(ctx, query, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	solved := make(map[string]bool)
+	for rows.Next() {
+		var partID string
+		if err := rows.Scan(&partID); err != nil {
+			return nil, err
+		}
+		solved[partID] = true
+	}
+
+	return solved, rows.Err()
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MySQLHintRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLHintRepository(db *sql.DB) *MySQLHintRepository {
+	return &MySQLHintRepository{db: db}
+}
+
+func (r *MySQLHintRepository) Create(ctx context.Context, hint *domain.Hint) error {
+	query := `
+		INSERT INTO hints (id, challenge_id, content, cost, position, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		hint.HintID,
+		hint.ChallengeID,
+		hint.Content,
+		hint.Cost,
+		hint.Position,
+		hint.CreatedAt,
+	)
+	return err
+}
+
+func (r *MySQLHintRepository) FindByID(ctx context.Context, hintID string) (*domain.Hint, error) {
+	query := `
+		SELECT id, challenge_id, content, cost, position, created_at
+		FROM hints
+		WHERE id = ?
+	`
+	hint := &domain.Hint{}
+	err := r.db.QueryRowContext(ctx, query, hintID).Scan(
+		&hint.HintID,
+		&hint.ChallengeID,
+		&hint.Content,
+		&hint.Cost,
+		&hint.Position,
+		&hint.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrHintNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hint, nil
+}
+
+func (r *MySQLHintRepository) FindByChallengeID(ctx context.Context, challengeID string) ([]*domain.Hint, error) {
+	query := `
+		SELECT id, challenge_id, content, cost, position, created_at
+		FROM hints
+		WHERE challenge_id = ?
+		ORDER BY position ASC, created_at ASC
+	`
+	rows, err := r.db.QueryContext(ctx, query, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hints []*domain.Hint
+	for rows.Next() {
+		hint := &domain.Hint{}
+		if err := rows.Scan(
+			&hint.HintID,
+			&hint.ChallengeID,
+			&hint.Content,
+			&hint.Cost,
+			&hint.Position,
+			&hint.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		hints = append(hints, hint)
+	}
+
+	return hints, rows.Err()
+}
+
+func (r *MySQLHintRepository) Update(ctx context.Context, hint *domain.Hint) error {
+	query := `
+		UPDATE hints
+		SET content = ?, cost = ?, position = ?
+		WHERE id = ?
+	`
+	result, err := r.db.ExecContext(ctx, query,
+		hint.Content,
+		hint.Cost,
+		hint.Position,
+		hint.HintID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrHintNotFound
+	}
+
+	return nil
+}
+
+func (r *MySQLHintRepository) Delete(ctx context.Context, hintID string) error {
+	query := `DELETE FROM hints WHERE id = ?`
+	result, err := r.db.ExecContext(ctx, query, hintID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrHintNotFound
+	}
+
+	return nil
+}
+
+// CreateUnlock は公開記録を保存する。同じ主体が既に公開済みの場合は何もしない
+func (r *MySQLHintRepository) CreateUnlock(ctx context.Context, unlock *domain.HintUnlock) error {
+	query := `
+		INSERT IGNORE INTO hint_unlocks (id, hint_id, user_id, team_id, owner_id, unlocked_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		unlock.UnlockID,
+		unlock.HintID,
+		unlock.UserID,
+		sql.NullString{String: unlock.TeamID, Valid: unlock.TeamID != ""},
+		unlock.OwnerID,
+		unlock.UnlockedAt,
+	)
+	return err
+}
+
+func (r *MySQLHintRepository) FindUnlockedHintIDs(ctx context.Context, challengeID, ownerID string) (map[string]bool, error) {
+	query := `
+		SELECT hu.hint_id
+		FROM hint_unlocks hu
+		JOIN hints h ON h.id = hu.hint_id
+		WHERE h.challenge_id = ? AND hu.owner_id = ?
+	`
+	rows, err := r.db.QueryContext(ctx, query, challengeID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	unlocked := make(map[string]bool)
+	for rows.Next() {
+		var hintID string
+		if err := rows.Scan(&hintID); err != nil {
+			return nil, err
+		}
+		unlocked[hintID] = true
+	}
+
+	return unlocked, rows.Err()
+}
@@ -90,6 +90,41 @@ func (r *MySQLInstanceRepository) FindByUserAndChallenge(ctx context.Context, us
 	return instance, nil
 }
 
+func (r *MySQLInstanceRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.Instance, error) {
+	query := `
+		SELECT id, user_id, challenge_id, image_tag, status, host, port, started_at, expires_at
+		FROM instances
+		WHERE user_id = ? AND status != 'destroyed'
+		ORDER BY started_at DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var instances []*domain.Instance
+	for rows.Next() {
+		instance := &domain.Instance{}
+		if err := rows.Scan(
+			&instance.InstanceID,
+			&instance.UserID,
+			&instance.ChallengeID,
+			&instance.ImageTag,
+			&instance.Status,
+			&instance.Host,
+			&instance.Port,
+			&instance.StartedAt,
+			&instance.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		instances = append(instances, instance)
+	}
+
+	return instances, rows.Err()
+}
+
 func (r *MySQLInstanceRepository) Update(ctx context.Context, instance *domain.Instance) error {
 	query := `
 		UPDATE instances
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MySQLIssuedFlagRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLIssuedFlagRepository(db *sql.DB) *MySQLIssuedFlagRepository {
+	return &MySQLIssuedFlagRepository{db: db}
+}
+
+func (r *MySQLIssuedFlagRepository) Create(ctx context.Context, issuedFlag *domain.IssuedFlag) error {
+	query := `
+		INSERT INTO issued_flags (id, challenge_id, owner_id, flag, issued_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		issuedFlag.IssuedFlagID,
+		issuedFlag.ChallengeID,
+		issuedFlag.OwnerID,
+		issuedFlag.Flag,
+		issuedFlag.IssuedAt,
+	)
+	return err
+}
+
+func (r *MySQLIssuedFlagRepository) FindByOwner(ctx context.Context, challengeID, ownerID string) (*domain.IssuedFlag, error) {
+	query := `
+		SELECT id, challenge_id, owner_id, flag, issued_at
+		FROM issued_flags
+		WHERE challenge_id = ? AND owner_id = ?
+	`
+	issuedFlag := &domain.IssuedFlag{}
+	err := r.db.QueryRowContext(ctx, query, challengeID, ownerID).Scan(
+		&issuedFlag.IssuedFlagID,
+		&issuedFlag.ChallengeID,
+		&issuedFlag.OwnerID,
+		&issuedFlag.Flag,
+		&issuedFlag.IssuedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrIssuedFlagNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return issuedFlag, nil
+}
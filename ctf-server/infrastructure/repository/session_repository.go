@@ -117,3 +117,17 @@ func (r *MySQLSessionRepository) DeleteByUserID(ctx context.Context, userID stri
 	_, err := r.db.ExecContext(ctx, query, userID)
 	return err
 }
+
+func (r *MySQLSessionRepository) DeleteOthersByUserID(ctx context.Context, userID, sessionID string) error {
+	query := `DELETE FROM sessions WHERE user_id = ? AND id != ?`
+
+	_, err := r.db.ExecContext(ctx, query, userID, sessionID)
+	return err
+}
+
+func (r *MySQLSessionRepository) RevokeAdminByUserID(ctx context.Context, userID string) error {
+	query := `UPDATE sessions SET is_admin = FALSE WHERE user_id = ?`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	return err
+}
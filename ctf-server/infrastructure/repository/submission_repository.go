@@ -3,6 +3,9 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/kavos113/quickctf/ctf-server/domain"
 )
@@ -17,34 +20,228 @@ func NewMySQLSubmissionRepository(db *sql.DB) *MySQLSubmissionRepository {
 
 func (r *MySQLSubmissionRepository) Create(ctx context.Context, submission *domain.Submission) error {
 	query := `
-		INSERT INTO submissions (id, user_id, challenge_id, submitted_flag, is_correct, submitted_at)
-		VALUES (?, ?, ?, ?, ?, ?)
+		INSERT INTO submissions (id, user_id, team_id, challenge_id, submitted_flag, is_correct, part_id, submitted_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 	`
 	_, err := r.db.ExecContext(ctx, query,
 		submission.SubmissionID,
 		submission.UserID,
+		sql.NullString{String: submission.TeamID, Valid: submission.TeamID != ""},
 		submission.ChallengeID,
 		submission.SubmittedFlag,
 		submission.IsCorrect,
+		sql.NullString{String: submission.PartID, Valid: submission.PartID != ""},
 		submission.SubmittedAt,
 	)
 	return err
 }
 
+// CreateSolve は問題を解いた提出を記録し、何番目に解いたかを submission.SolveRank に設定する
+// 提出と solves への記録は1つのトランザクションで行い、solves の一意制約により同じ主体が同じ問題を2回解いたことにはならない
+// 問題の行をロックしてから順位を採番するため、同時に解かれても同じ順位にはならない
+func (r *MySQLSubmissionRepository) CreateSolve(ctx context.Context, submission *domain.Submission) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var challengeID string
+	err = tx.QueryRowContext(ctx, `SELECT id FROM challenges WHERE id = ? FOR UPDATE`, submission.ChallengeID).Scan(&challengeID)
+	if err == sql.ErrNoRows {
+		return domain.ErrChallengeNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	rank, err := insertSolve(ctx, tx, submission)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	submission.IsCorrect = true
+	submission.SolveRank = rank
+	return nil
+}
+
+// CreatePartSolve は部分フラグの正解を記録する
+// 問題の行をロックしてから未解答の部分を数えるため、最後の2つの部分が同時に解かれても解き終えたことを見落とさない
+func (r *MySQLSubmissionRepository) CreatePartSolve(ctx context.Context, submission *domain.Submission, solve *domain.FlagPartSolve) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var challengeID string
+	err = tx.QueryRowContext(ctx, `SELECT id FROM challenges WHERE id = ? FOR UPDATE`, submission.ChallengeID).Scan(&challengeID)
+	if err == sql.ErrNoRows {
+		return domain.ErrChallengeNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT IGNORE INTO flag_part_solves (id, part_id, challenge_id, user_id, team_id, owner_id, solved_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`,
+		solve.SolveID,
+		solve.PartID,
+		solve.ChallengeID,
+		solve.UserID,
+		sql.NullString{String: solve.TeamID, Valid: solve.TeamID != ""},
+		solve.OwnerID,
+		solve.SolvedAt,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrAlreadySolved
+	}
+
+	var remaining, solved int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM flag_parts fp
+		WHERE fp.challenge_id = ?
+		  AND NOT EXISTS (SELECT 1 FROM flag_part_solves ps WHERE ps.part_id = fp.id AND ps.owner_id = ?)
+	`, submission.ChallengeID, solve.OwnerID).Scan(&remaining)
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM solves WHERE challenge_id = ? AND owner_id = ?`,
+		submission.ChallengeID,
+		solve.OwnerID,
+	).Scan(&solved)
+	if err != nil {
+		return err
+	}
+
+	if remaining > 0 || solved > 0 {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO submissions (id, user_id, team_id, challenge_id, submitted_flag, is_correct, part_id, submitted_at)
+			VALUES (?, ?, ?, ?, ?, TRUE, ?, ?)
+		`,
+			submission.SubmissionID,
+			submission.UserID,
+			sql.NullString{String: submission.TeamID, Valid: submission.TeamID != ""},
+			submission.ChallengeID,
+			submission.SubmittedFlag,
+			solve.PartID,
+			submission.SubmittedAt,
+		)
+		if err != nil {
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		submission.IsCorrect = true
+		submission.PartID = solve.PartID
+		return nil
+	}
+
+	// 取り消された場合に最後の部分フラグも解き直せるよう、どの部分で解き終えたかを記録する
+	submission.CompletedPartID = solve.PartID
+	rank, err := insertSolve(ctx, tx, submission)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	submission.IsCorrect = true
+	submission.SolveRank = rank
+	return nil
+}
+
+// insertSolve は問題の行をロックしたトランザクションで問題を解いた提出を記録し、採番した順位を返す
+func insertSolve(ctx context.Context, tx *sql.Tx, submission *domain.Submission) (int, error) {
+	var rank int
+	err := tx.QueryRowContext(ctx,
+		`SELECT COALESCE(MAX(solve_rank), 0) + 1 FROM submissions WHERE challenge_id = ?`,
+		submission.ChallengeID,
+	).Scan(&rank)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `
+		INSERT INTO submissions (id, user_id, team_id, challenge_id, submitted_flag, is_correct, part_id, completed_part_id, solve_rank, submitted_at)
+		VALUES (?, ?, ?, ?, ?, TRUE, NULL, ?, ?, ?)
+	`
+	_, err = tx.ExecContext(ctx, query,
+		submission.SubmissionID,
+		submission.UserID,
+		sql.NullString{String: submission.TeamID, Valid: submission.TeamID != ""},
+		submission.ChallengeID,
+		submission.SubmittedFlag,
+		sql.NullString{String: submission.CompletedPartID, Valid: submission.CompletedPartID != ""},
+		rank,
+		submission.SubmittedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx,
+		`INSERT IGNORE INTO solves (submission_id, challenge_id, owner_id, solved_at) VALUES (?, ?, ?, ?)`,
+		submission.SubmissionID,
+		submission.ChallengeID,
+		submission.OwnerID(),
+		submission.SubmittedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if rowsAffected == 0 {
+		return 0, domain.ErrAlreadySolved
+	}
+
+	if err := updateDynamicPoints(ctx, tx, submission.ChallengeID); err != nil {
+		return 0, err
+	}
+	return rank, nil
+}
+
 func (r *MySQLSubmissionRepository) FindByID(ctx context.Context, submissionID string) (*domain.Submission, error) {
 	query := `
-		SELECT id, user_id, challenge_id, submitted_flag, is_correct, submitted_at
+		SELECT id, user_id, team_id, challenge_id, submitted_flag, is_correct, part_id, completed_part_id, solve_rank, submitted_at, invalidated_at
 		FROM submissions
 		WHERE id = ?
 	`
 	submission := &domain.Submission{}
+	var teamID, partID, completedPartID sql.NullString
+	var solveRank sql.NullInt64
+	var invalidatedAt sql.NullTime
 	err := r.db.QueryRowContext(ctx, query, submissionID).Scan(
 		&submission.SubmissionID,
 		&submission.UserID,
+		&teamID,
 		&submission.ChallengeID,
 		&submission.SubmittedFlag,
 		&submission.IsCorrect,
+		&partID,
+		&completedPartID,
+		&solveRank,
 		&submission.SubmittedAt,
+		&invalidatedAt,
 	)
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrSubmissionNotFound
@@ -52,12 +249,17 @@ func (r *MySQLSubmissionRepository) FindByID(ctx context.Context, submissionID s
 	if err != nil {
 		return nil, err
 	}
+	submission.TeamID = teamID.String
+	submission.PartID = partID.String
+	submission.CompletedPartID = completedPartID.String
+	submission.SolveRank = int(solveRank.Int64)
+	submission.InvalidatedAt = invalidatedAt.Time
 	return submission, nil
 }
 
 func (r *MySQLSubmissionRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.Submission, error) {
 	query := `
-		SELECT id, user_id, challenge_id, submitted_flag, is_correct, submitted_at
+		SELECT id, user_id, team_id, challenge_id, submitted_flag, is_correct, part_id, completed_part_id, solve_rank, submitted_at, invalidated_at
 		FROM submissions
 		WHERE user_id = ?
 		ORDER BY submitted_at DESC
@@ -68,85 +270,743 @@ func (r *MySQLSubmissionRepository) FindByUserID(ctx context.Context, userID str
 	}
 	defer rows.Close()
 
-	var submissions []*domain.Submission
+	return scanSubmissions(rows)
+}
+
+func (r *MySQLSubmissionRepository) FindByChallengeID(ctx context.Context, challengeID string) ([]*domain.Submission, error) {
+	query := `
+		SELECT id, user_id, team_id, challenge_id, submitted_flag, is_correct, part_id, completed_part_id, solve_rank, submitted_at, invalidated_at
+		FROM submissions
+		WHERE challenge_id = ?
+		ORDER BY submitted_at DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSubmissions(rows)
+}
+
+func (r *MySQLSubmissionRepository) FindByUserAndChallenge(ctx context.Context, userID, challengeID string) ([]*domain.Submission, error) {
+	query := `
+		SELECT id, user_id, team_id, challenge_id, submitted_flag, is_correct, part_id, completed_part_id, solve_rank, submitted_at, invalidated_at
+		FROM submissions
+		WHERE user_id = ? AND challenge_id = ?
+		ORDER BY submitted_at DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSubmissions(rows)
+}
+
+func (r *MySQLSubmissionRepository) FindByTeamAndChallenge(ctx context.Context, teamID, challengeID string) ([]*domain.Submission, error) {
+	query := `
+		SELECT id, user_id, team_id, challenge_id, submitted_flag, is_correct, part_id, completed_part_id, solve_rank, submitted_at, invalidated_at
+		FROM submissions
+		WHERE team_id = ? AND challenge_id = ?
+		ORDER BY submitted_at DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, teamID, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSubmissions(rows)
+}
+
+func (r *MySQLSubmissionRepository) FindSolvedChallengeIDs(ctx context.Context, userID, teamID string) (map[string]bool, error) {
+	query := `
+		SELECT DISTINCT challenge_id
+		FROM submissions
+		WHERE is_correct = TRUE AND part_id IS NULL AND user_id = ?
+	`
+	args := []any{userID}
+	if teamID != "" {
+		query = `
+			SELECT DISTINCT challenge_id
+			FROM submissions
+			WHERE is_correct = TRUE AND part_id IS NULL AND team_id = ?
+		`
+		args = []any{teamID}
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	solved := make(map[string]bool)
 	for rows.Next() {
-		submission := &domain.Submission{}
+		var challengeID string
+		if err := rows.Scan(&challengeID); err != nil {
+			return nil, err
+		}
+		solved[challengeID] = true
+	}
+
+	return solved, rows.Err()
+}
+
+const countSolvesQuery = `
+	SELECT COUNT(DISTINCT COALESCE(team_id, user_id))
+	FROM submissions
+	WHERE challenge_id = ? AND is_correct = TRUE AND part_id IS NULL
+		AND user_id NOT IN (SELECT id FROM users WHERE banned_at IS NOT NULL)
+`
+
+func (r *MySQLSubmissionRepository) CountSolves(ctx context.Context, challengeID string) (int, error) {
+	var count int
+	if err := r.db.QueryRowContext(ctx, countSolvesQuery, challengeID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// renumberSolvesQuery は利用停止中のユーザーを除いた正解に、解いた順に1から順位を振る
+const renumberSolvesQuery = `
+	UPDATE submissions s
+	JOIN (
+		SELECT id, ROW_NUMBER() OVER (ORDER BY submitted_at, id) AS solve_rank
+		FROM submissions
+		WHERE challenge_id = ? AND is_correct = TRUE AND part_id IS NULL
+			AND user_id NOT IN (SELECT id FROM users WHERE banned_at IS NOT NULL)
+	) ranked ON ranked.id = s.id
+	SET s.solve_rank = ranked.solve_rank
+`
+
+// Rescore は問題の行をロックし、解いた順位を振り直してから dynamic scoring の得点を正解数から再計算する
+// 順位は一意制約に触れないよう、いったんすべて外してから振り直す
+func (r *MySQLSubmissionRepository) Rescore(ctx context.Context, challengeID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var id string
+	err = tx.QueryRowContext(ctx, `SELECT id FROM challenges WHERE id = ? FOR UPDATE`, challengeID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return domain.ErrChallengeNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE submissions SET solve_rank = NULL WHERE challenge_id = ? AND solve_rank IS NOT NULL`, challengeID)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, renumberSolvesQuery, challengeID); err != nil {
+		return err
+	}
+
+	if err := updateDynamicPoints(ctx, tx, challengeID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// updateDynamicPoints は dynamic scoring の問題の得点を正解数から再計算して保存する
+// 正解の記録や取り消しと同じトランザクションで呼び、呼び出し側で問題の行をロックしておく
+func updateDynamicPoints(ctx context.Context, tx *sql.Tx, challengeID string) error {
+	challenge := &domain.Challenge{}
+	err := tx.QueryRowContext(ctx,
+		`SELECT scoring_type, initial_points, minimum_points, decay FROM challenges WHERE id = ?`,
+		challengeID,
+	).Scan(&challenge.ScoringType, &challenge.InitialPoints, &challenge.MinimumPoints, &challenge.Decay)
+	if err != nil {
+		return err
+	}
+	if !challenge.IsDynamic() {
+		return nil
+	}
+
+	var solves int
+	if err := tx.QueryRowContext(ctx, countSolvesQuery, challengeID).Scan(&solves); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE challenges SET points = ? WHERE id = ?`, challenge.DynamicPoints(solves), challengeID)
+	return err
+}
+
+// GetChallengeSolveStats は問題ごとの正解数・最初の正解者・自分が解いたかを1クエリで集計する
+// 正解数はチームで提出されたものをチーム単位で数える。自分が解いたかは until に関係なくすべての正解から判定する
+// 利用停止中のユーザーの正解は正解数と最初の正解者に含めない
+func (r *MySQLSubmissionRepository) GetChallengeSolveStats(ctx context.Context, userID, teamID string, until time.Time) (map[string]*domain.ChallengeSolveStats, error) {
+	query := `
+		WITH solves AS (
+			SELECT id, challenge_id, user_id, team_id, submitted_at,
+				(? IS NULL OR submitted_at < ?) AND user_id NOT IN (SELECT id FROM users WHERE banned_at IS NOT NULL) AS counted
+			FROM submissions
+			WHERE is_correct = TRUE AND part_id IS NULL
+		), first_solves AS (
+			SELECT challenge_id, user_id, team_id, submitted_at,
+				ROW_NUMBER() OVER (PARTITION BY challenge_id ORDER BY submitted_at, id) AS solve_rank
+			FROM solves
+			WHERE counted
+		)
+		SELECT
+			s.challenge_id,
+			COUNT(DISTINCT CASE WHEN s.counted THEN COALESCE(s.team_id, s.user_id) END) AS solve_count,
+			MAX(CASE WHEN (? = '' AND s.user_id = ?) OR s.team_id = ? THEN 1 ELSE 0 END) AS solved_by_me,
+			f.user_id, u.username, f.team_id, t.name, f.submitted_at
+		FROM solves s
+		LEFT JOIN first_solves f ON f.challenge_id = s.challenge_id AND f.solve_rank = 1
+		LEFT JOIN users u ON u.id = f.user_id
+		LEFT JOIN teams t ON t.id = f.team_id
+		GROUP BY s.challenge_id, f.user_id, u.username, f.team_id, t.name, f.submitted_at
+	`
+	cutoff := sql.NullTime{Time: until, Valid: !until.IsZero()}
+	team := sql.NullString{String: teamID, Valid: teamID != ""}
+	rows, err := r.db.QueryContext(ctx, query, cutoff, cutoff, teamID, userID, team)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make(map[string]*domain.ChallengeSolveStats)
+	for rows.Next() {
+		st := &domain.ChallengeSolveStats{}
+		var (
+			firstUserID   sql.NullString
+			firstUsername sql.NullString
+			firstTeamID   sql.NullString
+			firstTeamName sql.NullString
+			firstSolvedAt sql.NullTime
+		)
 		if err := rows.Scan(
-			&submission.SubmissionID,
-			&submission.UserID,
-			&submission.ChallengeID,
-			&submission.SubmittedFlag,
-			&submission.IsCorrect,
-			&submission.SubmittedAt,
+			&st.ChallengeID,
+			&st.SolveCount,
+			&st.SolvedByMe,
+			&firstUserID,
+			&firstUsername,
+			&firstTeamID,
+			&firstTeamName,
+			&firstSolvedAt,
 		); err != nil {
 			return nil, err
 		}
-		submissions = append(submissions, submission)
+		if firstUserID.Valid {
+			st.FirstBlood = &domain.FirstBlood{
+				UserID:   firstUserID.String,
+				Username: firstUsername.String,
+				TeamID:   firstTeamID.String,
+				TeamName: firstTeamName.String,
+				SolvedAt: firstSolvedAt.Time,
+			}
+		}
+		stats[st.ChallengeID] = st
 	}
 
-	return submissions, rows.Err()
+	return stats, rows.Err()
 }
 
-func (r *MySQLSubmissionRepository) FindByChallengeID(ctx context.Context, challengeID string) ([]*domain.Submission, error) {
+// GetScoreboard はユーザーごとの正解数・得点を1クエリで集計する
+// 同じ問題への正解が複数あっても最初の1件のみを数える。解いた順位に応じたボーナスと部分フラグの得点を加え、公開したヒントのコストは得点から差し引く
+// 利用停止中のユーザーは含めない
+func (r *MySQLSubmissionRepository) GetScoreboard(ctx context.Context, until time.Time, points map[string]int) ([]*domain.ScoreboardEntry, error) {
+	pointsTable, pointsArgs := challengePointsTable(points)
 	query := `
-		SELECT id, user_id, challenge_id, submitted_flag, is_correct, submitted_at
-		FROM submissions
-		WHERE challenge_id = ?
-		ORDER BY submitted_at DESC
+		SELECT u.id, u.username, SUM(x.points) - COALESCE(MAX(p.penalty), 0) AS score, SUM(x.is_solve) AS solve_count, MAX(x.solved_at) AS last_solve_at
+		FROM (
+			SELECT s.user_id,
+				COALESCE(cp.points, c.points) + CASE s.solve_rank WHEN 1 THEN c.first_blood_bonus WHEN 2 THEN c.second_blood_bonus WHEN 3 THEN c.third_blood_bonus ELSE 0 END AS points,
+				s.solved_at, 1 AS is_solve
+			FROM (
+				SELECT user_id, challenge_id, MIN(submitted_at) AS solved_at, MIN(solve_rank) AS solve_rank
+				FROM submissions
+				WHERE is_correct = TRUE AND part_id IS NULL AND (? IS NULL OR submitted_at < ?)
+				GROUP BY user_id, challenge_id
+			) s
+			JOIN challenges c ON c.id = s.challenge_id
+			LEFT JOIN (` + pointsTable + `) cp ON cp.challenge_id = c.id
+			UNION ALL
+			SELECT ps.user_id, fp.points, ps.solved_at, 0 AS is_solve
+			FROM flag_part_solves ps
+			JOIN flag_parts fp ON fp.id = ps.part_id
+			WHERE (? IS NULL OR ps.solved_at < ?)
+		) x
+		JOIN users u ON u.id = x.user_id
+		LEFT JOIN (
+			SELECT hu.user_id, SUM(h.cost) AS penalty
+			FROM hint_unlocks hu
+			JOIN hints h ON h.id = hu.hint_id
+			WHERE (? IS NULL OR hu.unlocked_at < ?)
+			GROUP BY hu.user_id
+		) p ON p.user_id = u.id
+		WHERE u.banned_at IS NULL
+		GROUP BY u.id, u.username
+		ORDER BY score DESC, last_solve_at ASC, u.id ASC
 	`
-	rows, err := r.db.QueryContext(ctx, query, challengeID)
+	rows, err := r.db.QueryContext(ctx, query, scoreboardArgs(until, pointsArgs)...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var submissions []*domain.Submission
+	var entries []*domain.ScoreboardEntry
 	for rows.Next() {
-		submission := &domain.Submission{}
+		entry := &domain.ScoreboardEntry{}
 		if err := rows.Scan(
-			&submission.SubmissionID,
-			&submission.UserID,
-			&submission.ChallengeID,
-			&submission.SubmittedFlag,
-			&submission.IsCorrect,
-			&submission.SubmittedAt,
+			&entry.UserID,
+			&entry.Username,
+			&entry.Score,
+			&entry.SolveCount,
+			&entry.LastSolveAt,
 		); err != nil {
 			return nil, err
 		}
-		submissions = append(submissions, submission)
+		entries = append(entries, entry)
 	}
 
-	return submissions, rows.Err()
+	return entries, rows.Err()
 }
 
-func (r *MySQLSubmissionRepository) FindByUserAndChallenge(ctx context.Context, userID, challengeID string) ([]*domain.Submission, error) {
+// GetTeamScoreboard はチームごとの正解数・得点を集計する
+// チーム内の誰かが解いた問題はチームで1回として数える。部分フラグの得点とヒントのコストもチーム単位で集計する
+// 利用停止中のメンバーの正解は集計しない
+func (r *MySQLSubmissionRepository) GetTeamScoreboard(ctx context.Context, until time.Time, points map[string]int) ([]*domain.ScoreboardEntry, error) {
+	pointsTable, pointsArgs := challengePointsTable(points)
 	query := `
-		SELECT id, user_id, challenge_id, submitted_flag, is_correct, submitted_at
+		SELECT t.id, t.name, SUM(x.points) - COALESCE(MAX(p.penalty), 0) AS score, SUM(x.is_solve) AS solve_count, MAX(x.solved_at) AS last_solve_at
+		FROM (
+			SELECT s.team_id,
+				COALESCE(cp.points, c.points) + CASE s.solve_rank WHEN 1 THEN c.first_blood_bonus WHEN 2 THEN c.second_blood_bonus WHEN 3 THEN c.third_blood_bonus ELSE 0 END AS points,
+				s.solved_at, 1 AS is_solve
+			FROM (
+				SELECT team_id, challenge_id, MIN(submitted_at) AS solved_at, MIN(solve_rank) AS solve_rank
+				FROM submissions
+				WHERE is_correct = TRUE AND part_id IS NULL AND team_id IS NOT NULL AND user_id NOT IN (SELECT id FROM users WHERE banned_at IS NOT NULL) AND (? IS NULL OR submitted_at < ?)
+				GROUP BY team_id, challenge_id
+			) s
+			JOIN challenges c ON c.id = s.challenge_id
+			LEFT JOIN (` + pointsTable + `) cp ON cp.challenge_id = c.id
+			UNION ALL
+			SELECT ps.team_id, fp.points, ps.solved_at, 0 AS is_solve
+			FROM flag_part_solves ps
+			JOIN flag_parts fp ON fp.id = ps.part_id
+			WHERE ps.team_id IS NOT NULL AND ps.user_id NOT IN (SELECT id FROM users WHERE banned_at IS NOT NULL) AND (? IS NULL OR ps.solved_at < ?)
+		) x
+		JOIN teams t ON t.id = x.team_id
+		LEFT JOIN (
+			SELECT hu.team_id, SUM(h.cost) AS penalty
+			FROM hint_unlocks hu
+			JOIN hints h ON h.id = hu.hint_id
+			WHERE hu.team_id IS NOT NULL AND (? IS NULL OR hu.unlocked_at < ?)
+			GROUP BY hu.team_id
+		) p ON p.team_id = t.id
+		GROUP BY t.id, t.name
+		ORDER BY score DESC, last_solve_at ASC, t.id ASC
+	`
+	rows, err := r.db.QueryContext(ctx, query, scoreboardArgs(until, pointsArgs)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*domain.ScoreboardEntry
+	for rows.Next() {
+		entry := &domain.ScoreboardEntry{}
+		if err := rows.Scan(
+			&entry.TeamID,
+			&entry.TeamName,
+			&entry.Score,
+			&entry.SolveCount,
+			&entry.LastSolveAt,
+		); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// scoreboardArgs は GetScoreboard と GetTeamScoreboard の引数を並べる。正解の集計期限、問題の得点の表、部分フラグとヒントの集計期限の順
+func scoreboardArgs(until time.Time, pointsArgs []any) []any {
+	cutoff := sql.NullTime{Time: until, Valid: !until.IsZero()}
+	args := make([]any, 0, len(pointsArgs)+6)
+	args = append(args, cutoff, cutoff)
+	args = append(args, pointsArgs...)
+	return append(args, cutoff, cutoff, cutoff, cutoff)
+}
+
+// challengePointsTable は points の問題IDと得点を行にした導出表とその引数を返す。points が空の場合は行のない表を返す
+func challengePointsTable(points map[string]int) (string, []any) {
+	if len(points) == 0 {
+		return `SELECT NULL AS challenge_id, NULL AS points FROM DUAL WHERE FALSE`, nil
+	}
+
+	ids := make([]string, 0, len(points))
+	for id := range points {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	rows := make([]string, 0, len(ids))
+	args := make([]any, 0, len(ids)*2)
+	for _, id := range ids {
+		rows = append(rows, `SELECT ? AS challenge_id, ? AS points`)
+		args = append(args, id, points[id])
+	}
+	return strings.Join(rows, " UNION ALL "), args
+}
+
+// FindScoreEvents は正解・部分フラグの正解・ヒントの公開による得点の増減を時刻順に返す
+func (r *MySQLSubmissionRepository) FindScoreEvents(ctx context.Context, userIDs []string, until time.Time, points map[string]int) ([]*domain.ScoreEvent, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	in := placeholders(len(userIDs))
+	pointsTable, pointsArgs := challengePointsTable(points)
+	query := `
+		SELECT x.owner_id, x.points, x.occurred_at
+		FROM (
+			SELECT s.user_id AS owner_id,
+				COALESCE(cp.points, c.points) + CASE s.solve_rank WHEN 1 THEN c.first_blood_bonus WHEN 2 THEN c.second_blood_bonus WHEN 3 THEN c.third_blood_bonus ELSE 0 END AS points,
+				s.solved_at AS occurred_at
+			FROM (
+				SELECT user_id, challenge_id, MIN(submitted_at) AS solved_at, MIN(solve_rank) AS solve_rank
+				FROM submissions
+				WHERE is_correct = TRUE AND part_id IS NULL AND user_id IN (` + in + `) AND (? IS NULL OR submitted_at < ?)
+				GROUP BY user_id, challenge_id
+			) s
+			JOIN challenges c ON c.id = s.challenge_id
+			LEFT JOIN (` + pointsTable + `) cp ON cp.challenge_id = c.id
+			UNION ALL
+			SELECT ps.user_id, fp.points, ps.solved_at
+			FROM flag_part_solves ps
+			JOIN flag_parts fp ON fp.id = ps.part_id
+			WHERE ps.user_id IN (` + in + `) AND (? IS NULL OR ps.solved_at < ?)
+			UNION ALL
+			SELECT hu.user_id, -h.cost, hu.unlocked_at
+			FROM hint_unlocks hu
+			JOIN hints h ON h.id = hu.hint_id
+			WHERE hu.user_id IN (` + in + `) AND (? IS NULL OR hu.unlocked_at < ?)
+		) x
+		ORDER BY x.occurred_at ASC, x.owner_id ASC
+	`
+	return r.findScoreEvents(ctx, query, userIDs, until, pointsArgs)
+}
+
+// FindTeamScoreEvents はチームごとの得点の増減を時刻順に返す
+func (r *MySQLSubmissionRepository) FindTeamScoreEvents(ctx context.Context, teamIDs []string, until time.Time, points map[string]int) ([]*domain.ScoreEvent, error) {
+	if len(teamIDs) == 0 {
+		return nil, nil
+	}
+
+	in := placeholders(len(teamIDs))
+	pointsTable, pointsArgs := challengePointsTable(points)
+	query := `
+		SELECT x.owner_id, x.points, x.occurred_at
+		FROM (
+			SELECT s.team_id AS owner_id,
+				COALESCE(cp.points, c.points) + CASE s.solve_rank WHEN 1 THEN c.first_blood_bonus WHEN 2 THEN c.second_blood_bonus WHEN 3 THEN c.third_blood_bonus ELSE 0 END AS points,
+				s.solved_at AS occurred_at
+			FROM (
+				SELECT team_id, challenge_id, MIN(submitted_at) AS solved_at, MIN(solve_rank) AS solve_rank
+				FROM submissions
+				WHERE is_correct = TRUE AND part_id IS NULL AND team_id IN (` + in + `) AND user_id NOT IN (SELECT id FROM users WHERE banned_at IS NOT NULL) AND (? IS NULL OR submitted_at < ?)
+				GROUP BY team_id, challenge_id
+			) s
+			JOIN challenges c ON c.id = s.challenge_id
+			LEFT JOIN (` + pointsTable + `) cp ON cp.challenge_id = c.id
+			UNION ALL
+			SELECT ps.team_id, fp.points, ps.solved_at
+			FROM flag_part_solves ps
+			JOIN flag_parts fp ON fp.id = ps.part_id
+			WHERE ps.team_id IN (` + in + `) AND ps.user_id NOT IN (SELECT id FROM users WHERE banned_at IS NOT NULL) AND (? IS NULL OR ps.solved_at < ?)
+			UNION ALL
+			SELECT hu.team_id, -h.cost, hu.unlocked_at
+			FROM hint_unlocks hu
+			JOIN hints h ON h.id = hu.hint_id
+			WHERE hu.team_id IN (` + in + `) AND (? IS NULL OR hu.unlocked_at < ?)
+		) x
+		ORDER BY x.occurred_at ASC, x.owner_id ASC
+	`
+	return r.findScoreEvents(ctx, query, teamIDs, until, pointsArgs)
+}
+
+// findScoreEvents は ownerIDs と集計期限の組を3回繰り返した引数で query を実行する。問題の得点の表の引数は1回目の組の後に置く
+func (r *MySQLSubmissionRepository) findScoreEvents(ctx context.Context, query string, ownerIDs []string, until time.Time, pointsArgs []any) ([]*domain.ScoreEvent, error) {
+	cutoff := sql.NullTime{Time: until, Valid: !until.IsZero()}
+	args := make([]any, 0, (len(ownerIDs)+2)*3+len(pointsArgs))
+	for i := 0; i < 3; i++ {
+		for _, id := range ownerIDs {
+			args = append(args, id)
+		}
+		args = append(args, cutoff, cutoff)
+		if i == 0 {
+			args = append(args, pointsArgs...)
+		}
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*domain.ScoreEvent
+	for rows.Next() {
+		event := &domain.ScoreEvent{}
+		if err := rows.Scan(&event.OwnerID, &event.Points, &event.OccurredAt); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// FindSharedIncorrect は同じ問題に対して同じ誤答が複数の主体(チームで提出されたものはチーム)から提出されたものを返す
+func (r *MySQLSubmissionRepository) FindSharedIncorrect(ctx context.Context) ([]*domain.Submission, error) {
+	query := `
+		SELECT s.id, s.user_id, s.team_id, s.challenge_id, s.submitted_flag, s.is_correct, s.part_id, s.completed_part_id, s.solve_rank, s.submitted_at, s.invalidated_at
+		FROM submissions s
+		JOIN (
+			SELECT challenge_id, BINARY submitted_flag AS submitted_flag
+			FROM submissions
+			WHERE is_correct = FALSE AND invalidated_at IS NULL
+			GROUP BY challenge_id, BINARY submitted_flag
+			HAVING COUNT(DISTINCT COALESCE(team_id, user_id)) > 1
+		) d ON d.challenge_id = s.challenge_id AND d.submitted_flag = BINARY s.submitted_flag
+		WHERE s.is_correct = FALSE AND s.invalidated_at IS NULL
+		ORDER BY s.challenge_id, s.submitted_at
+	`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSubmissions(rows)
+}
+
+func (r *MySQLSubmissionRepository) FindSolves(ctx context.Context) ([]*domain.Submission, error) {
+	query := `
+		SELECT s.id, s.user_id, s.team_id, s.challenge_id, s.submitted_flag, s.is_correct, s.part_id, s.completed_part_id, s.solve_rank, s.submitted_at, s.invalidated_at
+		FROM submissions s
+		JOIN (
+			SELECT challenge_id, COALESCE(team_id, user_id) AS owner_id, MIN(submitted_at) AS solved_at
+			FROM submissions
+			WHERE is_correct = TRUE AND part_id IS NULL
+			GROUP BY challenge_id, COALESCE(team_id, user_id)
+		) f ON f.challenge_id = s.challenge_id
+			AND f.owner_id = COALESCE(s.team_id, s.user_id)
+			AND f.solved_at = s.submitted_at
+		WHERE s.is_correct = TRUE AND s.part_id IS NULL
+		ORDER BY s.challenge_id, s.submitted_at
+	`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSubmissions(rows)
+}
+
+func (r *MySQLSubmissionRepository) FindSubmissions(ctx context.Context, filter *domain.SubmissionFilter) ([]*domain.Submission, error) {
+	var conditions []string
+	var args []any
+	if filter.UserID != "" {
+		conditions = append(conditions, "user_id = ?")
+		args = append(args, filter.UserID)
+	}
+	if filter.ChallengeID != "" {
+		conditions = append(conditions, "challenge_id = ?")
+		args = append(args, filter.ChallengeID)
+	}
+	switch filter.Result {
+	case domain.SubmissionResultCorrect:
+		conditions = append(conditions, "is_correct = TRUE")
+	case domain.SubmissionResultIncorrect:
+		conditions = append(conditions, "is_correct = FALSE")
+	}
+	if !filter.Since.IsZero() {
+		conditions = append(conditions, "submitted_at >= ?")
+		args = append(args, filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		conditions = append(conditions, "submitted_at < ?")
+		args = append(args, filter.Until)
+	}
+
+	// 同じ時刻の提出は ID で順序を決め、カーソルの位置が一意になるようにする
+	direction, compare := "DESC", "<"
+	if filter.Order == domain.SubmissionOrderOldestFirst {
+		direction, compare = "ASC", ">"
+	}
+	if filter.After != nil {
+		conditions = append(conditions, "(submitted_at "+compare+" ? OR (submitted_at = ? AND id "+compare+" ?))")
+		args = append(args, filter.After.SubmittedAt, filter.After.SubmittedAt, filter.After.SubmissionID)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := `
+		SELECT id, user_id, team_id, challenge_id, submitted_flag, is_correct, part_id, completed_part_id, solve_rank, submitted_at, invalidated_at
 		FROM submissions
-		WHERE user_id = ? AND challenge_id = ?
-		ORDER BY submitted_at DESC
+		` + where + `
+		ORDER BY submitted_at ` + direction + `, id ` + direction + `
+		LIMIT ?
 	`
-	rows, err := r.db.QueryContext(ctx, query, userID, challengeID)
+	args = append(args, filter.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	return scanSubmissions(rows)
+}
+
+// Invalidate は提出を不正解に変え、solves と部分フラグの正解記録を削除する
+// 順位の繰り上げが CreateSolve の採番と競合しないよう、CreateSolve と同じく問題の行をロックする
+func (r *MySQLSubmissionRepository) Invalidate(ctx context.Context, submissionID string, invalidatedAt time.Time) error {
+	submission, err := r.FindByID(ctx, submissionID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var challengeID string
+	err = tx.QueryRowContext(ctx, `SELECT id FROM challenges WHERE id = ? FOR UPDATE`, submission.ChallengeID).Scan(&challengeID)
+	if err == sql.ErrNoRows {
+		return domain.ErrChallengeNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	var isCorrect bool
+	var partID, completedPartID sql.NullString
+	var solveRank sql.NullInt64
+	err = tx.QueryRowContext(ctx,
+		`SELECT is_correct, part_id, completed_part_id, solve_rank FROM submissions WHERE id = ? FOR UPDATE`,
+		submissionID,
+	).Scan(&isCorrect, &partID, &completedPartID, &solveRank)
+	if err == sql.ErrNoRows {
+		return domain.ErrSubmissionNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !isCorrect {
+		return domain.ErrSubmissionNotCorrect
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE submissions SET is_correct = FALSE, solve_rank = NULL, invalidated_at = ? WHERE id = ?`,
+		invalidatedAt,
+		submissionID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if partID.Valid {
+		_, err = tx.ExecContext(ctx,
+			`DELETE FROM flag_part_solves WHERE part_id = ? AND owner_id = ?`,
+			partID.String,
+			submission.OwnerID(),
+		)
+		if err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM solves WHERE submission_id = ?`, submissionID); err != nil {
+		return err
+	}
+
+	// 最後の部分フラグで解き終えた場合、その部分も解き直せるようにする
+	if completedPartID.Valid {
+		_, err = tx.ExecContext(ctx,
+			`DELETE FROM flag_part_solves WHERE part_id = ? AND owner_id = ?`,
+			completedPartID.String,
+			submission.OwnerID(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if solveRank.Valid {
+		// 一意制約に違反しないよう、小さい順位から繰り上げる
+		_, err = tx.ExecContext(ctx,
+			`UPDATE submissions SET solve_rank = solve_rank - 1 WHERE challenge_id = ? AND solve_rank > ? ORDER BY solve_rank ASC`,
+			submission.ChallengeID,
+			solveRank.Int64,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := updateDynamicPoints(ctx, tx, submission.ChallengeID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func scanSubmissions(rows *sql.Rows) ([]*domain.Submission, error) {
 	var submissions []*domain.Submission
 	for rows.Next() {
 		submission := &domain.Submission{}
+		var teamID, partID, completedPartID sql.NullString
+		var solveRank sql.NullInt64
+		var invalidatedAt sql.NullTime
 		if err := rows.Scan(
 			&submission.SubmissionID,
 			&submission.UserID,
+			&teamID,
 			&submission.ChallengeID,
 			&submission.SubmittedFlag,
 			&submission.IsCorrect,
+			&partID,
+			&completedPartID,
+			&solveRank,
 			&submission.SubmittedAt,
+			&invalidatedAt,
 		); err != nil {
 			return nil, err
 		}
+		submission.TeamID = teamID.String
+		submission.PartID = partID.String
+		submission.CompletedPartID = completedPartID.String
+		submission.SolveRank = int(solveRank.Int64)
+		submission.InvalidatedAt = invalidatedAt.Time
 		submissions = append(submissions, submission)
 	}
 
 	return submissions, rows.Err()
 }
+
+// placeholders は IN 句に使う n 個のプレースホルダーを返す
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
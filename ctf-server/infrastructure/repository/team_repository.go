@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MySQLTeamRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLTeamRepository(db *sql.DB) *MySQLTeamRepository {
+	return &MySQLTeamRepository{db: db}
+}
+
+// Create は同じユーザーが同時に作成しても、メンバーのいないチームが残らないようにする
+func (r *MySQLTeamRepository) Create(ctx context.Context, team *domain.Team, ownerID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO teams (id, name, invite_code, created_at)
+		VALUES (?, ?, ?, ?)
+	`
+	_, err = tx.ExecContext(ctx, query,
+		team.TeamID,
+		team.Name,
+		team.InviteCode,
+		team.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO team_members (user_id, team_id, joined_at) VALUES (?, ?, ?)`,
+		ownerID,
+		team.TeamID,
+		team.CreatedAt,
+	)
+	if isDuplicateEntry(err) {
+		return domain.ErrAlreadyInTeam
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// isDuplicateEntry は一意制約の違反によるエラーかを返す
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
+
+// isMissingReference は外部キーの参照先が存在しないことによるエラーかを返す
+func isMissingReference(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1452
+}
+
+func (r *MySQLTeamRepository) FindByID(ctx context.Context, teamID string) (*domain.Team, error) {
+	query := `
+		SELECT id, name, invite_code, created_at
+		FROM teams
+		WHERE id = ?
+	`
+	return r.findOne(ctx, query, teamID)
+}
+
+func (r *MySQLTeamRepository) FindByName(ctx context.Context, name string) (*domain.Team, error) {
+	query := `
+		SELECT id, name, invite_code, created_at
+		FROM teams
+		WHERE name = ?
+	`
+	return r.findOne(ctx, query, name)
+}
+
+func (r *MySQLTeamRepository) FindByInviteCode(ctx context.Context, inviteCode string) (*domain.Team, error) {
+	query := `
+		SELECT id, name, invite_code, created_at
+		FROM teams
+		WHERE invite_code = ?
+	`
+	return r.findOne(ctx, query, inviteCode)
+}
+
+func (r *MySQLTeamRepository) FindByUserID(ctx context.Context, userID string) (*domain.Team, error) {
+	query := `
+		SELECT t.id, t.name, t.invite_code, t.created_at
+		FROM teams t
+		JOIN team_members m ON m.team_id = t.id
+		WHERE m.user_id = ?
+	`
+	return r.findOne(ctx, query, userID)
+}
+
+func (r *MySQLTeamRepository) FindMembers(ctx context.Context, teamID string) ([]*domain.TeamMember, error) {
+	query := `
+		SELECT u.id, u.username, m.joined_at
+		FROM team_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.team_id = ?
+		ORDER BY m.joined_at ASC
+	`
+	rows, err := r.db.QueryContext(ctx, query, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*domain.TeamMember
+	for rows.Next() {
+		member := &domain.TeamMember{}
+		if err := rows.Scan(
+			&member.UserID,
+			&member.Username,
+			&member.JoinedAt,
+		); err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+// AddMember は外部キーの確認でチームの行を共有ロックするため、RemoveMember によるチームの削除と同時には実行されない
+func (r *MySQLTeamRepository) AddMember(ctx context.Context, teamID, userID string, joinedAt time.Time) error {
+	query := `
+		INSERT INTO team_members (user_id, team_id, joined_at)
+		VALUES (?, ?, ?)
+	`
+	_, err := r.db.ExecContext(ctx, query, userID, teamID, joinedAt)
+	if isDuplicateEntry(err) {
+		return domain.ErrAlreadyInTeam
+	}
+	if isMissingReference(err) {
+		return domain.ErrTeamNotFound
+	}
+	return err
+}
+
+// RemoveMember はチームの行をロックしてメンバーを削除し、メンバーがいなくなった場合はチームも削除する
+func (r *MySQLTeamRepository) RemoveMember(ctx context.Context, teamID, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var id string
+	err = tx.QueryRowContext(ctx, `SELECT id FROM teams WHERE id = ? FOR UPDATE`, teamID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return domain.ErrNotInTeam
+	}
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM team_members WHERE team_id = ? AND user_id = ?`, teamID, userID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrNotInTeam
+	}
+
+	var remaining int
+	if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM team_members WHERE team_id = ?`, teamID).Scan(&remaining); err != nil {
+		return err
+	}
+	if remaining == 0 {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM teams WHERE id = ?`, teamID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *MySQLTeamRepository) findOne(ctx context.Context, query string, arg string) (*domain.Team, error) {
+	team := &domain.Team{}
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(
+		&team.TeamID,
+		&team.Name,
+		&team.InviteCode,
+		&team.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return team, nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/kavos113/quickctf/ctf-server/domain"
@@ -20,14 +21,19 @@ func NewMySQLUserRepository(db *sql.DB) *MySQLUserRepository {
 
 func (r *MySQLUserRepository) Create(ctx context.Context, user *domain.User) error {
 	query := `
-		INSERT INTO users (id, username, email, password_hash, is_admin, created_at, updated_at)
-		VALUES (?, ?, '', ?, FALSE, ?, ?)
+		INSERT INTO users (id, username, email, display_name, affiliation, country, password_hash, role, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 	
 	_, err := r.db.ExecContext(ctx, query,
 		user.UserID,
 		user.Username,
+		sql.NullString{String: user.Email, Valid: user.Email != ""},
+		user.DisplayName,
+		user.Affiliation,
+		user.Country,
 		user.PasswordHash,
+		user.Role,
 		user.CreatedAt,
 		user.UpdatedAt,
 	)
@@ -41,17 +47,25 @@ func (r *MySQLUserRepository) Create(ctx context.Context, user *domain.User) err
 
 func (r *MySQLUserRepository) FindByID(ctx context.Context, userID string) (*domain.User, error) {
 	query := `
-		SELECT id, username, password_hash, created_at, updated_at
+		SELECT id, username, email, display_name, affiliation, country, password_hash, role, banned_at, created_at, updated_at
 		FROM users
 		WHERE id = ?
 	`
 	
 	var user domain.User
+	var email sql.NullString
+	var bannedAt sql.NullTime
 	
 	err := r.db.QueryRowContext(ctx, query, userID).Scan(
 		&user.UserID,
 		&user.Username,
+		&email,
+		&user.DisplayName,
+		&user.Affiliation,
+		&user.Country,
 		&user.PasswordHash,
+		&user.Role,
+		&bannedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -62,23 +76,33 @@ func (r *MySQLUserRepository) FindByID(ctx context.Context, userID string) (*dom
 	if err != nil {
 		return nil, err
 	}
+	user.Email = email.String
+	user.BannedAt = bannedAt.Time
 	
 	return &user, nil
 }
 
 func (r *MySQLUserRepository) FindByUsername(ctx context.Context, username string) (*domain.User, error) {
 	query := `
-		SELECT id, username, password_hash, created_at, updated_at
+		SELECT id, username, email, display_name, affiliation, country, password_hash, role, banned_at, created_at, updated_at
 		FROM users
 		WHERE username = ?
 	`
 	
 	var user domain.User
+	var email sql.NullString
+	var bannedAt sql.NullTime
 	
 	err := r.db.QueryRowContext(ctx, query, username).Scan(
 		&user.UserID,
 		&user.Username,
+		&email,
+		&user.DisplayName,
+		&user.Affiliation,
+		&user.Country,
 		&user.PasswordHash,
+		&user.Role,
+		&bannedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -89,37 +113,95 @@ func (r *MySQLUserRepository) FindByUsername(ctx context.Context, username strin
 	if err != nil {
 		return nil, err
 	}
+	user.Email = email.String
+	user.BannedAt = bannedAt.Time
 	
 	return &user, nil
 }
 
-func (r *MySQLUserRepository) Update(ctx context.Context, user *domain.User) error {
+func (r *MySQLUserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
+	query := `
+		SELECT id, username, email, display_name, affiliation, country, password_hash, role, banned_at, created_at, updated_at
+		FROM users
+		WHERE email = ?
+	`
+	
+	var user domain.User
+	var storedEmail sql.NullString
+	var bannedAt sql.NullTime
+	
+	err := r.db.QueryRowContext(ctx, query, email).Scan(
+		&user.UserID,
+		&user.Username,
+		&storedEmail,
+		&user.DisplayName,
+		&user.Affiliation,
+		&user.Country,
+		&user.PasswordHash,
+		&user.Role,
+		&bannedAt,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	user.Email = storedEmail.String
+	user.BannedAt = bannedAt.Time
+	
+	return &user, nil
+}
+
+// UpdateProfile はプロフィールだけを更新する。パスワードは同時に変更されても上書きしない
+func (r *MySQLUserRepository) UpdateProfile(ctx context.Context, user *domain.User) error {
 	query := `
 		UPDATE users
-		SET username = ?, password_hash = ?, updated_at = ?
+		SET email = ?, display_name = ?, affiliation = ?, country = ?, updated_at = ?
 		WHERE id = ?
 	`
-	
 	result, err := r.db.ExecContext(ctx, query,
-		user.Username,
-		user.PasswordHash,
+		sql.NullString{String: user.Email, Valid: user.Email != ""},
+		user.DisplayName,
+		user.Affiliation,
+		user.Country,
 		time.Now(),
 		user.UserID,
 	)
-	
+	if isDuplicateEntry(err) {
+		return domain.ErrEmailAlreadyExists
+	}
 	if err != nil {
 		return err
 	}
-	
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *MySQLUserRepository) UpdatePassword(ctx context.Context, userID, passwordHash string) error {
+	query := `UPDATE users SET password_hash = ?, updated_at = ? WHERE id = ?`
+	result, err := r.db.ExecContext(ctx, query, passwordHash, time.Now(), userID)
+	if err != nil {
+		return err
+	}
+
 	rows, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
-	
 	if rows == 0 {
 		return domain.ErrUserNotFound
 	}
-	
 	return nil
 }
 
@@ -142,3 +224,160 @@ func (r *MySQLUserRepository) Delete(ctx context.Context, userID string) error {
 	
 	return nil
 }
+
+func (r *MySQLUserRepository) FindStaff(ctx context.Context) ([]*domain.User, error) {
+	query := `
+		SELECT id, username, email, display_name, affiliation, country, password_hash, role, banned_at, created_at, updated_at
+		FROM users
+		WHERE role != ''
+		ORDER BY username ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanUsers(rows)
+}
+
+// FindUsers は登録日時の新しい順にユーザーを返す。同じ日時の場合はIDの降順
+func (r *MySQLUserRepository) FindUsers(ctx context.Context, filter *domain.UserFilter) ([]*domain.User, error) {
+	var conditions []string
+	var args []any
+	if filter.Query != "" {
+		conditions = append(conditions, "username LIKE ?")
+		args = append(args, "%"+escapeLike(filter.Query)+"%")
+	}
+	if filter.After != nil {
+		conditions = append(conditions, "(created_at < ? OR (created_at = ? AND id < ?))")
+		args = append(args, filter.After.CreatedAt, filter.After.CreatedAt, filter.After.UserID)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := `
+		SELECT id, username, email, display_name, affiliation, country, password_hash, role, banned_at, created_at, updated_at
+		FROM users
+		` + where + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+	`
+	args = append(args, filter.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanUsers(rows)
+}
+
+// escapeLike は LIKE の特殊文字をエスケープする
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+func scanUsers(rows *sql.Rows) ([]*domain.User, error) {
+	var users []*domain.User
+	for rows.Next() {
+		user := &domain.User{}
+		var email sql.NullString
+		var bannedAt sql.NullTime
+		if err := rows.Scan(
+			&user.UserID,
+			&user.Username,
+			&email,
+			&user.DisplayName,
+			&user.Affiliation,
+			&user.Country,
+			&user.PasswordHash,
+			&user.Role,
+			&bannedAt,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		user.Email = email.String
+		user.BannedAt = bannedAt.Time
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// SetRole は superadmin の行をロックして数え、同時に外されても superadmin がいなくならないようにする
+func (r *MySQLUserRepository) SetRole(ctx context.Context, userID string, role domain.Role) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM users WHERE role = ? FOR UPDATE`, domain.RoleSuperadmin)
+	if err != nil {
+		return err
+	}
+	superadmins := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		superadmins[id] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if superadmins[userID] && role != domain.RoleSuperadmin && len(superadmins) == 1 {
+		return domain.ErrLastAdmin
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE users SET role = ?, updated_at = ? WHERE id = ?`, role, time.Now(), userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// BootstrapAdmin は users をロックして superadmin の有無を確認し、同時に呼ばれても最初の superadmin が2人にならないようにする
+func (r *MySQLUserRepository) BootstrapAdmin(ctx context.Context, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var superadmins int
+	if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM users WHERE role = ? FOR UPDATE`, domain.RoleSuperadmin).Scan(&superadmins); err != nil {
+		return err
+	}
+	if superadmins > 0 {
+		return domain.ErrAdminAlreadyExists
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE users SET role = ?, updated_at = ? WHERE id = ?`, domain.RoleSuperadmin, time.Now(), userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *MySQLUserRepository) SetBanned(ctx context.Context, userID string, bannedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE users SET banned_at = ?, updated_at = ? WHERE id = ?`,
+		sql.NullTime{Time: bannedAt, Valid: !bannedAt.IsZero()},
+		time.Now(),
+		userID,
+	)
+	return err
+}
@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+	"github.com/kavos113/quickctf/ctf-server/usecase"
+	pb "github.com/kavos113/quickctf/gen/go/api/server/v1"
+	"github.com/kavos113/quickctf/gen/go/api/server/v1/serverv1connect"
+)
+
+// AuditInterceptor は AdminService の変更操作を、対象の変更前後の差分とともに監査ログに記録する
+// セッションを使うため AuthInterceptor より内側に置く
+type AuditInterceptor struct {
+	auditUsecase   *usecase.AuditUsecase
+	targetTypes    map[string]domain.AuditTargetType
+	trustedProxies []*net.IPNet
+}
+
+// NewAuditInterceptor は trustedProxies からの接続の場合のみ X-Forwarded-For と X-Real-IP を接続元として使う
+func NewAuditInterceptor(auditUsecase *usecase.AuditUsecase, trustedProxies []*net.IPNet) *AuditInterceptor {
+	targetTypes := map[string]domain.AuditTargetType{
+		serverv1connect.AdminServiceCreateChallengeProcedure:      domain.AuditTargetChallenge,
+		serverv1connect.AdminServiceUpdateChallengeProcedure:      domain.AuditTargetChallenge,
+		serverv1connect.AdminServiceUploadChallengeImageProcedure: domain.AuditTargetChallenge,
+		serverv1connect.AdminServiceDeleteChallengeProcedure:      domain.AuditTargetChallenge,
+		serverv1connect.AdminServiceUploadAttachmentProcedure:     domain.AuditTargetChallenge,
+		serverv1connect.AdminServiceDeleteAttachmentProcedure:     domain.AuditTargetChallenge,
+		serverv1connect.AdminServiceUpdateEventConfigProcedure:    domain.AuditTargetEventConfig,
+		serverv1connect.AdminServiceInvalidateSubmissionProcedure: domain.AuditTargetSubmission,
+		serverv1connect.AdminServiceGrantAdminProcedure:           domain.AuditTargetUser,
+		serverv1connect.AdminServiceRevokeAdminProcedure:          domain.AuditTargetUser,
+		serverv1connect.AdminServiceBanUserProcedure:              domain.AuditTargetUser,
+		serverv1connect.AdminServiceUnbanUserProcedure:            domain.AuditTargetUser,
+		serverv1connect.AdminServiceResetUserPasswordProcedure:    domain.AuditTargetUser,
+		serverv1connect.AdminServiceDeleteUserProcedure:           domain.AuditTargetUser,
+		serverv1connect.AdminServiceCreateHintProcedure:           domain.AuditTargetHint,
+		serverv1connect.AdminServiceUpdateHintProcedure:           domain.AuditTargetHint,
+		serverv1connect.AdminServiceDeleteHintProcedure:           domain.AuditTargetHint,
+		serverv1connect.AdminServiceCreateAnnouncementProcedure:   domain.AuditTargetAnnouncement,
+		serverv1connect.AdminServiceUpdateAnnouncementProcedure:   domain.AuditTargetAnnouncement,
+		serverv1connect.AdminServiceDeleteAnnouncementProcedure:   domain.AuditTargetAnnouncement,
+	}
+
+	return &AuditInterceptor{
+		auditUsecase:   auditUsecase,
+		targetTypes:    targetTypes,
+		trustedProxies: trustedProxies,
+	}
+}
+
+func (i *AuditInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		targetType, ok := i.targetTypes[req.Spec().Procedure]
+		if !ok {
+			return next(ctx, req)
+		}
+
+		session, ok := ctx.Value(SessionContextKey).(*domain.Session)
+		if !ok {
+			return next(ctx, req)
+		}
+
+		targetID := i.targetID(ctx, req.Any())
+		before, err := i.auditUsecase.Snapshot(ctx, targetType, targetID)
+		if err != nil {
+			log.Printf("Failed to take audit snapshot: %v", err)
+		}
+
+		resp, callErr := next(ctx, req)
+
+		// クライアントが切断しても記録する
+		ctx = context.WithoutCancel(ctx)
+
+		event := &domain.AuditEvent{
+			ActorID:    session.UserID,
+			Action:     path.Base(req.Spec().Procedure),
+			TargetType: targetType,
+			TargetID:   targetID,
+			ClientIP:   clientIP(req.Peer().Addr, req.Header(), i.trustedProxies),
+		}
+		if callErr != nil {
+			event.ErrorMessage = callErr.Error()
+		} else if r, ok := resp.Any().(interface{ GetErrorMessage() string }); ok {
+			event.ErrorMessage = r.GetErrorMessage()
+		}
+		if event.TargetID == "" && callErr == nil {
+			event.TargetID = createdTargetID(resp.Any())
+		}
+
+		after, err := i.auditUsecase.Snapshot(ctx, targetType, event.TargetID)
+		if err != nil {
+			log.Printf("Failed to take audit snapshot: %v", err)
+		}
+		event.Changes = domain.DiffAuditSnapshots(before, after)
+
+		if err := i.auditUsecase.Record(ctx, event); err != nil {
+			log.Printf("Failed to record audit event: %v", err)
+		}
+
+		return resp, callErr
+	}
+}
+
+func (i *AuditInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler は何もしない。ストリーミングのメソッドに変更操作はない
+func (i *AuditInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// targetID はリクエストから操作の対象のIDを返す。作成の場合は空文字列
+func (i *AuditInterceptor) targetID(ctx context.Context, msg any) string {
+	switch m := msg.(type) {
+	case *pb.UpdateChallengeRequest:
+		return m.GetChallenge().GetChallengeId()
+	case *pb.UploadChallengeImageRequest:
+		return m.GetChallengeId()
+	case *pb.DeleteChallengeRequest:
+		return m.GetChallengeId()
+	case *pb.UploadAttachmentRequest:
+		return m.GetChallengeId()
+	case *pb.DeleteAttachmentRequest:
+		return m.GetChallengeId()
+	case *pb.InvalidateSubmissionRequest:
+		return m.GetSubmissionId()
+	case *pb.GrantAdminRequest:
+		return i.auditUsecase.FindUserID(ctx, m.GetUsername())
+	case *pb.RevokeAdminRequest:
+		return m.GetUserId()
+	case *pb.BanUserRequest:
+		return m.GetUserId()
+	case *pb.UnbanUserRequest:
+		return m.GetUserId()
+	case *pb.ResetUserPasswordRequest:
+		return m.GetUserId()
+	case *pb.DeleteUserRequest:
+		return m.GetUserId()
+	case *pb.UpdateHintRequest:
+		return m.GetHint().GetHintId()
+	case *pb.DeleteHintRequest:
+		return m.GetHintId()
+	case *pb.UpdateAnnouncementRequest:
+		return m.GetAnnouncement().GetAnnouncementId()
+	case *pb.DeleteAnnouncementRequest:
+		return m.GetAnnouncementId()
+	default:
+		return ""
+	}
+}
+
+// createdTargetID は作成操作のレスポンスから作成されたもののIDを返す
+func createdTargetID(msg any) string {
+	switch m := msg.(type) {
+	case *pb.CreateChallengeResponse:
+		return m.GetChallengeId()
+	case *pb.CreateHintResponse:
+		return m.GetHintId()
+	case *pb.CreateAnnouncementResponse:
+		return m.GetAnnouncementId()
+	default:
+		return ""
+	}
+}
+
+// clientIP は接続元のIPアドレスを返す。信頼できるプロキシからの接続の場合のみ、
+// X-Forwarded-For を右から見て最初の信頼できないアドレス、なければ X-Real-IP を使う
+func clientIP(peerAddr string, header http.Header, trustedProxies []*net.IPNet) string {
+	host, _, err := net.SplitHostPort(peerAddr)
+	if err != nil {
+		host = peerAddr
+	}
+	if !isTrustedProxy(host, trustedProxies) {
+		return host
+	}
+
+	if values := header.Values("X-Forwarded-For"); len(values) > 0 {
+		forwarded := strings.Split(strings.Join(values, ","), ",")
+		for j := len(forwarded) - 1; j >= 0; j-- {
+			ip := net.ParseIP(strings.TrimSpace(forwarded[j]))
+			if ip == nil {
+				break
+			}
+			if !isTrustedProxy(ip.String(), trustedProxies) {
+				return ip.String()
+			}
+		}
+	}
+	if ip := net.ParseIP(strings.TrimSpace(header.Get("X-Real-IP"))); ip != nil {
+		return ip.String()
+	}
+
+	return host
+}
+
+func isTrustedProxy(host string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, n := range trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
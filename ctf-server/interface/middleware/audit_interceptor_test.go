@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	_, proxies, _ := net.ParseCIDR("10.0.0.0/8")
+	trustedProxies := []*net.IPNet{proxies}
+
+	tests := []struct {
+		name     string
+		peerAddr string
+		header   http.Header
+		want     string
+	}{
+		{
+			name:     "peer address without headers",
+			peerAddr: "203.0.113.1:54321",
+			want:     "203.0.113.1",
+		},
+		{
+			name:     "forwarded header from untrusted peer is ignored",
+			peerAddr: "203.0.113.1:54321",
+			header:   http.Header{"X-Forwarded-For": {"198.51.100.7"}, "X-Real-Ip": {"198.51.100.7"}},
+			want:     "203.0.113.1",
+		},
+		{
+			name:     "forwarded header from trusted proxy",
+			peerAddr: "10.0.0.2:54321",
+			header:   http.Header{"X-Forwarded-For": {"198.51.100.7"}},
+			want:     "198.51.100.7",
+		},
+		{
+			name:     "spoofed leftmost address is skipped",
+			peerAddr: "10.0.0.2:54321",
+			header:   http.Header{"X-Forwarded-For": {"192.0.2.99, 198.51.100.7, 10.0.0.3"}},
+			want:     "198.51.100.7",
+		},
+		{
+			name:     "real ip header from trusted proxy",
+			peerAddr: "10.0.0.2:54321",
+			header:   http.Header{"X-Real-Ip": {"2001:db8::1"}},
+			want:     "2001:db8::1",
+		},
+		{
+			name:     "oversized forwarded header from trusted proxy",
+			peerAddr: "10.0.0.2:54321",
+			header:   http.Header{"X-Forwarded-For": {strings.Repeat("a", 100)}, "X-Real-Ip": {strings.Repeat("1", 100)}},
+			want:     "10.0.0.2",
+		},
+		{
+			name:     "oversized forwarded header from untrusted peer",
+			peerAddr: "203.0.113.1:54321",
+			header:   http.Header{"X-Forwarded-For": {strings.Repeat("a", 100)}},
+			want:     "203.0.113.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := tt.header
+			if header == nil {
+				header = http.Header{}
+			}
+			if got := clientIP(tt.peerAddr, header, trustedProxies); got != tt.want {
+				t.Errorf("clientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
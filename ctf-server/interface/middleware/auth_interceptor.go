@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"log"
+	"net/http"
 
 	"connectrpc.com/connect"
 
@@ -41,28 +42,9 @@ func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 			return next(ctx, req)
 		}
 
-		token := req.Header().Get("Authorization")
-		if token == "" {
-			log.Printf("Authorization header not found")
-			return nil, connect.NewError(connect.CodeUnauthenticated, domain.ErrSessionNotFound)
-		}
-
-		if len(token) > 7 && token[:7] == "Bearer " {
-			token = token[7:]
-		}
-
-		session, err := i.sessionRepo.FindByToken(ctx, token)
+		session, err := i.authenticate(ctx, req.Header().Get("Authorization"))
 		if err != nil {
-			if err == domain.ErrSessionNotFound {
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
-			log.Printf("Failed to find session: %v", err)
-			return nil, connect.NewError(connect.CodeInternal, err)
-		}
-
-		if session.IsExpired() {
-			i.sessionRepo.Delete(ctx, token)
-			return nil, connect.NewError(connect.CodeUnauthenticated, domain.ErrSessionExpired)
+			return nil, err
 		}
 
 		ctx = context.WithValue(ctx, SessionContextKey, session)
@@ -84,33 +66,68 @@ func (i *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc
 			return next(ctx, conn)
 		}
 
-		token := conn.RequestHeader().Get("Authorization")
-		if token == "" {
-			log.Printf("Authorization header not found in streaming request")
-			return connect.NewError(connect.CodeUnauthenticated, domain.ErrSessionNotFound)
+		session, err := i.authenticate(ctx, conn.RequestHeader().Get("Authorization"))
+		if err != nil {
+			return err
 		}
 
-		if len(token) > 7 && token[:7] == "Bearer " {
-			token = token[7:]
+		ctx = context.WithValue(ctx, SessionContextKey, session)
+		ctx = context.WithValue(ctx, UserIDContextKey, session.UserID)
+
+		return next(ctx, conn)
+	}
+}
+
+// WrapHTTP は Connect 以外の HTTP ハンドラーに有効なセッションを渡す
+// Authorization ヘッダーがない場合はセッションなしで next を呼び出し、認可はハンドラーに任せる
+func (i *AuthInterceptor) WrapHTTP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			next.ServeHTTP(w, r)
+			return
 		}
 
-		session, err := i.sessionRepo.FindByToken(ctx, token)
+		session, err := i.authenticate(r.Context(), header)
 		if err != nil {
-			if err == domain.ErrSessionNotFound {
-				return connect.NewError(connect.CodeUnauthenticated, err)
+			status := http.StatusUnauthorized
+			if connect.CodeOf(err) == connect.CodeInternal {
+				status = http.StatusInternalServerError
 			}
-			log.Printf("Failed to find session: %v", err)
-			return connect.NewError(connect.CodeInternal, err)
-		}
-
-		if session.IsExpired() {
-			i.sessionRepo.Delete(ctx, token)
-			return connect.NewError(connect.CodeUnauthenticated, domain.ErrSessionExpired)
+			http.Error(w, http.StatusText(status), status)
+			return
 		}
 
-		ctx = context.WithValue(ctx, SessionContextKey, session)
+		ctx := context.WithValue(r.Context(), SessionContextKey, session)
 		ctx = context.WithValue(ctx, UserIDContextKey, session.UserID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
 
-		return next(ctx, conn)
+// authenticate は Authorization ヘッダーのトークンから有効なセッションを返す
+func (i *AuthInterceptor) authenticate(ctx context.Context, token string) (*domain.Session, error) {
+	if token == "" {
+		log.Printf("Authorization header not found")
+		return nil, connect.NewError(connect.CodeUnauthenticated, domain.ErrSessionNotFound)
+	}
+
+	if len(token) > 7 && token[:7] == "Bearer " {
+		token = token[7:]
+	}
+
+	session, err := i.sessionRepo.FindByToken(ctx, token)
+	if err != nil {
+		if err == domain.ErrSessionNotFound {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		log.Printf("Failed to find session: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+
+	if session.IsExpired() {
+		i.sessionRepo.Delete(ctx, token)
+		return nil, connect.NewError(connect.CodeUnauthenticated, domain.ErrSessionExpired)
+	}
+
+	return session, nil
 }
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	"connectrpc.com/connect"
 
+	"github.com/kavos113/quickctf/ctf-server/domain"
 	"github.com/kavos113/quickctf/ctf-server/usecase"
 	pb "github.com/kavos113/quickctf/gen/go/api/server/v1"
 	"github.com/kavos113/quickctf/gen/go/api/server/v1/serverv1connect"
@@ -30,9 +32,12 @@ func (s *AdminAuthService) AdminLogin(ctx context.Context, req *connect.Request[
 	}
 
 	err = s.usecase.ActivateAdminWithSession(ctx, session, req.Msg.Password)
+	if err == domain.ErrInvalidActivationCode {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
 	if err != nil {
 		log.Printf("Failed to activate admin: %v", err)
-		return connect.NewResponse(&pb.AdminLoginResponse{}), nil
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to activate admin"))
 	}
 
 	log.Printf("Admin activated for user: %s", session.UserID)
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 
@@ -31,16 +32,20 @@ func stringToBuildStatus(status string) pb.BuildStatus {
 type AdminService struct {
 	serverv1connect.UnimplementedAdminServiceHandler
 	adminUsecase *usecase.AdminServiceUsecase
+	auditUsecase *usecase.AuditUsecase
+	authorizer   *usecase.Authorizer
 }
 
-func NewAdminService(adminUsecase *usecase.AdminServiceUsecase) *AdminService {
+func NewAdminService(adminUsecase *usecase.AdminServiceUsecase, auditUsecase *usecase.AuditUsecase, authorizer *usecase.Authorizer) *AdminService {
 	return &AdminService{
 		adminUsecase: adminUsecase,
+		auditUsecase: auditUsecase,
+		authorizer:   authorizer,
 	}
 }
 
 func (s *AdminService) CreateChallenge(ctx context.Context, req *connect.Request[pb.CreateChallengeRequest]) (*connect.Response[pb.CreateChallengeResponse], error) {
-	_, err := requireAdminSession(ctx)
+	staff, err := requirePermission(ctx, s.authorizer, domain.PermissionManageOwnChallenges)
 	if err != nil {
 		return connect.NewResponse(&pb.CreateChallengeResponse{
 			ErrorMessage: err.Error(),
@@ -54,6 +59,20 @@ func (s *AdminService) CreateChallenge(ctx context.Context, req *connect.Request
 		Points:           int(req.Msg.Challenge.Points),
 		Genre:            req.Msg.Challenge.Genre,
 		RequiresInstance: req.Msg.Challenge.RequiresInstance,
+		ScoringType:      scoringTypeFromPB(req.Msg.Challenge.ScoringType),
+		InitialPoints:    int(req.Msg.Challenge.InitialPoints),
+		MinimumPoints:    int(req.Msg.Challenge.MinimumPoints),
+		Decay:            int(req.Msg.Challenge.Decay),
+		DynamicFlag:      req.Msg.Challenge.DynamicFlag,
+		FlagMatchMode:    flagMatchModeFromPB(req.Msg.Challenge.FlagMatchMode),
+		AcceptedFlags:    req.Msg.Challenge.AcceptedFlags,
+		Prerequisites:    req.Msg.Challenge.PrerequisiteIds,
+		PrerequisiteMode: prerequisiteModeFromPB(req.Msg.Challenge.PrerequisiteMode),
+		Visibility:       visibilityFromPB(req.Msg.Challenge.Visibility),
+		ReleaseAt:        unixToTime(req.Msg.Challenge.ReleaseAt),
+		Parts:            flagPartsFromPB(req.Msg.Challenge.Parts),
+		BloodBonuses:     bloodBonusesFromPB(req.Msg.Challenge.BloodBonuses),
+		AuthorID:         staff.UserID,
 	}
 
 	challengeID, err := s.adminUsecase.CreateChallenge(ctx, challenge)
@@ -69,7 +88,7 @@ func (s *AdminService) CreateChallenge(ctx context.Context, req *connect.Request
 }
 
 func (s *AdminService) UpdateChallenge(ctx context.Context, req *connect.Request[pb.UpdateChallengeRequest]) (*connect.Response[pb.UpdateChallengeResponse], error) {
-	_, err := requireAdminSession(ctx)
+	_, err := requireChallengePermission(ctx, s.authorizer, req.Msg.Challenge.ChallengeId)
 	if err != nil {
 		return connect.NewResponse(&pb.UpdateChallengeResponse{
 			ErrorMessage: err.Error(),
@@ -83,6 +102,19 @@ func (s *AdminService) UpdateChallenge(ctx context.Context, req *connect.Request
 		Points:           int(req.Msg.Challenge.Points),
 		Genre:            req.Msg.Challenge.Genre,
 		RequiresInstance: req.Msg.Challenge.RequiresInstance,
+		ScoringType:      scoringTypeFromPB(req.Msg.Challenge.ScoringType),
+		InitialPoints:    int(req.Msg.Challenge.InitialPoints),
+		MinimumPoints:    int(req.Msg.Challenge.MinimumPoints),
+		Decay:            int(req.Msg.Challenge.Decay),
+		DynamicFlag:      req.Msg.Challenge.DynamicFlag,
+		FlagMatchMode:    flagMatchModeFromPB(req.Msg.Challenge.FlagMatchMode),
+		AcceptedFlags:    req.Msg.Challenge.AcceptedFlags,
+		Prerequisites:    req.Msg.Challenge.PrerequisiteIds,
+		PrerequisiteMode: prerequisiteModeFromPB(req.Msg.Challenge.PrerequisiteMode),
+		Visibility:       visibilityFromPB(req.Msg.Challenge.Visibility),
+		ReleaseAt:        unixToTime(req.Msg.Challenge.ReleaseAt),
+		Parts:            flagPartsFromPB(req.Msg.Challenge.Parts),
+		BloodBonuses:     bloodBonusesFromPB(req.Msg.Challenge.BloodBonuses),
 	}
 
 	err = s.adminUsecase.UpdateChallenge(ctx, req.Msg.Challenge.ChallengeId, challenge)
@@ -96,7 +128,7 @@ func (s *AdminService) UpdateChallenge(ctx context.Context, req *connect.Request
 }
 
 func (s *AdminService) UploadChallengeImage(ctx context.Context, req *connect.Request[pb.UploadChallengeImageRequest]) (*connect.Response[pb.UploadChallengeImageResponse], error) {
-	_, err := requireAdminSession(ctx)
+	_, err := requireChallengePermission(ctx, s.authorizer, req.Msg.ChallengeId)
 	if err != nil {
 		return connect.NewResponse(&pb.UploadChallengeImageResponse{
 			ErrorMessage: err.Error(),
@@ -114,7 +146,7 @@ func (s *AdminService) UploadChallengeImage(ctx context.Context, req *connect.Re
 }
 
 func (s *AdminService) DeleteChallenge(ctx context.Context, req *connect.Request[pb.DeleteChallengeRequest]) (*connect.Response[pb.DeleteChallengeResponse], error) {
-	_, err := requireAdminSession(ctx)
+	_, err := requireChallengePermission(ctx, s.authorizer, req.Msg.ChallengeId)
 	if err != nil {
 		return connect.NewResponse(&pb.DeleteChallengeResponse{
 			ErrorMessage: err.Error(),
@@ -132,14 +164,14 @@ func (s *AdminService) DeleteChallenge(ctx context.Context, req *connect.Request
 }
 
 func (s *AdminService) ListChallenges(ctx context.Context, req *connect.Request[pb.ListChallengesRequest]) (*connect.Response[pb.ListChallengesResponse], error) {
-	_, err := requireAdminSession(ctx)
+	staff, err := requirePermission(ctx, s.authorizer, domain.PermissionManageOwnChallenges)
 	if err != nil {
 		return connect.NewResponse(&pb.ListChallengesResponse{
 			ErrorMessage: err.Error(),
 		}), nil
 	}
 
-	challenges, err := s.adminUsecase.ListChallenges(ctx)
+	challenges, err := s.adminUsecase.ListChallenges(ctx, staff)
 	if err != nil {
 		return connect.NewResponse(&pb.ListChallengesResponse{
 			ErrorMessage: err.Error(),
@@ -167,6 +199,20 @@ func (s *AdminService) ListChallenges(ctx context.Context, req *connect.Request[
 			Genre:            c.Genre,
 			Attachments:      pbAttachments,
 			RequiresInstance: c.RequiresInstance,
+			ScoringType:      scoringTypeToPB(c.ScoringType),
+			InitialPoints:    int32(c.InitialPoints),
+			MinimumPoints:    int32(c.MinimumPoints),
+			Decay:            int32(c.Decay),
+			DynamicFlag:      c.DynamicFlag,
+			FlagMatchMode:    flagMatchModeToPB(c.FlagMatchMode),
+			AcceptedFlags:    c.AcceptedFlags,
+			PrerequisiteIds:  c.Prerequisites,
+			PrerequisiteMode: prerequisiteModeToPB(c.PrerequisiteMode),
+			Visibility:       visibilityToPB(c.Visibility),
+			ReleaseAt:        timeToUnix(c.ReleaseAt),
+			Parts:            flagPartsToPB(c.Parts),
+			BloodBonuses:     bloodBonusesToPB(c.BloodBonuses),
+			AuthorId:         c.AuthorID,
 		})
 	}
 
@@ -176,7 +222,7 @@ func (s *AdminService) ListChallenges(ctx context.Context, req *connect.Request[
 }
 
 func (s *AdminService) GetChallenge(ctx context.Context, req *connect.Request[pb.GetChallengeRequest]) (*connect.Response[pb.GetChallengeResponse], error) {
-	_, err := requireAdminSession(ctx)
+	_, err := requireChallengePermission(ctx, s.authorizer, req.Msg.ChallengeId)
 	if err != nil {
 		return connect.NewResponse(&pb.GetChallengeResponse{
 			ErrorMessage: err.Error(),
@@ -211,12 +257,26 @@ func (s *AdminService) GetChallenge(ctx context.Context, req *connect.Request[pb
 			Genre:            challenge.Genre,
 			Attachments:      pbAttachments,
 			RequiresInstance: challenge.RequiresInstance,
+			ScoringType:      scoringTypeToPB(challenge.ScoringType),
+			InitialPoints:    int32(challenge.InitialPoints),
+			MinimumPoints:    int32(challenge.MinimumPoints),
+			Decay:            int32(challenge.Decay),
+			DynamicFlag:      challenge.DynamicFlag,
+			FlagMatchMode:    flagMatchModeToPB(challenge.FlagMatchMode),
+			AcceptedFlags:    challenge.AcceptedFlags,
+			PrerequisiteIds:  challenge.Prerequisites,
+			PrerequisiteMode: prerequisiteModeToPB(challenge.PrerequisiteMode),
+			Visibility:       visibilityToPB(challenge.Visibility),
+			ReleaseAt:        timeToUnix(challenge.ReleaseAt),
+			Parts:            flagPartsToPB(challenge.Parts),
+			BloodBonuses:     bloodBonusesToPB(challenge.BloodBonuses),
+			AuthorId:         challenge.AuthorID,
 		},
 	}), nil
 }
 
 func (s *AdminService) ListBuildLogs(ctx context.Context, req *connect.Request[pb.ListBuildLogsRequest]) (*connect.Response[pb.ListBuildLogsResponse], error) {
-	_, err := requireAdminSession(ctx)
+	_, err := requireChallengePermission(ctx, s.authorizer, req.Msg.ChallengeId)
 	if err != nil {
 		return connect.NewResponse(&pb.ListBuildLogsResponse{
 			ErrorMessage: err.Error(),
@@ -247,7 +307,7 @@ func (s *AdminService) ListBuildLogs(ctx context.Context, req *connect.Request[p
 }
 
 func (s *AdminService) GetBuildLog(ctx context.Context, req *connect.Request[pb.GetBuildLogRequest]) (*connect.Response[pb.GetBuildLogResponse], error) {
-	_, err := requireAdminSession(ctx)
+	_, err := requireBuildJobPermission(ctx, s.authorizer, s.adminUsecase, req.Msg.JobId)
 	if err != nil {
 		return connect.NewResponse(&pb.GetBuildLogResponse{
 			ErrorMessage: err.Error(),
@@ -269,7 +329,7 @@ func (s *AdminService) GetBuildLog(ctx context.Context, req *connect.Request[pb.
 }
 
 func (s *AdminService) UploadAttachment(ctx context.Context, req *connect.Request[pb.UploadAttachmentRequest]) (*connect.Response[pb.UploadAttachmentResponse], error) {
-	_, err := requireAdminSession(ctx)
+	_, err := requireChallengePermission(ctx, s.authorizer, req.Msg.ChallengeId)
 	if err != nil {
 		return connect.NewResponse(&pb.UploadAttachmentResponse{
 			ErrorMessage: err.Error(),
@@ -299,7 +359,7 @@ func (s *AdminService) UploadAttachment(ctx context.Context, req *connect.Reques
 }
 
 func (s *AdminService) DeleteAttachment(ctx context.Context, req *connect.Request[pb.DeleteAttachmentRequest]) (*connect.Response[pb.DeleteAttachmentResponse], error) {
-	_, err := requireAdminSession(ctx)
+	_, err := requireChallengePermission(ctx, s.authorizer, req.Msg.ChallengeId)
 	if err != nil {
 		return connect.NewResponse(&pb.DeleteAttachmentResponse{
 			ErrorMessage: err.Error(),
@@ -317,7 +377,7 @@ func (s *AdminService) DeleteAttachment(ctx context.Context, req *connect.Reques
 }
 
 func (s *AdminService) StreamBuildLog(ctx context.Context, req *connect.Request[pb.StreamBuildLogRequest], stream *connect.ServerStream[pb.StreamBuildLogResponse]) error {
-	_, err := requireAdminSession(ctx)
+	_, err := requireBuildJobPermission(ctx, s.authorizer, s.adminUsecase, req.Msg.JobId)
 	if err != nil {
 		return err
 	}
@@ -376,3 +436,693 @@ func (s *AdminService) StreamBuildLog(ctx context.Context, req *connect.Request[
 
 	return nil
 }
+
+func (s *AdminService) GetEventConfig(ctx context.Context, req *connect.Request[pb.GetEventConfigRequest]) (*connect.Response[pb.GetEventConfigResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageEvent)
+	if err != nil {
+		return connect.NewResponse(&pb.GetEventConfigResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	config, err := s.adminUsecase.GetEventConfig(ctx)
+	if err != nil {
+		return connect.NewResponse(&pb.GetEventConfigResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.GetEventConfigResponse{
+		EventConfig: &pb.EventConfig{
+			StartAt:  timeToUnix(config.StartAt),
+			EndAt:    timeToUnix(config.EndAt),
+			FreezeAt: timeToUnix(config.FreezeAt),
+		},
+	}), nil
+}
+
+func (s *AdminService) UpdateEventConfig(ctx context.Context, req *connect.Request[pb.UpdateEventConfigRequest]) (*connect.Response[pb.UpdateEventConfigResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageEvent)
+	if err != nil {
+		return connect.NewResponse(&pb.UpdateEventConfigResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	pbConfig := req.Msg.GetEventConfig()
+	config := &domain.EventConfig{
+		StartAt:  unixToTime(pbConfig.GetStartAt()),
+		EndAt:    unixToTime(pbConfig.GetEndAt()),
+		FreezeAt: unixToTime(pbConfig.GetFreezeAt()),
+	}
+
+	if err := s.adminUsecase.UpdateEventConfig(ctx, config); err != nil {
+		return connect.NewResponse(&pb.UpdateEventConfigResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.UpdateEventConfigResponse{}), nil
+}
+
+func (s *AdminService) GetFlagSharingReport(ctx context.Context, req *connect.Request[pb.GetFlagSharingReportRequest]) (*connect.Response[pb.GetFlagSharingReportResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionViewSubmissions)
+	if err != nil {
+		return connect.NewResponse(&pb.GetFlagSharingReportResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	window := time.Duration(req.Msg.WindowSeconds) * time.Second
+	report, err := s.adminUsecase.GetFlagSharingReport(ctx, window)
+	if err != nil {
+		return connect.NewResponse(&pb.GetFlagSharingReportResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	pbClusters := make([]*pb.FlagSharingCluster, 0, len(report.Clusters))
+	for _, c := range report.Clusters {
+		pbSubmissions := make([]*pb.FlagSharingSubmission, 0, len(c.Submissions))
+		for _, sub := range c.Submissions {
+			pbSubmissions = append(pbSubmissions, &pb.FlagSharingSubmission{
+				SubmissionId:  sub.SubmissionID,
+				UserId:        sub.UserID,
+				Username:      report.Usernames[sub.UserID],
+				TeamId:        sub.TeamID,
+				SubmittedFlag: sub.SubmittedFlag,
+				SubmittedAt:   sub.SubmittedAt.Unix(),
+			})
+		}
+
+		pbClusters = append(pbClusters, &pb.FlagSharingCluster{
+			Reason:           flagSharingReasonToPB(c.Reason),
+			ChallengeId:      c.ChallengeID,
+			ChallengeName:    report.ChallengeNames[c.ChallengeID],
+			SubmittedFlag:    c.SubmittedFlag,
+			FirstSubmittedAt: c.FirstSubmittedAt().Unix(),
+			LastSubmittedAt:  c.LastSubmittedAt().Unix(),
+			Submissions:      pbSubmissions,
+		})
+	}
+
+	return connect.NewResponse(&pb.GetFlagSharingReportResponse{
+		Clusters: pbClusters,
+	}), nil
+}
+
+func (s *AdminService) ListSubmissions(ctx context.Context, req *connect.Request[pb.ListSubmissionsRequest]) (*connect.Response[pb.ListSubmissionsResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionViewSubmissions)
+	if err != nil {
+		return connect.NewResponse(&pb.ListSubmissionsResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	filter := &domain.SubmissionFilter{
+		UserID:      req.Msg.UserId,
+		ChallengeID: req.Msg.ChallengeId,
+		Since:       unixToTime(req.Msg.Since),
+		Until:       unixToTime(req.Msg.Until),
+		Limit:       int(req.Msg.PageSize),
+	}
+	switch req.Msg.Result {
+	case pb.ListSubmissionsRequest_RESULT_CORRECT:
+		filter.Result = domain.SubmissionResultCorrect
+	case pb.ListSubmissionsRequest_RESULT_INCORRECT:
+		filter.Result = domain.SubmissionResultIncorrect
+	}
+	switch req.Msg.Order {
+	case pb.ListSubmissionsRequest_ORDER_OLDEST_FIRST:
+		filter.Order = domain.SubmissionOrderOldestFirst
+	default:
+		filter.Order = domain.SubmissionOrderNewestFirst
+	}
+
+	page, err := s.adminUsecase.ListSubmissions(ctx, filter, req.Msg.Cursor)
+	if err != nil {
+		return connect.NewResponse(&pb.ListSubmissionsResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	pbSubmissions := make([]*pb.AdminSubmission, 0, len(page.Submissions))
+	for _, sub := range page.Submissions {
+		pbSubmissions = append(pbSubmissions, adminSubmissionToPB(sub, page.Usernames[sub.UserID], page.ChallengeNames[sub.ChallengeID]))
+	}
+
+	return connect.NewResponse(&pb.ListSubmissionsResponse{
+		Submissions: pbSubmissions,
+		NextCursor:  page.NextCursor,
+	}), nil
+}
+
+func adminSubmissionToPB(sub *domain.Submission, username, challengeName string) *pb.AdminSubmission {
+	return &pb.AdminSubmission{
+		SubmissionId:  sub.SubmissionID,
+		UserId:        sub.UserID,
+		Username:      username,
+		TeamId:        sub.TeamID,
+		ChallengeId:   sub.ChallengeID,
+		ChallengeName: challengeName,
+		SubmittedFlag: sub.SubmittedFlag,
+		Correct:       sub.IsCorrect,
+		PartId:        sub.PartID,
+		SolveRank:     int32(sub.SolveRank),
+		SubmittedAt:   sub.SubmittedAt.Unix(),
+		InvalidatedAt: timeToUnix(sub.InvalidatedAt),
+	}
+}
+
+func (s *AdminService) InvalidateSubmission(ctx context.Context, req *connect.Request[pb.InvalidateSubmissionRequest]) (*connect.Response[pb.InvalidateSubmissionResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageSubmissions)
+	if err != nil {
+		return connect.NewResponse(&pb.InvalidateSubmissionResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	if err := s.adminUsecase.InvalidateSubmission(ctx, req.Msg.SubmissionId); err != nil {
+		return connect.NewResponse(&pb.InvalidateSubmissionResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.InvalidateSubmissionResponse{}), nil
+}
+
+func flagSharingReasonToPB(reason domain.FlagSharingReason) pb.FlagSharingReason {
+	switch reason {
+	case domain.FlagSharingReasonSameWrongAnswer:
+		return pb.FlagSharingReason_FLAG_SHARING_REASON_SAME_WRONG_ANSWER
+	case domain.FlagSharingReasonCloseSolves:
+		return pb.FlagSharingReason_FLAG_SHARING_REASON_CLOSE_SOLVES
+	default:
+		return pb.FlagSharingReason_FLAG_SHARING_REASON_UNSPECIFIED
+	}
+}
+
+func (s *AdminService) CreateHint(ctx context.Context, req *connect.Request[pb.CreateHintRequest]) (*connect.Response[pb.CreateHintResponse], error) {
+	_, err := requireChallengePermission(ctx, s.authorizer, req.Msg.ChallengeId)
+	if err != nil {
+		return connect.NewResponse(&pb.CreateHintResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	hintID, err := s.adminUsecase.CreateHint(ctx, req.Msg.ChallengeId, req.Msg.Content, int(req.Msg.Cost))
+	if err != nil {
+		return connect.NewResponse(&pb.CreateHintResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.CreateHintResponse{
+		HintId: hintID,
+	}), nil
+}
+
+func (s *AdminService) UpdateHint(ctx context.Context, req *connect.Request[pb.UpdateHintRequest]) (*connect.Response[pb.UpdateHintResponse], error) {
+	_, err := requireHintPermission(ctx, s.authorizer, req.Msg.GetHint().GetHintId())
+	if err != nil {
+		return connect.NewResponse(&pb.UpdateHintResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	pbHint := req.Msg.GetHint()
+	hint := &domain.Hint{
+		HintID:   pbHint.GetHintId(),
+		Content:  pbHint.GetContent(),
+		Cost:     int(pbHint.GetCost()),
+		Position: int(pbHint.GetPosition()),
+	}
+
+	if err := s.adminUsecase.UpdateHint(ctx, hint); err != nil {
+		return connect.NewResponse(&pb.UpdateHintResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.UpdateHintResponse{}), nil
+}
+
+func (s *AdminService) DeleteHint(ctx context.Context, req *connect.Request[pb.DeleteHintRequest]) (*connect.Response[pb.DeleteHintResponse], error) {
+	_, err := requireHintPermission(ctx, s.authorizer, req.Msg.HintId)
+	if err != nil {
+		return connect.NewResponse(&pb.DeleteHintResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	if err := s.adminUsecase.DeleteHint(ctx, req.Msg.HintId); err != nil {
+		return connect.NewResponse(&pb.DeleteHintResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.DeleteHintResponse{}), nil
+}
+
+func (s *AdminService) ListHints(ctx context.Context, req *connect.Request[pb.ListHintsRequest]) (*connect.Response[pb.ListHintsResponse], error) {
+	_, err := requireChallengePermission(ctx, s.authorizer, req.Msg.ChallengeId)
+	if err != nil {
+		return connect.NewResponse(&pb.ListHintsResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	hints, err := s.adminUsecase.ListHints(ctx, req.Msg.ChallengeId)
+	if err != nil {
+		return connect.NewResponse(&pb.ListHintsResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	pbHints := make([]*pb.Hint, 0, len(hints))
+	for _, h := range hints {
+		pbHints = append(pbHints, hintToPB(h, false))
+	}
+
+	return connect.NewResponse(&pb.ListHintsResponse{
+		Hints: pbHints,
+	}), nil
+}
+
+func (s *AdminService) CreateAnnouncement(ctx context.Context, req *connect.Request[pb.CreateAnnouncementRequest]) (*connect.Response[pb.CreateAnnouncementResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageEvent)
+	if err != nil {
+		return connect.NewResponse(&pb.CreateAnnouncementResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	announcementID, err := s.adminUsecase.CreateAnnouncement(ctx, req.Msg.ChallengeId, req.Msg.Title, req.Msg.Content)
+	if err != nil {
+		return connect.NewResponse(&pb.CreateAnnouncementResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.CreateAnnouncementResponse{
+		AnnouncementId: announcementID,
+	}), nil
+}
+
+func (s *AdminService) UpdateAnnouncement(ctx context.Context, req *connect.Request[pb.UpdateAnnouncementRequest]) (*connect.Response[pb.UpdateAnnouncementResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageEvent)
+	if err != nil {
+		return connect.NewResponse(&pb.UpdateAnnouncementResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	pbAnnouncement := req.Msg.GetAnnouncement()
+	announcement := &domain.Announcement{
+		AnnouncementID: pbAnnouncement.GetAnnouncementId(),
+		ChallengeID:    pbAnnouncement.GetChallengeId(),
+		Title:          pbAnnouncement.GetTitle(),
+		Content:        pbAnnouncement.GetContent(),
+	}
+
+	if err := s.adminUsecase.UpdateAnnouncement(ctx, announcement); err != nil {
+		return connect.NewResponse(&pb.UpdateAnnouncementResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.UpdateAnnouncementResponse{}), nil
+}
+
+func (s *AdminService) DeleteAnnouncement(ctx context.Context, req *connect.Request[pb.DeleteAnnouncementRequest]) (*connect.Response[pb.DeleteAnnouncementResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageEvent)
+	if err != nil {
+		return connect.NewResponse(&pb.DeleteAnnouncementResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	if err := s.adminUsecase.DeleteAnnouncement(ctx, req.Msg.AnnouncementId); err != nil {
+		return connect.NewResponse(&pb.DeleteAnnouncementResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.DeleteAnnouncementResponse{}), nil
+}
+
+func (s *AdminService) ListAnnouncements(ctx context.Context, req *connect.Request[pb.ListAnnouncementsRequest]) (*connect.Response[pb.ListAnnouncementsResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageEvent)
+	if err != nil {
+		return connect.NewResponse(&pb.ListAnnouncementsResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	announcements, err := s.adminUsecase.ListAnnouncements(ctx)
+	if err != nil {
+		return connect.NewResponse(&pb.ListAnnouncementsResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.ListAnnouncementsResponse{
+		Announcements: announcementsToPB(announcements),
+	}), nil
+}
+
+func (s *AdminService) ListAdmins(ctx context.Context, req *connect.Request[pb.ListAdminsRequest]) (*connect.Response[pb.ListAdminsResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionViewUsers)
+	if err != nil {
+		return connect.NewResponse(&pb.ListAdminsResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	admins, err := s.adminUsecase.ListAdmins(ctx)
+	if err != nil {
+		return connect.NewResponse(&pb.ListAdminsResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	pbAdmins := make([]*pb.AdminUser, 0, len(admins))
+	for _, u := range admins {
+		pbAdmins = append(pbAdmins, adminUserToPB(u))
+	}
+
+	return connect.NewResponse(&pb.ListAdminsResponse{
+		Admins: pbAdmins,
+	}), nil
+}
+
+func (s *AdminService) GrantAdmin(ctx context.Context, req *connect.Request[pb.GrantAdminRequest]) (*connect.Response[pb.GrantAdminResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageStaff)
+	if err != nil {
+		return connect.NewResponse(&pb.GrantAdminResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	user, err := s.adminUsecase.GrantAdmin(ctx, req.Msg.Username, staffRoleFromPB(req.Msg.Role))
+	if err != nil {
+		return connect.NewResponse(&pb.GrantAdminResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.GrantAdminResponse{
+		Admin: adminUserToPB(user),
+	}), nil
+}
+
+func (s *AdminService) RevokeAdmin(ctx context.Context, req *connect.Request[pb.RevokeAdminRequest]) (*connect.Response[pb.RevokeAdminResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageStaff)
+	if err != nil {
+		return connect.NewResponse(&pb.RevokeAdminResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	if err := s.adminUsecase.RevokeAdmin(ctx, req.Msg.UserId); err != nil {
+		return connect.NewResponse(&pb.RevokeAdminResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.RevokeAdminResponse{}), nil
+}
+
+func (s *AdminService) ListUsers(ctx context.Context, req *connect.Request[pb.ListUsersRequest]) (*connect.Response[pb.ListUsersResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionViewUsers)
+	if err != nil {
+		return connect.NewResponse(&pb.ListUsersResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	filter := &domain.UserFilter{
+		Query: req.Msg.Query,
+		Limit: int(req.Msg.PageSize),
+	}
+
+	page, err := s.adminUsecase.ListUsers(ctx, filter, req.Msg.Cursor)
+	if err != nil {
+		return connect.NewResponse(&pb.ListUsersResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	pbUsers := make([]*pb.AdminUser, 0, len(page.Users))
+	for _, u := range page.Users {
+		pbUsers = append(pbUsers, adminUserToPB(u))
+	}
+
+	return connect.NewResponse(&pb.ListUsersResponse{
+		Users:      pbUsers,
+		NextCursor: page.NextCursor,
+	}), nil
+}
+
+func (s *AdminService) GetUser(ctx context.Context, req *connect.Request[pb.GetUserRequest]) (*connect.Response[pb.GetUserResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionViewUsers)
+	if err != nil {
+		return connect.NewResponse(&pb.GetUserResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	detail, err := s.adminUsecase.GetUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return connect.NewResponse(&pb.GetUserResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	pbSolves := make([]*pb.AdminSubmission, 0, len(detail.Solves))
+	for _, sub := range detail.Solves {
+		pbSolves = append(pbSolves, adminSubmissionToPB(sub, detail.User.Username, detail.ChallengeNames[sub.ChallengeID]))
+	}
+
+	pbInstances := make([]*pb.AdminInstance, 0, len(detail.Instances))
+	for _, i := range detail.Instances {
+		pbInstances = append(pbInstances, &pb.AdminInstance{
+			InstanceId:    i.InstanceID,
+			ChallengeId:   i.ChallengeID,
+			ChallengeName: detail.ChallengeNames[i.ChallengeID],
+			Status:        string(i.Status),
+			Host:          i.Host,
+			Port:          i.Port,
+			StartedAt:     i.StartedAt.Unix(),
+			ExpiresAt:     i.ExpiresAt.Unix(),
+		})
+	}
+
+	return connect.NewResponse(&pb.GetUserResponse{
+		User:      adminUserToPB(detail.User),
+		Solves:    pbSolves,
+		Instances: pbInstances,
+	}), nil
+}
+
+func (s *AdminService) BanUser(ctx context.Context, req *connect.Request[pb.BanUserRequest]) (*connect.Response[pb.BanUserResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageUsers)
+	if err != nil {
+		return connect.NewResponse(&pb.BanUserResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	if err := s.adminUsecase.BanUser(ctx, req.Msg.UserId); err != nil {
+		return connect.NewResponse(&pb.BanUserResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.BanUserResponse{}), nil
+}
+
+func (s *AdminService) UnbanUser(ctx context.Context, req *connect.Request[pb.UnbanUserRequest]) (*connect.Response[pb.UnbanUserResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageUsers)
+	if err != nil {
+		return connect.NewResponse(&pb.UnbanUserResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	if err := s.adminUsecase.UnbanUser(ctx, req.Msg.UserId); err != nil {
+		return connect.NewResponse(&pb.UnbanUserResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.UnbanUserResponse{}), nil
+}
+
+func (s *AdminService) ResetUserPassword(ctx context.Context, req *connect.Request[pb.ResetUserPasswordRequest]) (*connect.Response[pb.ResetUserPasswordResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageUsers)
+	if err != nil {
+		return connect.NewResponse(&pb.ResetUserPasswordResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	password, err := s.adminUsecase.ResetUserPassword(ctx, req.Msg.UserId)
+	if err != nil {
+		return connect.NewResponse(&pb.ResetUserPasswordResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.ResetUserPasswordResponse{
+		TemporaryPassword: password,
+	}), nil
+}
+
+func (s *AdminService) DeleteUser(ctx context.Context, req *connect.Request[pb.DeleteUserRequest]) (*connect.Response[pb.DeleteUserResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionManageUsers)
+	if err != nil {
+		return connect.NewResponse(&pb.DeleteUserResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	if err := s.adminUsecase.DeleteUser(ctx, req.Msg.UserId); err != nil {
+		return connect.NewResponse(&pb.DeleteUserResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.DeleteUserResponse{}), nil
+}
+
+func adminUserToPB(u *domain.User) *pb.AdminUser {
+	return &pb.AdminUser{
+		UserId:    u.UserID,
+		Username:  u.Username,
+		Role:      staffRoleToPB(u.Role),
+		CreatedAt: u.CreatedAt.Unix(),
+		BannedAt:  timeToUnix(u.BannedAt),
+	}
+}
+
+func staffRoleToPB(role domain.Role) pb.StaffRole {
+	switch role {
+	case domain.RoleSuperadmin:
+		return pb.StaffRole_STAFF_ROLE_SUPERADMIN
+	case domain.RoleAuthor:
+		return pb.StaffRole_STAFF_ROLE_AUTHOR
+	case domain.RoleSupport:
+		return pb.StaffRole_STAFF_ROLE_SUPPORT
+	default:
+		return pb.StaffRole_STAFF_ROLE_UNSPECIFIED
+	}
+}
+
+// staffRoleFromPB は未指定の場合に RoleNone を返す
+func staffRoleFromPB(role pb.StaffRole) domain.Role {
+	switch role {
+	case pb.StaffRole_STAFF_ROLE_SUPERADMIN:
+		return domain.RoleSuperadmin
+	case pb.StaffRole_STAFF_ROLE_AUTHOR:
+		return domain.RoleAuthor
+	case pb.StaffRole_STAFF_ROLE_SUPPORT:
+		return domain.RoleSupport
+	default:
+		return domain.RoleNone
+	}
+}
+
+func (s *AdminService) ListAuditEvents(ctx context.Context, req *connect.Request[pb.ListAuditEventsRequest]) (*connect.Response[pb.ListAuditEventsResponse], error) {
+	_, err := requirePermission(ctx, s.authorizer, domain.PermissionViewAuditLog)
+	if err != nil {
+		return connect.NewResponse(&pb.ListAuditEventsResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	filter := &domain.AuditEventFilter{
+		ActorID:    req.Msg.ActorId,
+		Action:     req.Msg.Action,
+		TargetType: auditTargetTypeFromPB(req.Msg.TargetType),
+		TargetID:   req.Msg.TargetId,
+		Since:      unixToTime(req.Msg.Since),
+		Until:      unixToTime(req.Msg.Until),
+		Limit:      int(req.Msg.PageSize),
+	}
+
+	page, err := s.auditUsecase.ListAuditEvents(ctx, filter, req.Msg.Cursor)
+	if err != nil {
+		return connect.NewResponse(&pb.ListAuditEventsResponse{
+			ErrorMessage: err.Error(),
+		}), nil
+	}
+
+	pbEvents := make([]*pb.AuditEvent, 0, len(page.Events))
+	for _, e := range page.Events {
+		pbChanges := make([]*pb.AuditChange, 0, len(e.Changes))
+		for _, c := range e.Changes {
+			pbChanges = append(pbChanges, &pb.AuditChange{
+				Field:  c.Field,
+				Before: c.Before,
+				After:  c.After,
+			})
+		}
+		pbEvents = append(pbEvents, &pb.AuditEvent{
+			AuditEventId: e.AuditEventID,
+			ActorId:      e.ActorID,
+			ActorName:    e.ActorName,
+			Action:       e.Action,
+			TargetType:   auditTargetTypeToPB(e.TargetType),
+			TargetId:     e.TargetID,
+			Changes:      pbChanges,
+			ErrorMessage: e.ErrorMessage,
+			ClientIp:     e.ClientIP,
+			OccurredAt:   timeToUnix(e.OccurredAt),
+		})
+	}
+
+	return connect.NewResponse(&pb.ListAuditEventsResponse{
+		Events:     pbEvents,
+		NextCursor: page.NextCursor,
+	}), nil
+}
+
+func auditTargetTypeToPB(t domain.AuditTargetType) pb.AuditTargetType {
+	switch t {
+	case domain.AuditTargetChallenge:
+		return pb.AuditTargetType_AUDIT_TARGET_TYPE_CHALLENGE
+	case domain.AuditTargetHint:
+		return pb.AuditTargetType_AUDIT_TARGET_TYPE_HINT
+	case domain.AuditTargetAnnouncement:
+		return pb.AuditTargetType_AUDIT_TARGET_TYPE_ANNOUNCEMENT
+	case domain.AuditTargetEventConfig:
+		return pb.AuditTargetType_AUDIT_TARGET_TYPE_EVENT_CONFIG
+	case domain.AuditTargetSubmission:
+		return pb.AuditTargetType_AUDIT_TARGET_TYPE_SUBMISSION
+	case domain.AuditTargetUser:
+		return pb.AuditTargetType_AUDIT_TARGET_TYPE_USER
+	default:
+		return pb.AuditTargetType_AUDIT_TARGET_TYPE_UNSPECIFIED
+	}
+}
+
+// auditTargetTypeFromPB は未指定の場合に空文字列を返し、検索条件に含めない
+func auditTargetTypeFromPB(t pb.AuditTargetType) domain.AuditTargetType {
+	switch t {
+	case pb.AuditTargetType_AUDIT_TARGET_TYPE_CHALLENGE:
+		return domain.AuditTargetChallenge
+	case pb.AuditTargetType_AUDIT_TARGET_TYPE_HINT:
+		return domain.AuditTargetHint
+	case pb.AuditTargetType_AUDIT_TARGET_TYPE_ANNOUNCEMENT:
+		return domain.AuditTargetAnnouncement
+	case pb.AuditTargetType_AUDIT_TARGET_TYPE_EVENT_CONFIG:
+		return domain.AuditTargetEventConfig
+	case pb.AuditTargetType_AUDIT_TARGET_TYPE_SUBMISSION:
+		return domain.AuditTargetSubmission
+	case pb.AuditTargetType_AUDIT_TARGET_TYPE_USER:
+		return domain.AuditTargetUser
+	default:
+		return ""
+	}
+}
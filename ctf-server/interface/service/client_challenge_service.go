@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"log"
+	"math"
 
 	"connectrpc.com/connect"
 
@@ -14,21 +16,31 @@ import (
 
 type ClientChallengeService struct {
 	serverv1connect.UnimplementedClientChallengeServiceHandler
-	usecase *usecase.ClientChallengeUsecase
+	usecase    *usecase.ClientChallengeUsecase
+	authorizer *usecase.Authorizer
 }
 
-func NewClientChallengeService(usecase *usecase.ClientChallengeUsecase) *ClientChallengeService {
+func NewClientChallengeService(usecase *usecase.ClientChallengeUsecase, authorizer *usecase.Authorizer) *ClientChallengeService {
 	return &ClientChallengeService{
-		usecase: usecase,
+		usecase:    usecase,
+		authorizer: authorizer,
 	}
 }
 
 func (s *ClientChallengeService) GetChallenges(ctx context.Context, req *connect.Request[pb.GetChallengesRequest]) (*connect.Response[pb.GetChallengesResponse], error) {
-	challenges, err := s.usecase.GetChallenges(ctx)
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		log.Printf("Failed to get user ID from context: %v", err)
+		return connect.NewResponse(&pb.GetChallengesResponse{
+			ErrorMessage: "authentication required",
+		}), nil
+	}
+
+	challenges, err := s.usecase.GetChallenges(ctx, userID)
 	if err != nil {
 		log.Printf("Failed to get challenges: %v", err)
 		return connect.NewResponse(&pb.GetChallengesResponse{
-			ErrorMessage: "failed to get challenges",
+			ErrorMessage: clientErrorMessage(err, "failed to get challenges"),
 		}), nil
 	}
 
@@ -49,7 +61,7 @@ func (s *ClientChallengeService) GetChallenges(ctx context.Context, req *connect
 			})
 		}
 
-		pbChallenges = append(pbChallenges, &pb.Challenge{
+		pbChallenge := &pb.Challenge{
 			ChallengeId:      c.ChallengeID,
 			Name:             c.Name,
 			Description:      c.Description,
@@ -58,7 +70,27 @@ func (s *ClientChallengeService) GetChallenges(ctx context.Context, req *connect
 			Genre:            c.Genre,
 			Attachments:      pbAttachments,
 			RequiresInstance: c.RequiresInstance,
-		})
+			ScoringType:      scoringTypeToPB(c.ScoringType),
+			InitialPoints:    int32(c.InitialPoints),
+			MinimumPoints:    int32(c.MinimumPoints),
+			Decay:            int32(c.Decay),
+			DynamicFlag:      c.DynamicFlag,
+			FlagMatchMode:    flagMatchModeToPB(c.FlagMatchMode),
+			AcceptedFlags:    c.AcceptedFlags,
+			PrerequisiteIds:  c.Prerequisites,
+			PrerequisiteMode: prerequisiteModeToPB(c.PrerequisiteMode),
+			Locked:           c.Locked,
+			Visibility:       visibilityToPB(c.Visibility),
+			ReleaseAt:        timeToUnix(c.ReleaseAt),
+			Parts:            flagPartsToPB(c.Parts),
+			BloodBonuses:     bloodBonusesToPB(c.BloodBonuses),
+		}
+		if c.SolveStats != nil {
+			pbChallenge.SolveCount = int32(c.SolveStats.SolveCount)
+			pbChallenge.SolvedByMe = c.SolveStats.SolvedByMe
+			pbChallenge.FirstBlood = firstBloodToPB(c.SolveStats.FirstBlood)
+		}
+		pbChallenges = append(pbChallenges, pbChallenge)
 	}
 
 	return connect.NewResponse(&pb.GetChallengesResponse{
@@ -82,9 +114,17 @@ func (s *ClientChallengeService) SubmitFlag(ctx context.Context, req *connect.Re
 		req.Msg.Submission.SubmittedFlag,
 	)
 	if err != nil {
+		var rateLimited *domain.RateLimitedError
+		if errors.As(err, &rateLimited) {
+			return connect.NewResponse(&pb.SubmitFlagResponse{
+				ErrorMessage:      "too many submissions, please wait",
+				RetryAfterSeconds: int32(math.Ceil(rateLimited.RetryAfter.Seconds())),
+			}), nil
+		}
+
 		log.Printf("Failed to submit flag: %v", err)
 		return connect.NewResponse(&pb.SubmitFlagResponse{
-			ErrorMessage: "failed to submit flag",
+			ErrorMessage: clientErrorMessage(err, "failed to submit flag"),
 		}), nil
 	}
 
@@ -94,6 +134,150 @@ func (s *ClientChallengeService) SubmitFlag(ctx context.Context, req *connect.Re
 	}), nil
 }
 
+func (s *ClientChallengeService) GetScoreboard(ctx context.Context, req *connect.Request[pb.GetScoreboardRequest]) (*connect.Response[pb.GetScoreboardResponse], error) {
+	// 権限を持つ運営には凍結中でも最新の順位を返す
+	live := canViewLiveScoreboard(ctx, s.authorizer)
+
+	entries, frozen, err := s.usecase.GetScoreboard(ctx, live)
+	if err != nil {
+		log.Printf("Failed to get scoreboard: %v", err)
+		return connect.NewResponse(&pb.GetScoreboardResponse{
+			ErrorMessage: "failed to get scoreboard",
+		}), nil
+	}
+
+	pbEntries := make([]*pb.ScoreboardEntry, 0, len(entries))
+	for _, e := range entries {
+		pbEntries = append(pbEntries, scoreboardEntryToPB(e))
+	}
+
+	return connect.NewResponse(&pb.GetScoreboardResponse{
+		Entries: pbEntries,
+		Frozen:  frozen,
+	}), nil
+}
+
+func (s *ClientChallengeService) GetScoreHistory(ctx context.Context, req *connect.Request[pb.GetScoreHistoryRequest]) (*connect.Response[pb.GetScoreHistoryResponse], error) {
+	// スコアボードと同じく、権限を持つ運営には凍結中でも最新の推移を返す
+	live := canViewLiveScoreboard(ctx, s.authorizer)
+
+	histories, frozen, err := s.usecase.GetScoreHistory(ctx, int(req.Msg.Top), live)
+	if err != nil {
+		log.Printf("Failed to get score history: %v", err)
+		return connect.NewResponse(&pb.GetScoreHistoryResponse{
+			ErrorMessage: "failed to get score history",
+		}), nil
+	}
+
+	pbHistories := make([]*pb.ScoreHistory, 0, len(histories))
+	for _, h := range histories {
+		pbPoints := make([]*pb.ScorePoint, 0, len(h.Points))
+		for _, p := range h.Points {
+			pbPoints = append(pbPoints, &pb.ScorePoint{
+				At:    p.At.Unix(),
+				Score: int32(p.Score),
+			})
+		}
+		pbHistories = append(pbHistories, &pb.ScoreHistory{
+			Entry:  scoreboardEntryToPB(h.Entry),
+			Points: pbPoints,
+		})
+	}
+
+	return connect.NewResponse(&pb.GetScoreHistoryResponse{
+		Histories: pbHistories,
+		Frozen:    frozen,
+	}), nil
+}
+
+func (s *ClientChallengeService) GetHints(ctx context.Context, req *connect.Request[pb.GetHintsRequest]) (*connect.Response[pb.GetHintsResponse], error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		log.Printf("Failed to get user ID from context: %v", err)
+		return connect.NewResponse(&pb.GetHintsResponse{
+			ErrorMessage: "authentication required",
+		}), nil
+	}
+
+	hints, unlocked, err := s.usecase.GetHints(ctx, userID, req.Msg.ChallengeId)
+	if err != nil {
+		log.Printf("Failed to get hints: %v", err)
+		return connect.NewResponse(&pb.GetHintsResponse{
+			ErrorMessage: clientErrorMessage(err, "failed to get hints"),
+		}), nil
+	}
+
+	pbHints := make([]*pb.Hint, 0, len(hints))
+	for _, h := range hints {
+		pbHints = append(pbHints, hintToPB(h, unlocked[h.HintID]))
+	}
+
+	return connect.NewResponse(&pb.GetHintsResponse{
+		Hints: pbHints,
+	}), nil
+}
+
+func (s *ClientChallengeService) UnlockHint(ctx context.Context, req *connect.Request[pb.UnlockHintRequest]) (*connect.Response[pb.UnlockHintResponse], error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		log.Printf("Failed to get user ID from context: %v", err)
+		return connect.NewResponse(&pb.UnlockHintResponse{
+			ErrorMessage: "authentication required",
+		}), nil
+	}
+
+	hint, err := s.usecase.UnlockHint(ctx, userID, req.Msg.HintId)
+	if err != nil {
+		log.Printf("Failed to unlock hint: %v", err)
+		return connect.NewResponse(&pb.UnlockHintResponse{
+			ErrorMessage: clientErrorMessage(err, "failed to unlock hint"),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.UnlockHintResponse{
+		Hint: hintToPB(hint, true),
+	}), nil
+}
+
+func (s *ClientChallengeService) GetAnnouncements(ctx context.Context, req *connect.Request[pb.GetAnnouncementsRequest]) (*connect.Response[pb.GetAnnouncementsResponse], error) {
+	if _, err := getUserIDFromContext(ctx); err != nil {
+		log.Printf("Failed to get user ID from context: %v", err)
+		return connect.NewResponse(&pb.GetAnnouncementsResponse{
+			ErrorMessage: "authentication required",
+		}), nil
+	}
+
+	announcements, err := s.usecase.GetAnnouncements(ctx)
+	if err != nil {
+		log.Printf("Failed to get announcements: %v", err)
+		return connect.NewResponse(&pb.GetAnnouncementsResponse{
+			ErrorMessage: "failed to get announcements",
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.GetAnnouncementsResponse{
+		Announcements: announcementsToPB(announcements),
+	}), nil
+}
+
+func (s *ClientChallengeService) StreamEvents(ctx context.Context, req *connect.Request[pb.StreamEventsRequest], stream *connect.ServerStream[pb.StreamEventsResponse]) error {
+	if _, err := getUserIDFromContext(ctx); err != nil {
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	err := s.usecase.SubscribeEvents(ctx, func(event *domain.LiveEvent) {
+		if err := stream.Send(&pb.StreamEventsResponse{Event: liveEventToPB(event)}); err != nil {
+			log.Printf("Failed to send live event: %v", err)
+		}
+	})
+
+	if err != nil && err != context.Canceled {
+		return err
+	}
+
+	return nil
+}
+
 func (s *ClientChallengeService) StartInstance(ctx context.Context, req *connect.Request[pb.StartInstanceRequest]) (*connect.Response[pb.StartInstanceResponse], error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
@@ -107,7 +291,7 @@ func (s *ClientChallengeService) StartInstance(ctx context.Context, req *connect
 	if err != nil {
 		log.Printf("Failed to start instance: %v", err)
 		return connect.NewResponse(&pb.StartInstanceResponse{
-			ErrorMessage: "failed to start instance",
+			ErrorMessage: clientErrorMessage(err, "failed to start instance"),
 		}), nil
 	}
 
@@ -172,3 +356,23 @@ func (s *ClientChallengeService) GetInstanceStatus(ctx context.Context, req *con
 		Port:   port,
 	}), nil
 }
+
+// clientErrorMessage はプレイヤーに見せてよいエラーの場合はその内容を、それ以外は fallback を返す
+func clientErrorMessage(err error, fallback string) string {
+	switch err {
+	case domain.ErrNotInTeam:
+		return "you must join a team to submit flags"
+	case domain.ErrEventNotStarted:
+		return "the event has not started yet"
+	case domain.ErrEventEnded:
+		return "the event has ended"
+	case domain.ErrChallengeLocked:
+		return "solve the prerequisite challenges first"
+	case domain.ErrHintNotFound:
+		return "hint not found"
+	case domain.ErrPreviousHintLocked:
+		return "unlock the previous hints first"
+	default:
+		return fallback
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+	"github.com/kavos113/quickctf/ctf-server/usecase"
+)
+
+// CTFtimeFeedHandler はスコアボードを CTFtime のスコアボードフィード形式の JSON で返す
+type CTFtimeFeedHandler struct {
+	usecase    *usecase.ClientChallengeUsecase
+	authorizer *usecase.Authorizer
+	public     bool // trueの場合は権限のない利用者にも凍結中の順位を返す
+}
+
+func NewCTFtimeFeedHandler(usecase *usecase.ClientChallengeUsecase, authorizer *usecase.Authorizer, public bool) *CTFtimeFeedHandler {
+	return &CTFtimeFeedHandler{
+		usecase:    usecase,
+		authorizer: authorizer,
+		public:     public,
+	}
+}
+
+type ctftimeFeed struct {
+	Standings []ctftimeStanding `json:"standings"`
+}
+
+type ctftimeStanding struct {
+	Pos   int    `json:"pos"`
+	Team  string `json:"team"`
+	Score int    `json:"score"`
+}
+
+func (h *CTFtimeFeedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	// 権限を持つ運営には凍結中でも最新の順位を返す
+	live := canViewLiveScoreboard(r.Context(), h.authorizer)
+	if !live && !h.public {
+		http.Error(w, "admin permission required", http.StatusForbidden)
+		return
+	}
+
+	entries, _, err := h.usecase.GetScoreboard(r.Context(), live)
+	if err != nil {
+		log.Printf("Failed to get scoreboard for CTFtime feed: %v", err)
+		http.Error(w, "failed to get scoreboard", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ctftimeFeedFromScoreboard(entries)); err != nil {
+		log.Printf("Failed to write CTFtime feed: %v", err)
+	}
+}
+
+// ctftimeFeedFromScoreboard は順位付け済みのスコアボードをフィードに変換する
+// チームのスコアボードではチーム名、個人のスコアボードではユーザー名を team とする
+func ctftimeFeedFromScoreboard(entries []*domain.ScoreboardEntry) ctftimeFeed {
+	feed := ctftimeFeed{Standings: make([]ctftimeStanding, 0, len(entries))}
+	for _, e := range entries {
+		team := e.Username
+		if e.TeamID != "" {
+			team = e.TeamName
+		}
+		feed.Standings = append(feed.Standings, ctftimeStanding{
+			Pos:   e.Rank,
+			Team:  team,
+			Score: e.Score,
+		})
+	}
+	return feed
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+func TestCTFtimeFeedFromScoreboard(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []*domain.ScoreboardEntry
+		want    string
+	}{
+		{
+			name:    "empty",
+			entries: nil,
+			want:    `{"standings":[]}`,
+		},
+		{
+			name: "users",
+			entries: []*domain.ScoreboardEntry{
+				{Rank: 1, UserID: "u1", Username: "alice", Score: 300},
+				{Rank: 2, UserID: "u2", Username: "bob", Score: 100},
+			},
+			want: `{"standings":[{"pos":1,"team":"alice","score":300},{"pos":2,"team":"bob","score":100}]}`,
+		},
+		{
+			name: "teams",
+			entries: []*domain.ScoreboardEntry{
+				{Rank: 1, TeamID: "t1", TeamName: "red", Score: 500},
+			},
+			want: `{"standings":[{"pos":1,"team":"red","score":500}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(ctftimeFeedFromScoreboard(tt.entries))
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("ctftimeFeedFromScoreboard() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
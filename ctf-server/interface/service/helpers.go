@@ -3,9 +3,12 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/kavos113/quickctf/ctf-server/domain"
 	"github.com/kavos113/quickctf/ctf-server/interface/middleware"
+	"github.com/kavos113/quickctf/ctf-server/usecase"
+	pb "github.com/kavos113/quickctf/gen/go/api/server/v1"
 )
 
 func getSessionFromContext(ctx context.Context) (*domain.Session, error) {
@@ -24,15 +27,290 @@ func getUserIDFromContext(ctx context.Context) (string, error) {
 	return userID, nil
 }
 
-func requireAdminSession(ctx context.Context) (*domain.Session, error) {
+// requirePermission は管理者モードのセッションのユーザーが permission を持つかを確認する
+func requirePermission(ctx context.Context, authorizer *usecase.Authorizer, permission domain.Permission) (*domain.User, error) {
 	session, err := getSessionFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	return authorizer.Authorize(ctx, session, permission)
+}
 
-	if !session.IsAdmin {
-		return nil, errors.New("admin permission required")
+// requireChallengePermission はセッションのユーザーが問題を編集できるかを確認する
+func requireChallengePermission(ctx context.Context, authorizer *usecase.Authorizer, challengeID string) (*domain.User, error) {
+	session, err := getSessionFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
+	return authorizer.AuthorizeChallenge(ctx, session, challengeID)
+}
 
-	return session, nil
+// requireHintPermission はセッションのユーザーがヒントの属する問題を編集できるかを確認する
+func requireHintPermission(ctx context.Context, authorizer *usecase.Authorizer, hintID string) (*domain.User, error) {
+	session, err := getSessionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return authorizer.AuthorizeHint(ctx, session, hintID)
+}
+
+// requireBuildJobPermission はセッションのユーザーがビルドジョブの対象の問題を編集できるかを確認する
+func requireBuildJobPermission(ctx context.Context, authorizer *usecase.Authorizer, adminUsecase *usecase.AdminServiceUsecase, jobID string) (*domain.User, error) {
+	session, err := getSessionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	challengeID, err := adminUsecase.GetBuildChallengeID(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return authorizer.AuthorizeChallenge(ctx, session, challengeID)
+}
+
+// canViewLiveScoreboard は凍結中でも最新の順位を返してよいかを返す
+func canViewLiveScoreboard(ctx context.Context, authorizer *usecase.Authorizer) bool {
+	_, err := requirePermission(ctx, authorizer, domain.PermissionViewLiveScoreboard)
+	return err == nil
+}
+
+func scoringTypeToPB(scoringType domain.ScoringType) pb.ScoringType {
+	switch scoringType {
+	case domain.ScoringTypeStatic:
+		return pb.ScoringType_SCORING_TYPE_STATIC
+	case domain.ScoringTypeDynamic:
+		return pb.ScoringType_SCORING_TYPE_DYNAMIC
+	default:
+		return pb.ScoringType_SCORING_TYPE_UNSPECIFIED
+	}
+}
+
+func scoringTypeFromPB(scoringType pb.ScoringType) domain.ScoringType {
+	switch scoringType {
+	case pb.ScoringType_SCORING_TYPE_DYNAMIC:
+		return domain.ScoringTypeDynamic
+	default:
+		return domain.ScoringTypeStatic
+	}
+}
+
+// timeToUnix はゼロ値の時刻を 0 に変換する
+func timeToUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// unixToTime は 0 をゼロ値の時刻に変換する
+func unixToTime(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+func flagMatchModeToPB(mode domain.FlagMatchMode) pb.FlagMatchMode {
+	switch mode {
+	case domain.FlagMatchModeExact:
+		return pb.FlagMatchMode_FLAG_MATCH_MODE_EXACT
+	case domain.FlagMatchModeCaseInsensitive:
+		return pb.FlagMatchMode_FLAG_MATCH_MODE_CASE_INSENSITIVE
+	case domain.FlagMatchModeRegex:
+		return pb.FlagMatchMode_FLAG_MATCH_MODE_REGEX
+	default:
+		return pb.FlagMatchMode_FLAG_MATCH_MODE_UNSPECIFIED
+	}
+}
+
+func flagMatchModeFromPB(mode pb.FlagMatchMode) domain.FlagMatchMode {
+	switch mode {
+	case pb.FlagMatchMode_FLAG_MATCH_MODE_CASE_INSENSITIVE:
+		return domain.FlagMatchModeCaseInsensitive
+	case pb.FlagMatchMode_FLAG_MATCH_MODE_REGEX:
+		return domain.FlagMatchModeRegex
+	default:
+		return domain.FlagMatchModeExact
+	}
+}
+
+func prerequisiteModeToPB(mode domain.PrerequisiteMode) pb.PrerequisiteMode {
+	switch mode {
+	case domain.PrerequisiteModeAll:
+		return pb.PrerequisiteMode_PREREQUISITE_MODE_ALL
+	case domain.PrerequisiteModeAny:
+		return pb.PrerequisiteMode_PREREQUISITE_MODE_ANY
+	default:
+		return pb.PrerequisiteMode_PREREQUISITE_MODE_UNSPECIFIED
+	}
+}
+
+func prerequisiteModeFromPB(mode pb.PrerequisiteMode) domain.PrerequisiteMode {
+	switch mode {
+	case pb.PrerequisiteMode_PREREQUISITE_MODE_ANY:
+		return domain.PrerequisiteModeAny
+	default:
+		return domain.PrerequisiteModeAll
+	}
+}
+
+func visibilityToPB(visibility domain.Visibility) pb.ChallengeVisibility {
+	switch visibility {
+	case domain.VisibilityDraft:
+		return pb.ChallengeVisibility_CHALLENGE_VISIBILITY_DRAFT
+	case domain.VisibilityHidden:
+		return pb.ChallengeVisibility_CHALLENGE_VISIBILITY_HIDDEN
+	case domain.VisibilityVisible:
+		return pb.ChallengeVisibility_CHALLENGE_VISIBILITY_VISIBLE
+	case domain.VisibilityScheduled:
+		return pb.ChallengeVisibility_CHALLENGE_VISIBILITY_SCHEDULED
+	default:
+		return pb.ChallengeVisibility_CHALLENGE_VISIBILITY_UNSPECIFIED
+	}
+}
+
+// visibilityFromPB は未指定の場合に空文字列を返す。デフォルト値はusecaseで決める
+func visibilityFromPB(visibility pb.ChallengeVisibility) domain.Visibility {
+	switch visibility {
+	case pb.ChallengeVisibility_CHALLENGE_VISIBILITY_DRAFT:
+		return domain.VisibilityDraft
+	case pb.ChallengeVisibility_CHALLENGE_VISIBILITY_HIDDEN:
+		return domain.VisibilityHidden
+	case pb.ChallengeVisibility_CHALLENGE_VISIBILITY_VISIBLE:
+		return domain.VisibilityVisible
+	case pb.ChallengeVisibility_CHALLENGE_VISIBILITY_SCHEDULED:
+		return domain.VisibilityScheduled
+	default:
+		return ""
+	}
+}
+
+func flagPartsToPB(parts []*domain.FlagPart) []*pb.FlagPart {
+	pbParts := make([]*pb.FlagPart, 0, len(parts))
+	for _, p := range parts {
+		pbParts = append(pbParts, &pb.FlagPart{
+			PartId: p.PartID,
+			Name:   p.Name,
+			Flag:   p.Flag,
+			Points: int32(p.Points),
+			Solved: p.Solved,
+		})
+	}
+	return pbParts
+}
+
+func flagPartsFromPB(pbParts []*pb.FlagPart) []*domain.FlagPart {
+	parts := make([]*domain.FlagPart, 0, len(pbParts))
+	for _, p := range pbParts {
+		parts = append(parts, &domain.FlagPart{
+			PartID: p.PartId,
+			Name:   p.Name,
+			Flag:   p.Flag,
+			Points: int(p.Points),
+		})
+	}
+	return parts
+}
+
+func scoreboardEntryToPB(e *domain.ScoreboardEntry) *pb.ScoreboardEntry {
+	return &pb.ScoreboardEntry{
+		Rank:        int32(e.Rank),
+		UserId:      e.UserID,
+		Username:    e.Username,
+		TeamId:      e.TeamID,
+		TeamName:    e.TeamName,
+		Score:       int32(e.Score),
+		SolveCount:  int32(e.SolveCount),
+		LastSolveAt: e.LastSolveAt.Unix(),
+	}
+}
+
+func bloodBonusesToPB(bonuses []int) []int32 {
+	if len(bonuses) == 0 {
+		return nil
+	}
+	result := make([]int32, 0, len(bonuses))
+	for _, b := range bonuses {
+		result = append(result, int32(b))
+	}
+	return result
+}
+
+func bloodBonusesFromPB(bonuses []int32) []int {
+	if len(bonuses) == 0 {
+		return nil
+	}
+	result := make([]int, 0, len(bonuses))
+	for _, b := range bonuses {
+		result = append(result, int(b))
+	}
+	return result
+}
+
+func firstBloodToPB(fb *domain.FirstBlood) *pb.FirstBlood {
+	if fb == nil {
+		return nil
+	}
+	return &pb.FirstBlood{
+		UserId:   fb.UserID,
+		Username: fb.Username,
+		TeamId:   fb.TeamID,
+		TeamName: fb.TeamName,
+		SolvedAt: timeToUnix(fb.SolvedAt),
+	}
+}
+
+func hintToPB(h *domain.Hint, unlocked bool) *pb.Hint {
+	return &pb.Hint{
+		HintId:      h.HintID,
+		ChallengeId: h.ChallengeID,
+		Content:     h.Content,
+		Cost:        int32(h.Cost),
+		Position:    int32(h.Position),
+		Unlocked:    unlocked,
+	}
+}
+
+func liveEventTypeToPB(t domain.LiveEventType) pb.LiveEventType {
+	switch t {
+	case domain.LiveEventSolve:
+		return pb.LiveEventType_LIVE_EVENT_TYPE_SOLVE
+	case domain.LiveEventFirstBlood:
+		return pb.LiveEventType_LIVE_EVENT_TYPE_FIRST_BLOOD
+	case domain.LiveEventNewChallenge:
+		return pb.LiveEventType_LIVE_EVENT_TYPE_NEW_CHALLENGE
+	case domain.LiveEventAnnouncement:
+		return pb.LiveEventType_LIVE_EVENT_TYPE_ANNOUNCEMENT
+	default:
+		return pb.LiveEventType_LIVE_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func liveEventToPB(e *domain.LiveEvent) *pb.LiveEvent {
+	return &pb.LiveEvent{
+		Type:          liveEventTypeToPB(e.Type),
+		ChallengeId:   e.ChallengeID,
+		ChallengeName: e.ChallengeName,
+		UserId:        e.UserID,
+		Username:      e.Username,
+		TeamId:        e.TeamID,
+		TeamName:      e.TeamName,
+		Message:       e.Message,
+		OccurredAt:    timeToUnix(e.OccurredAt),
+	}
+}
+
+func announcementsToPB(announcements []*domain.Announcement) []*pb.Announcement {
+	pbAnnouncements := make([]*pb.Announcement, 0, len(announcements))
+	for _, a := range announcements {
+		pbAnnouncements = append(pbAnnouncements, &pb.Announcement{
+			AnnouncementId: a.AnnouncementID,
+			ChallengeId:    a.ChallengeID,
+			Title:          a.Title,
+			Content:        a.Content,
+			CreatedAt:      timeToUnix(a.CreatedAt),
+			UpdatedAt:      timeToUnix(a.UpdatedAt),
+		})
+	}
+	return pbAnnouncements
 }
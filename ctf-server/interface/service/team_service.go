@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"connectrpc.com/connect"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+	"github.com/kavos113/quickctf/ctf-server/usecase"
+	pb "github.com/kavos113/quickctf/gen/go/api/server/v1"
+	"github.com/kavos113/quickctf/gen/go/api/server/v1/serverv1connect"
+)
+
+type TeamService struct {
+	serverv1connect.UnimplementedTeamServiceHandler
+	usecase *usecase.TeamUsecase
+}
+
+func NewTeamService(usecase *usecase.TeamUsecase) *TeamService {
+	return &TeamService{
+		usecase: usecase,
+	}
+}
+
+func (s *TeamService) CreateTeam(ctx context.Context, req *connect.Request[pb.CreateTeamRequest]) (*connect.Response[pb.CreateTeamResponse], error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		log.Printf("Failed to get user ID from context: %v", err)
+		return connect.NewResponse(&pb.CreateTeamResponse{
+			ErrorMessage: "authentication required",
+		}), nil
+	}
+
+	team, err := s.usecase.CreateTeam(ctx, userID, req.Msg.Name)
+	if err != nil {
+		log.Printf("Failed to create team: %v", err)
+		return connect.NewResponse(&pb.CreateTeamResponse{
+			ErrorMessage: teamErrorMessage(err, "failed to create team"),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.CreateTeamResponse{
+		Team: teamToPB(team),
+	}), nil
+}
+
+func (s *TeamService) JoinTeam(ctx context.Context, req *connect.Request[pb.JoinTeamRequest]) (*connect.Response[pb.JoinTeamResponse], error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		log.Printf("Failed to get user ID from context: %v", err)
+		return connect.NewResponse(&pb.JoinTeamResponse{
+			ErrorMessage: "authentication required",
+		}), nil
+	}
+
+	team, err := s.usecase.JoinTeam(ctx, userID, req.Msg.InviteCode)
+	if err != nil {
+		log.Printf("Failed to join team: %v", err)
+		return connect.NewResponse(&pb.JoinTeamResponse{
+			ErrorMessage: teamErrorMessage(err, "failed to join team"),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.JoinTeamResponse{
+		Team: teamToPB(team),
+	}), nil
+}
+
+func (s *TeamService) LeaveTeam(ctx context.Context, req *connect.Request[pb.LeaveTeamRequest]) (*connect.Response[pb.LeaveTeamResponse], error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		log.Printf("Failed to get user ID from context: %v", err)
+		return connect.NewResponse(&pb.LeaveTeamResponse{
+			ErrorMessage: "authentication required",
+		}), nil
+	}
+
+	if err := s.usecase.LeaveTeam(ctx, userID); err != nil {
+		log.Printf("Failed to leave team: %v", err)
+		return connect.NewResponse(&pb.LeaveTeamResponse{
+			ErrorMessage: teamErrorMessage(err, "failed to leave team"),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.LeaveTeamResponse{}), nil
+}
+
+func (s *TeamService) GetMyTeam(ctx context.Context, req *connect.Request[pb.GetMyTeamRequest]) (*connect.Response[pb.GetMyTeamResponse], error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		log.Printf("Failed to get user ID from context: %v", err)
+		return connect.NewResponse(&pb.GetMyTeamResponse{
+			ErrorMessage: "authentication required",
+		}), nil
+	}
+
+	team, err := s.usecase.GetMyTeam(ctx, userID)
+	if err != nil {
+		if err == domain.ErrNotInTeam {
+			return connect.NewResponse(&pb.GetMyTeamResponse{}), nil
+		}
+		log.Printf("Failed to get team: %v", err)
+		return connect.NewResponse(&pb.GetMyTeamResponse{
+			ErrorMessage: "failed to get team",
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.GetMyTeamResponse{
+		Team: teamToPB(team),
+	}), nil
+}
+
+func teamToPB(team *domain.Team) *pb.Team {
+	members := make([]*pb.TeamMember, 0, len(team.Members))
+	for _, m := range team.Members {
+		members = append(members, &pb.TeamMember{
+			UserId:   m.UserID,
+			Username: m.Username,
+			JoinedAt: m.JoinedAt.Unix(),
+		})
+	}
+
+	return &pb.Team{
+		TeamId:     team.TeamID,
+		Name:       team.Name,
+		InviteCode: team.InviteCode,
+		Members:    members,
+	}
+}
+
+func teamErrorMessage(err error, fallback string) string {
+	switch err {
+	case domain.ErrInvalidTeamName:
+		return "invalid team name"
+	case domain.ErrTeamAlreadyExists:
+		return "team name already exists"
+	case domain.ErrAlreadyInTeam:
+		return "you already belong to a team"
+	case domain.ErrNotInTeam:
+		return "you do not belong to a team"
+	case domain.ErrInvalidInviteCode:
+		return "invalid invite code"
+	default:
+		return fallback
+	}
+}
@@ -28,7 +28,7 @@ func (s *UserAuthService) Register(ctx context.Context, req *connect.Request[pb.
 	if err != nil {
 		log.Printf("Register failed: %v", err)
 
-		errorMsg := "registration failed"
+		errorMsg := profileErrorMessage(err, "registration failed")
 		if err == domain.ErrUserAlreadyExists {
 			errorMsg = "username already exists"
 		}
@@ -55,6 +55,9 @@ func (s *UserAuthService) Login(ctx context.Context, req *connect.Request[pb.Log
 		if err == domain.ErrInvalidPassword {
 			errorMsg = "invalid username or password"
 		}
+		if err == domain.ErrUserBanned {
+			errorMsg = "this account has been banned"
+		}
 
 		return connect.NewResponse(&pb.LoginResponse{
 			Token:        "",
@@ -90,3 +93,108 @@ func (s *UserAuthService) Logout(ctx context.Context, req *connect.Request[pb.Lo
 		ErrorMessage: "",
 	}), nil
 }
+
+func (s *UserAuthService) GetProfile(ctx context.Context, req *connect.Request[pb.GetProfileRequest]) (*connect.Response[pb.GetProfileResponse], error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		log.Printf("Failed to get user ID from context: %v", err)
+		return connect.NewResponse(&pb.GetProfileResponse{
+			ErrorMessage: "authentication required",
+		}), nil
+	}
+
+	user, err := s.usecase.GetProfile(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to get profile: %v", err)
+		return connect.NewResponse(&pb.GetProfileResponse{
+			ErrorMessage: "failed to get profile",
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.GetProfileResponse{
+		Profile: userProfileToPB(user),
+	}), nil
+}
+
+func (s *UserAuthService) UpdateProfile(ctx context.Context, req *connect.Request[pb.UpdateProfileRequest]) (*connect.Response[pb.UpdateProfileResponse], error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		log.Printf("Failed to get user ID from context: %v", err)
+		return connect.NewResponse(&pb.UpdateProfileResponse{
+			ErrorMessage: "authentication required",
+		}), nil
+	}
+
+	profile := &domain.UserProfile{
+		Email:       req.Msg.Email,
+		DisplayName: req.Msg.DisplayName,
+		Affiliation: req.Msg.Affiliation,
+		Country:     req.Msg.Country,
+	}
+
+	user, err := s.usecase.UpdateProfile(ctx, userID, profile)
+	if err != nil {
+		log.Printf("Failed to update profile: %v", err)
+		return connect.NewResponse(&pb.UpdateProfileResponse{
+			ErrorMessage: profileErrorMessage(err, "failed to update profile"),
+		}), nil
+	}
+
+	return connect.NewResponse(&pb.UpdateProfileResponse{
+		Profile: userProfileToPB(user),
+	}), nil
+}
+
+func (s *UserAuthService) ChangePassword(ctx context.Context, req *connect.Request[pb.ChangePasswordRequest]) (*connect.Response[pb.ChangePasswordResponse], error) {
+	session, err := getSessionFromContext(ctx)
+	if err != nil {
+		log.Printf("Failed to get session from context: %v", err)
+		return connect.NewResponse(&pb.ChangePasswordResponse{
+			ErrorMessage: "authentication required",
+		}), nil
+	}
+
+	if err := s.usecase.ChangePassword(ctx, session, req.Msg.CurrentPassword, req.Msg.NewPassword); err != nil {
+		log.Printf("Failed to change password: %v", err)
+
+		errorMsg := profileErrorMessage(err, "failed to change password")
+		if err == domain.ErrInvalidPassword {
+			errorMsg = "current password is incorrect"
+		}
+
+		return connect.NewResponse(&pb.ChangePasswordResponse{
+			ErrorMessage: errorMsg,
+		}), nil
+	}
+
+	log.Printf("User changed password: %s", session.UserID)
+	return connect.NewResponse(&pb.ChangePasswordResponse{}), nil
+}
+
+func userProfileToPB(u *domain.User) *pb.UserProfile {
+	return &pb.UserProfile{
+		UserId:      u.UserID,
+		Username:    u.Username,
+		Email:       u.Email,
+		DisplayName: u.DisplayName,
+		Affiliation: u.Affiliation,
+		Country:     u.Country,
+	}
+}
+
+// profileErrorMessage は入力の検証エラーをそのまま返し、それ以外は fallback を返す
+func profileErrorMessage(err error, fallback string) string {
+	switch err {
+	case domain.ErrInvalidEmail,
+		domain.ErrEmailAlreadyExists,
+		domain.ErrInvalidDisplayName,
+		domain.ErrInvalidAffiliation,
+		domain.ErrInvalidCountry,
+		domain.ErrPasswordTooShort,
+		domain.ErrPasswordTooLong,
+		domain.ErrPasswordUnchanged:
+		return err.Error()
+	default:
+		return fallback
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -15,7 +17,10 @@ import (
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
+	"github.com/kavos113/quickctf/ctf-server/domain"
 	"github.com/kavos113/quickctf/ctf-server/infrastructure/client"
+	"github.com/kavos113/quickctf/ctf-server/infrastructure/eventhub"
+	"github.com/kavos113/quickctf/ctf-server/infrastructure/ratelimit"
 	"github.com/kavos113/quickctf/ctf-server/infrastructure/repository"
 	"github.com/kavos113/quickctf/ctf-server/infrastructure/storage"
 	"github.com/kavos113/quickctf/ctf-server/interface/middleware"
@@ -44,6 +49,13 @@ func main() {
 	submissionRepo := repository.NewMySQLSubmissionRepository(db)
 	instanceRepo := repository.NewMySQLInstanceRepository(db)
 	attachmentRepo := repository.NewAttachmentRepository(db)
+	teamRepo := repository.NewMySQLTeamRepository(db)
+	eventRepo := repository.NewMySQLEventConfigRepository(db)
+	issuedFlagRepo := repository.NewMySQLIssuedFlagRepository(db)
+	hintRepo := repository.NewMySQLHintRepository(db)
+	announcementRepo := repository.NewMySQLAnnouncementRepository(db)
+	partSolveRepo := repository.NewMySQLFlagPartSolveRepository(db)
+	auditRepo := repository.NewMySQLAuditRepository(db)
 
 	// Initialize storage
 	s3Config := storage.NewS3ConfigFromEnv()
@@ -76,18 +88,43 @@ func main() {
 	}
 	defer managerClient.Close()
 
+	submitLimiter, err := newSubmitRateLimiter()
+	if err != nil {
+		log.Fatalf("failed to create submission rate limiter: %v", err)
+	}
+
+	trustedProxies, err := parseTrustedProxies(os.Getenv("TRUSTED_PROXIES"))
+	if err != nil {
+		log.Fatalf("failed to parse trusted proxies: %v", err)
+	}
+
+	// スケジューラーやイベントの購読など、サーバーの停止まで動かす処理のコンテキスト
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+
+	eventHub, err := newEventHub(backgroundCtx)
+	if err != nil {
+		log.Fatalf("failed to create event hub: %v", err)
+	}
+
 	userAuthUsecase := usecase.NewUserAuthUsecase(userRepo, sessionRepo)
-	adminAuthUsecase := usecase.NewAdminAuthUsecase(sessionRepo)
-	adminServiceUsecase := usecase.NewAdminServiceUsecase(challengeRepo, attachmentRepo, sessionRepo, builderClient, attachmentStorage, buildLogStorage)
-	clientChallengeUsecase := usecase.NewClientChallengeUsecase(challengeRepo, submissionRepo, instanceRepo, attachmentRepo, managerClient, attachmentStorage)
+	adminAuthUsecase := usecase.NewAdminAuthUsecase(userRepo, sessionRepo)
+	adminServiceUsecase := usecase.NewAdminServiceUsecase(challengeRepo, attachmentRepo, submissionRepo, instanceRepo, eventRepo, userRepo, sessionRepo, hintRepo, announcementRepo, eventHub, builderClient, managerClient, attachmentStorage, buildLogStorage)
+	clientChallengeUsecase := usecase.NewClientChallengeUsecase(challengeRepo, submissionRepo, instanceRepo, attachmentRepo, teamRepo, eventRepo, issuedFlagRepo, hintRepo, partSolveRepo, announcementRepo, userRepo, submitLimiter, eventHub, managerClient, attachmentStorage)
+	teamUsecase := usecase.NewTeamUsecase(teamRepo)
+	authorizer := usecase.NewAuthorizer(userRepo, challengeRepo, hintRepo)
+	auditUsecase := usecase.NewAuditUsecase(auditRepo, challengeRepo, hintRepo, announcementRepo, eventRepo, submissionRepo, userRepo)
+	releaseScheduler := usecase.NewReleaseScheduler(challengeRepo, eventHub)
 
 	userAuthService := service.NewUserAuthService(userAuthUsecase)
 	adminAuthService := service.NewAdminAuthService(adminAuthUsecase)
-	adminService := service.NewAdminService(adminServiceUsecase)
-	clientChallengeService := service.NewClientChallengeService(clientChallengeUsecase)
+	adminService := service.NewAdminService(adminServiceUsecase, auditUsecase, authorizer)
+	clientChallengeService := service.NewClientChallengeService(clientChallengeUsecase, authorizer)
+	teamService := service.NewTeamService(teamUsecase)
 
 	authInterceptor := middleware.NewAuthInterceptor(sessionRepo)
 	loggingInterceptor := logger.NewConnectLoggingInterceptor("ctf-server")
+	auditInterceptor := middleware.NewAuditInterceptor(auditUsecase, trustedProxies)
 
 	interceptors := connect.WithInterceptors(authInterceptor, loggingInterceptor)
 
@@ -101,12 +138,19 @@ func main() {
 	path, handler = serverv1connect.NewAdminAuthServiceHandler(adminAuthService, interceptors)
 	mux.Handle(path, handler)
 
-	path, handler = serverv1connect.NewAdminServiceHandler(adminService, interceptors)
+	path, handler = serverv1connect.NewAdminServiceHandler(adminService, interceptors, connect.WithInterceptors(auditInterceptor))
 	mux.Handle(path, handler)
 
 	path, handler = serverv1connect.NewClientChallengeServiceHandler(clientChallengeService, interceptors)
 	mux.Handle(path, handler)
 
+	path, handler = serverv1connect.NewTeamServiceHandler(teamService, interceptors)
+	mux.Handle(path, handler)
+
+	// CTFtime のスコアボードフィード。CTFTIME_FEED_PUBLIC=true の場合は認証なしで取得できる
+	ctftimeFeedHandler := service.NewCTFtimeFeedHandler(clientChallengeUsecase, authorizer, os.Getenv("CTFTIME_FEED_PUBLIC") == "true")
+	mux.Handle("/ctftime/scoreboard.json", authInterceptor.WrapHTTP(ctftimeFeedHandler))
+
 	corsHandler := corsMiddleware(mux)
 
 	server := &http.Server{
@@ -116,6 +160,8 @@ func main() {
 
 	log.Printf("CTF server listening on port %s", port)
 
+	go releaseScheduler.Run(backgroundCtx)
+
 	// Graceful shutdown
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
@@ -123,6 +169,7 @@ func main() {
 	go func() {
 		<-sigChan
 		log.Println("Shutting down gracefully...")
+		stopBackground()
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 		server.Shutdown(ctx)
@@ -133,6 +180,87 @@ func main() {
 	}
 }
 
+// newSubmitRateLimiter はフラグ提出のレートリミッターを環境変数の設定から作成する
+// SUBMIT_RATE_LIMIT が0の場合は制限しない
+func newSubmitRateLimiter() (domain.RateLimiter, error) {
+	limit := 10
+	if v := os.Getenv("SUBMIT_RATE_LIMIT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid SUBMIT_RATE_LIMIT: %q", v)
+		}
+		limit = n
+	}
+	if limit == 0 {
+		return nil, nil
+	}
+
+	window := time.Minute
+	if v := os.Getenv("SUBMIT_RATE_WINDOW"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid SUBMIT_RATE_WINDOW: %q", v)
+		}
+		window = d
+	}
+
+	switch backend := os.Getenv("SUBMIT_RATE_LIMIT_BACKEND"); backend {
+	case "", "memory":
+		return ratelimit.NewMemoryRateLimiter(limit, window), nil
+	case "redis":
+		return ratelimit.NewRedisRateLimiter(limit, window)
+	default:
+		return nil, fmt.Errorf("unknown SUBMIT_RATE_LIMIT_BACKEND: %q", backend)
+	}
+}
+
+// parseTrustedProxies はカンマ区切りの CIDR または IP アドレスを解析する
+func parseTrustedProxies(v string) ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+	for _, s := range strings.Split(v, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid TRUSTED_PROXIES: %q", s)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TRUSTED_PROXIES: %q", s)
+		}
+		proxies = append(proxies, n)
+	}
+	return proxies, nil
+}
+
+// newEventHub は参加者へのイベント配信に使うハブを環境変数の設定から作成する
+// redis の場合は ctx が終了するまで Redis からイベントを受け取る
+func newEventHub(ctx context.Context) (domain.EventHub, error) {
+	switch backend := os.Getenv("EVENT_HUB_BACKEND"); backend {
+	case "", "memory":
+		return eventhub.NewMemoryEventHub(), nil
+	case "redis":
+		hub, err := eventhub.NewRedisEventHub()
+		if err != nil {
+			return nil, err
+		}
+		go hub.Run(ctx)
+		return hub, nil
+	default:
+		return nil, fmt.Errorf("unknown EVENT_HUB_BACKEND: %q", backend)
+	}
+}
+
 func corsMiddleware(next http.Handler) http.Handler {
 	origins := os.Getenv("CORS_ALLOWED_ORIGINS")
 	originsMap := make(map[string]bool)
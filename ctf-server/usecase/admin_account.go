@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+func (u *AdminServiceUsecase) ListAdmins(ctx context.Context) ([]*domain.User, error) {
+	return u.userRepo.FindStaff(ctx)
+}
+
+// GrantAdmin は username のアカウントに役割を付与する。既に役割を持つ場合は変更する
+// 付与されたユーザーは管理者モードを有効にすると管理画面を使える
+func (u *AdminServiceUsecase) GrantAdmin(ctx context.Context, username string, role domain.Role) (*domain.User, error) {
+	if !role.IsStaff() {
+		return nil, domain.ErrInvalidRole
+	}
+
+	user, err := u.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role == role {
+		return user, nil
+	}
+
+	if err := u.userRepo.SetRole(ctx, user.UserID, role); err != nil {
+		return nil, err
+	}
+	user.Role = role
+
+	return user, nil
+}
+
+// RevokeAdmin は役割を外し、そのユーザーのすべてのセッションの管理者モードを解除する
+func (u *AdminServiceUsecase) RevokeAdmin(ctx context.Context, userID string) error {
+	if _, err := u.userRepo.FindByID(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := u.userRepo.SetRole(ctx, userID, domain.RoleNone); err != nil {
+		return err
+	}
+
+	return u.sessionRepo.RevokeAdminByUserID(ctx, userID)
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+func TestAdminServiceUsecase_GrantRevokeAdmin(t *testing.T) {
+	ctx := context.Background()
+	userRepo := NewMockUserRepository()
+	userRepo.Create(ctx, &domain.User{UserID: "admin", Username: "root", Role: domain.RoleSuperadmin})
+	userRepo.Create(ctx, &domain.User{UserID: "staff", Username: "staff"})
+
+	sessionRepo := NewMockSessionRepository()
+	staffSession := &domain.Session{SessionID: "s1", UserID: "staff", Token: "staff-token", ExpiresAt: time.Now().Add(time.Hour)}
+	sessionRepo.Create(ctx, staffSession)
+
+	uc := &AdminServiceUsecase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+	}
+
+	if _, err := uc.GrantAdmin(ctx, "nobody", domain.RoleAuthor); err != domain.ErrUserNotFound {
+		t.Errorf("GrantAdmin() unknown user error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+	if _, err := uc.GrantAdmin(ctx, "staff", domain.RoleNone); err != domain.ErrInvalidRole {
+		t.Errorf("GrantAdmin() no role error = %v, want %v", err, domain.ErrInvalidRole)
+	}
+
+	granted, err := uc.GrantAdmin(ctx, "staff", domain.RoleAuthor)
+	if err != nil {
+		t.Fatalf("GrantAdmin() error = %v", err)
+	}
+	if granted.Role != domain.RoleAuthor {
+		t.Errorf("GrantAdmin() Role = %v, want %v", granted.Role, domain.RoleAuthor)
+	}
+
+	if _, err := uc.GrantAdmin(ctx, "staff", domain.RoleSupport); err != nil {
+		t.Fatalf("GrantAdmin() change role error = %v", err)
+	}
+	if user, _ := userRepo.FindByID(ctx, "staff"); user.Role != domain.RoleSupport {
+		t.Errorf("GrantAdmin() changed Role = %v, want %v", user.Role, domain.RoleSupport)
+	}
+
+	if _, err := uc.GrantAdmin(ctx, "root", domain.RoleAuthor); err != domain.ErrLastAdmin {
+		t.Errorf("GrantAdmin() demoting the last superadmin error = %v, want %v", err, domain.ErrLastAdmin)
+	}
+
+	admins, _ := uc.ListAdmins(ctx)
+	if len(admins) != 2 {
+		t.Errorf("ListAdmins() = %d admins, want 2", len(admins))
+	}
+
+	staffSession.IsAdmin = true
+	if err := uc.RevokeAdmin(ctx, "staff"); err != nil {
+		t.Fatalf("RevokeAdmin() error = %v", err)
+	}
+	if user, _ := userRepo.FindByID(ctx, "staff"); user.Role != domain.RoleNone {
+		t.Errorf("RevokeAdmin() user Role = %v, want none", user.Role)
+	}
+	if staffSession.IsAdmin {
+		t.Errorf("RevokeAdmin() session IsAdmin = true, want false")
+	}
+
+	if err := uc.RevokeAdmin(ctx, "admin"); err != domain.ErrLastAdmin {
+		t.Errorf("RevokeAdmin() last admin error = %v, want %v", err, domain.ErrLastAdmin)
+	}
+}
@@ -2,33 +2,29 @@ package usecase
 
 import (
 	"context"
+	"crypto/subtle"
 	"os"
 
 	"github.com/kavos113/quickctf/ctf-server/domain"
 )
 
+// AdminAuthUsecase はセッションの管理者モードを切り替える
+// 管理者モードにできるのは役割を持つアカウントのみ。アクティベーションコードは最初の superadmin を作るためだけに使う
 type AdminAuthUsecase struct {
+	userRepo       domain.UserRepository
 	sessionRepo    domain.SessionRepository
-	activationCode string
+	activationCode string // 空の場合はアクティベーションコードで管理者を作れない
 }
 
-func NewAdminAuthUsecase(sessionRepo domain.SessionRepository) *AdminAuthUsecase {
-	code := os.Getenv("ADMIN_ACTIVATION_CODE")
-	if code == "" {
-		code = "admin_secret"
-	}
-
+func NewAdminAuthUsecase(userRepo domain.UserRepository, sessionRepo domain.SessionRepository) *AdminAuthUsecase {
 	return &AdminAuthUsecase{
+		userRepo:       userRepo,
 		sessionRepo:    sessionRepo,
-		activationCode: code,
+		activationCode: os.Getenv("ADMIN_ACTIVATION_CODE"),
 	}
 }
 
 func (u *AdminAuthUsecase) ActivateAdmin(ctx context.Context, token, activationCode string) error {
-	if activationCode != u.activationCode {
-		return domain.ErrInvalidActivationCode
-	}
-
 	session, err := u.sessionRepo.FindByToken(ctx, token)
 	if err != nil {
 		return err
@@ -39,16 +35,7 @@ func (u *AdminAuthUsecase) ActivateAdmin(ctx context.Context, token, activationC
 		return domain.ErrSessionExpired
 	}
 
-	if session.IsAdmin {
-		return nil
-	}
-
-	session.IsAdmin = true
-	if err := u.sessionRepo.Update(ctx, session); err != nil {
-		return err
-	}
-
-	return nil
+	return u.ActivateAdminWithSession(ctx, session, activationCode)
 }
 
 func (u *AdminAuthUsecase) ValidateAdminToken(ctx context.Context, token string) error {
@@ -83,10 +70,18 @@ func (u *AdminAuthUsecase) DeactivateAdmin(ctx context.Context, token string) er
 	return u.sessionRepo.Update(ctx, session)
 }
 
-// ActivateAdminWithSession はセッションを使って管理者権限を付与する（インターセプター用）
+// ActivateAdminWithSession はセッションを管理者モードにする（インターセプター用）
+// 役割を持たないアカウントは、superadmin が1人もいない場合に限りアクティベーションコードで最初の superadmin になれる
 func (u *AdminAuthUsecase) ActivateAdminWithSession(ctx context.Context, session *domain.Session, activationCode string) error {
-	if activationCode != u.activationCode {
-		return domain.ErrInvalidActivationCode
+	user, err := u.userRepo.FindByID(ctx, session.UserID)
+	if err != nil {
+		return err
+	}
+
+	if !user.Role.IsStaff() {
+		if err := u.bootstrapAdmin(ctx, user, activationCode); err != nil {
+			return err
+		}
 	}
 
 	if session.IsAdmin {
@@ -101,6 +96,22 @@ func (u *AdminAuthUsecase) ActivateAdminWithSession(ctx context.Context, session
 	return nil
 }
 
+func (u *AdminAuthUsecase) bootstrapAdmin(ctx context.Context, user *domain.User, activationCode string) error {
+	if u.activationCode == "" || subtle.ConstantTimeCompare([]byte(activationCode), []byte(u.activationCode)) != 1 {
+		return domain.ErrInvalidActivationCode
+	}
+
+	if err := u.userRepo.BootstrapAdmin(ctx, user.UserID); err != nil {
+		if err == domain.ErrAdminAlreadyExists {
+			return domain.ErrInvalidActivationCode
+		}
+		return err
+	}
+	user.Role = domain.RoleSuperadmin
+
+	return nil
+}
+
 // DeactivateAdminWithSession はセッションを使って管理者権限を解除する（インターセプター用）
 func (u *AdminAuthUsecase) DeactivateAdminWithSession(ctx context.Context, session *domain.Session) error {
 	if !session.IsAdmin {
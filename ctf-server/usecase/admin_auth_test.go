@@ -2,7 +2,6 @@ package usecase
 
 import (
 	"context"
-	"os"
 	"testing"
 	"time"
 
@@ -11,75 +10,98 @@ import (
 
 func TestAdminAuthUsecase_ActivateAdmin(t *testing.T) {
 	ctx := context.Background()
-	sessionRepo := NewMockSessionRepository()
-
 	testCode := "test_activation_code"
-	os.Setenv("ADMIN_ACTIVATION_CODE", testCode)
-	defer os.Unsetenv("ADMIN_ACTIVATION_CODE")
-
-	uc := NewAdminAuthUsecase(sessionRepo)
-
-	session := &domain.Session{
-		SessionID: "test-session",
-		UserID:    "test-user",
-		Token:     "test-token",
-		IsAdmin:   false,
-		ExpiresAt: time.Now().Add(24 * time.Hour),
-	}
-	sessionRepo.Create(ctx, session)
 
 	tests := []struct {
 		name           string
+		configuredCode string
+		userRole       domain.Role
+		otherAdmin     bool
 		token          string
 		activationCode string
-		wantErr        bool
-		wantIsAdmin    bool
+		wantErr        error
 	}{
 		{
-			name:           "valid activation code",
+			name:           "first admin with valid activation code",
+			configuredCode: testCode,
 			token:          "test-token",
 			activationCode: testCode,
-			wantErr:        false,
-			wantIsAdmin:    true,
 		},
 		{
 			name:           "invalid activation code",
+			configuredCode: testCode,
 			token:          "test-token",
 			activationCode: "wrong-code",
-			wantErr:        true,
-			wantIsAdmin:    false,
+			wantErr:        domain.ErrInvalidActivationCode,
+		},
+		{
+			name:           "activation code is used only while no admin exists",
+			configuredCode: testCode,
+			otherAdmin:     true,
+			token:          "test-token",
+			activationCode: testCode,
+			wantErr:        domain.ErrInvalidActivationCode,
+		},
+		{
+			name:           "activation code is not configured",
+			configuredCode: "",
+			token:          "test-token",
+			activationCode: "",
+			wantErr:        domain.ErrInvalidActivationCode,
+		},
+		{
+			name:           "staff account does not need activation code",
+			configuredCode: testCode,
+			userRole:       domain.RoleAuthor,
+			otherAdmin:     true,
+			token:          "test-token",
 		},
 		{
 			name:           "invalid token",
+			configuredCode: testCode,
 			token:          "invalid-token",
 			activationCode: testCode,
-			wantErr:        true,
-			wantIsAdmin:    false,
+			wantErr:        domain.ErrSessionNotFound,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			session.IsAdmin = false
-			sessionRepo.Update(ctx, session)
+			t.Setenv("ADMIN_ACTIVATION_CODE", tt.configuredCode)
 
-			err := uc.ActivateAdmin(ctx, tt.token, tt.activationCode)
+			userRepo := NewMockUserRepository()
+			userRepo.Create(ctx, &domain.User{UserID: "test-user", Username: "test", Role: tt.userRole})
+			if tt.otherAdmin {
+				userRepo.Create(ctx, &domain.User{UserID: "other-admin", Username: "other", Role: domain.RoleSuperadmin})
+			}
 
-			if tt.wantErr {
-				if err == nil {
-					t.Errorf("ActivateAdmin() error = nil, wantErr %v", tt.wantErr)
-				}
-				return
+			sessionRepo := NewMockSessionRepository()
+			session := &domain.Session{
+				SessionID: "test-session",
+				UserID:    "test-user",
+				Token:     "test-token",
+				IsAdmin:   false,
+				ExpiresAt: time.Now().Add(24 * time.Hour),
 			}
+			sessionRepo.Create(ctx, session)
 
-			if err != nil {
-				t.Errorf("ActivateAdmin() error = %v, wantErr %v", err, tt.wantErr)
-				return
+			uc := NewAdminAuthUsecase(userRepo, sessionRepo)
+
+			err := uc.ActivateAdmin(ctx, tt.token, tt.activationCode)
+			if err != tt.wantErr {
+				t.Fatalf("ActivateAdmin() error = %v, want %v", err, tt.wantErr)
 			}
 
-			updatedSession, _ := sessionRepo.FindByToken(ctx, tt.token)
-			if updatedSession.IsAdmin != tt.wantIsAdmin {
-				t.Errorf("ActivateAdmin() IsAdmin = %v, want %v", updatedSession.IsAdmin, tt.wantIsAdmin)
+			wantIsAdmin := tt.wantErr == nil
+			if session.IsAdmin != wantIsAdmin {
+				t.Errorf("ActivateAdmin() session IsAdmin = %v, want %v", session.IsAdmin, wantIsAdmin)
+			}
+			wantRole := tt.userRole
+			if wantRole == domain.RoleNone && wantIsAdmin {
+				wantRole = domain.RoleSuperadmin
+			}
+			if user, _ := userRepo.FindByID(ctx, "test-user"); user.Role != wantRole {
+				t.Errorf("ActivateAdmin() user Role = %v, want %v", user.Role, wantRole)
 			}
 		})
 	}
@@ -88,7 +110,7 @@ func TestAdminAuthUsecase_ActivateAdmin(t *testing.T) {
 func TestAdminAuthUsecase_ValidateAdminToken(t *testing.T) {
 	ctx := context.Background()
 	sessionRepo := NewMockSessionRepository()
-	uc := NewAdminAuthUsecase(sessionRepo)
+	uc := NewAdminAuthUsecase(NewMockUserRepository(), sessionRepo)
 
 	adminSession := &domain.Session{
 		SessionID: "admin-session",
@@ -149,7 +171,7 @@ func TestAdminAuthUsecase_ValidateAdminToken(t *testing.T) {
 func TestAdminAuthUsecase_DeactivateAdmin(t *testing.T) {
 	ctx := context.Background()
 	sessionRepo := NewMockSessionRepository()
-	uc := NewAdminAuthUsecase(sessionRepo)
+	uc := NewAdminAuthUsecase(NewMockUserRepository(), sessionRepo)
 
 	adminSession := &domain.Session{
 		SessionID: "admin-session",
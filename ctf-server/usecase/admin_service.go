@@ -14,7 +14,16 @@ import (
 type AdminServiceUsecase struct {
 	challengeRepo     domain.ChallengeRepository
 	attachmentRepo    domain.AttachmentRepository
+	submissionRepo    domain.SubmissionRepository
+	instanceRepo      domain.InstanceRepository
+	eventRepo         domain.EventConfigRepository
+	userRepo          domain.UserRepository
+	sessionRepo       domain.SessionRepository
+	hintRepo          domain.HintRepository
+	announcementRepo  domain.AnnouncementRepository
+	eventHub          domain.EventHub // nilの場合はイベントを配信しない
 	builderClient     *client.BuilderClient
+	managerClient     *client.ManagerClient
 	attachmentStorage *storage.AttachmentStorage
 	buildLogStorage   *storage.BuildLogStorage
 }
@@ -22,38 +31,184 @@ type AdminServiceUsecase struct {
 func NewAdminServiceUsecase(
 	challengeRepo domain.ChallengeRepository,
 	attachmentRepo domain.AttachmentRepository,
+	submissionRepo domain.SubmissionRepository,
+	instanceRepo domain.InstanceRepository,
+	eventRepo domain.EventConfigRepository,
+	userRepo domain.UserRepository,
 	sessionRepo domain.SessionRepository,
+	hintRepo domain.HintRepository,
+	announcementRepo domain.AnnouncementRepository,
+	eventHub domain.EventHub,
 	builderClient *client.BuilderClient,
+	managerClient *client.ManagerClient,
 	attachmentStorage *storage.AttachmentStorage,
 	buildLogStorage *storage.BuildLogStorage,
 ) *AdminServiceUsecase {
 	return &AdminServiceUsecase{
 		challengeRepo:     challengeRepo,
 		attachmentRepo:    attachmentRepo,
+		submissionRepo:    submissionRepo,
+		instanceRepo:      instanceRepo,
+		eventRepo:         eventRepo,
+		userRepo:          userRepo,
+		sessionRepo:       sessionRepo,
+		hintRepo:          hintRepo,
+		announcementRepo:  announcementRepo,
+		eventHub:          eventHub,
 		builderClient:     builderClient,
+		managerClient:     managerClient,
 		attachmentStorage: attachmentStorage,
 		buildLogStorage:   buildLogStorage,
 	}
 }
 
 func (u *AdminServiceUsecase) CreateChallenge(ctx context.Context, challenge *domain.Challenge) (string, error) {
+	if challenge.ScoringType == "" {
+		challenge.ScoringType = domain.ScoringTypeStatic
+	}
+	if challenge.FlagMatchMode == "" {
+		challenge.FlagMatchMode = domain.FlagMatchModeExact
+	}
+	if challenge.Visibility == "" {
+		challenge.Visibility = domain.VisibilityVisible
+	}
+	if err := challenge.ValidateVisibility(); err != nil {
+		return "", err
+	}
+	if err := challenge.ValidateScoring(); err != nil {
+		return "", err
+	}
+	if err := challenge.ValidateFlag(); err != nil {
+		return "", err
+	}
+	if err := challenge.ValidateParts(); err != nil {
+		return "", err
+	}
+	if err := challenge.ValidateBloodBonuses(); err != nil {
+		return "", err
+	}
+	if err := validateFlagMatching(challenge); err != nil {
+		return "", err
+	}
+	if challenge.IsDynamic() {
+		challenge.Points = challenge.DynamicPoints(0)
+	}
+
 	challenge.ChallengeID = uuid.New().String()
+	assignPartIDs(challenge, nil)
+	if err := u.validatePrerequisites(ctx, challenge); err != nil {
+		return "", err
+	}
 	if err := u.challengeRepo.Create(ctx, challenge); err != nil {
 		return "", err
 	}
 
+	if now := time.Now(); challenge.IsReleased(now) {
+		publishEvent(ctx, u.eventHub, newChallengeEvent(challenge, now))
+	}
+
 	return challenge.ChallengeID, nil
 }
 
 func (u *AdminServiceUsecase) UpdateChallenge(ctx context.Context, challengeID string, challenge *domain.Challenge) error {
 	challenge.ChallengeID = challengeID
+	if challenge.ScoringType == "" {
+		challenge.ScoringType = domain.ScoringTypeStatic
+	}
+	if challenge.FlagMatchMode == "" {
+		challenge.FlagMatchMode = domain.FlagMatchModeExact
+	}
+	if challenge.Visibility == "" {
+		challenge.Visibility = domain.VisibilityVisible
+	}
+	if err := challenge.ValidateVisibility(); err != nil {
+		return err
+	}
+	if err := challenge.ValidateScoring(); err != nil {
+		return err
+	}
+	if err := challenge.ValidateFlag(); err != nil {
+		return err
+	}
+	if err := challenge.ValidateParts(); err != nil {
+		return err
+	}
+	if err := challenge.ValidateBloodBonuses(); err != nil {
+		return err
+	}
+	if err := validateFlagMatching(challenge); err != nil {
+		return err
+	}
+	if err := u.validatePrerequisites(ctx, challenge); err != nil {
+		return err
+	}
+
+	existing, err := u.challengeRepo.FindByID(ctx, challengeID)
+	if err != nil {
+		return err
+	}
+	assignPartIDs(challenge, existing.Parts)
+
+	if challenge.IsDynamic() {
+		solves, err := u.submissionRepo.CountSolves(ctx, challengeID)
+		if err != nil {
+			return err
+		}
+		challenge.Points = challenge.DynamicPoints(solves)
+	}
+
 	if err := u.challengeRepo.Update(ctx, challenge); err != nil {
 		return err
 	}
+	// 更新中に解かれた場合に古い正解数の得点が残らないよう、問題の行をロックして数え直す
+	if challenge.IsDynamic() {
+		if err := u.submissionRepo.Rescore(ctx, challengeID); err != nil {
+			return err
+		}
+	}
+
+	if now := time.Now(); !existing.IsReleased(now) && challenge.IsReleased(now) {
+		publishEvent(ctx, u.eventHub, newChallengeEvent(challenge, now))
+	}
 
 	return nil
 }
 
+// assignPartIDs は部分フラグに問題IDと順番を設定する
+// existing に含まれないIDは他の問題の部分フラグを上書きしないよう新しいIDにする
+func assignPartIDs(challenge *domain.Challenge, existing []*domain.FlagPart) {
+	known := make(map[string]bool, len(existing))
+	for _, p := range existing {
+		known[p.PartID] = true
+	}
+
+	for i, p := range challenge.Parts {
+		if !known[p.PartID] {
+			p.PartID = uuid.New().String()
+		}
+		p.ChallengeID = challenge.ChallengeID
+		p.Position = i + 1
+	}
+}
+
+// validatePrerequisites は前提問題が存在し、依存関係が循環しないことを確認する
+func (u *AdminServiceUsecase) validatePrerequisites(ctx context.Context, challenge *domain.Challenge) error {
+	if challenge.PrerequisiteMode == "" {
+		challenge.PrerequisiteMode = domain.PrerequisiteModeAll
+	}
+
+	var challenges []*domain.Challenge
+	if len(challenge.Prerequisites) > 0 {
+		var err error
+		challenges, err = u.challengeRepo.FindAll(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return domain.ValidatePrerequisites(challenge, challenges)
+}
+
 func (u *AdminServiceUsecase) DeleteChallenge(ctx context.Context, challengeID string) error {
 	if err := u.challengeRepo.Delete(ctx, challengeID); err != nil {
 		return err
@@ -62,13 +217,21 @@ func (u *AdminServiceUsecase) DeleteChallenge(ctx context.Context, challengeID s
 	return nil
 }
 
-func (u *AdminServiceUsecase) ListChallenges(ctx context.Context) ([]*domain.Challenge, error) {
+// ListChallenges は staff が編集できる問題のみを返す
+func (u *AdminServiceUsecase) ListChallenges(ctx context.Context, staff *domain.User) ([]*domain.Challenge, error) {
 	challenges, err := u.challengeRepo.FindAll(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return challenges, nil
+	result := make([]*domain.Challenge, 0, len(challenges))
+	for _, c := range challenges {
+		if staff.CanManageChallenge(c) {
+			result = append(result, c)
+		}
+	}
+
+	return result, nil
 }
 
 func (u *AdminServiceUsecase) UploadChallengeImage(ctx context.Context, challengeID string, imageTar []byte) (string, error) {
@@ -100,6 +263,18 @@ func (u *AdminServiceUsecase) ListBuildLogs(ctx context.Context, challengeID str
 	return u.builderClient.ListBuildLogs(ctx, challengeID)
 }
 
+// GetBuildChallengeID はビルドジョブの対象の問題IDを返す
+func (u *AdminServiceUsecase) GetBuildChallengeID(ctx context.Context, jobID string) (string, error) {
+	info, err := u.builderClient.GetBuildJobInfo(ctx, jobID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get build job: %w", err)
+	}
+	if info == nil {
+		return "", fmt.Errorf("build job not found")
+	}
+	return info.ChallengeID, nil
+}
+
 func (u *AdminServiceUsecase) GetBuildLog(ctx context.Context, jobID string) (string, string, error) {
 	result, err := u.builderClient.GetBuildResult(ctx, jobID)
 	if err != nil {
@@ -194,3 +369,110 @@ func (u *AdminServiceUsecase) GetAttachmentURL(ctx context.Context, attachmentID
 
 	return url, nil
 }
+
+func (u *AdminServiceUsecase) GetEventConfig(ctx context.Context) (*domain.EventConfig, error) {
+	return u.eventRepo.Get(ctx)
+}
+
+func (u *AdminServiceUsecase) UpdateEventConfig(ctx context.Context, config *domain.EventConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	config.UpdatedAt = time.Now()
+	return u.eventRepo.Save(ctx, config)
+}
+
+const defaultFlagSharingWindow = 10 * time.Second
+
+// GetFlagSharingReport は提出履歴からフラグ共有が疑われるものを検出する
+func (u *AdminServiceUsecase) GetFlagSharingReport(ctx context.Context, window time.Duration) (*domain.FlagSharingReport, error) {
+	if window <= 0 {
+		window = defaultFlagSharingWindow
+	}
+
+	incorrect, err := u.submissionRepo.FindSharedIncorrect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	solves, err := u.submissionRepo.FindSolves(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := domain.DetectSameWrongAnswers(incorrect)
+	clusters = append(clusters, domain.DetectCloseSolves(solves, window)...)
+
+	report := &domain.FlagSharingReport{
+		Clusters:       clusters,
+		ChallengeNames: make(map[string]string),
+		Usernames:      make(map[string]string),
+	}
+
+	for _, c := range clusters {
+		if err := u.resolveNames(ctx, c.Submissions, report.ChallengeNames, report.Usernames); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// resolveNames は提出の問題名とユーザー名を challengeNames と usernames に追加する
+// 削除済みの問題やユーザーは名前を追加しない
+func (u *AdminServiceUsecase) resolveNames(ctx context.Context, submissions []*domain.Submission, challengeNames, usernames map[string]string) error {
+	for _, s := range submissions {
+		if _, ok := challengeNames[s.ChallengeID]; !ok {
+			challenge, err := u.challengeRepo.FindByID(ctx, s.ChallengeID)
+			if err != nil && err != domain.ErrChallengeNotFound {
+				return err
+			}
+			if challenge != nil {
+				challengeNames[s.ChallengeID] = challenge.Name
+			}
+		}
+
+		if _, ok := usernames[s.UserID]; !ok {
+			user, err := u.userRepo.FindByID(ctx, s.UserID)
+			if err != nil && err != domain.ErrUserNotFound {
+				return err
+			}
+			if user != nil {
+				usernames[s.UserID] = user.Username
+			}
+		}
+	}
+	return nil
+}
+
+// ListSubmissions は filter に一致する提出を1ページ分返す。cursor が空の場合は最初のページを返す
+func (u *AdminServiceUsecase) ListSubmissions(ctx context.Context, filter *domain.SubmissionFilter, cursor string) (*domain.SubmissionPage, error) {
+	submissions, next, err := domain.FetchPage(cursor, filter.Limit, domain.DecodeSubmissionCursor, domain.NewSubmissionCursor,
+		func(after *domain.SubmissionCursor, limit int) ([]*domain.Submission, error) {
+			filter.After = after
+			filter.Limit = limit
+			return u.submissionRepo.FindSubmissions(ctx, filter)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.SubmissionPage{
+		Submissions:    submissions,
+		NextCursor:     next,
+		ChallengeNames: make(map[string]string),
+		Usernames:      make(map[string]string),
+	}
+
+	if err := u.resolveNames(ctx, page.Submissions, page.ChallengeNames, page.Usernames); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// InvalidateSubmission は正解の提出を取り消す。dynamic scoring の問題の得点は取り消しと同じトランザクションで再計算される
+func (u *AdminServiceUsecase) InvalidateSubmission(ctx context.Context, submissionID string) error {
+	return u.submissionRepo.Invalidate(ctx, submissionID, time.Now())
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+func TestAdminServiceUsecase_ListSubmissions(t *testing.T) {
+	ctx := context.Background()
+	base := time.Now().Truncate(time.Second)
+
+	challengeRepo := NewMockChallengeRepository()
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "1", Name: "warmup", Flag: "flag{a}"})
+	userRepo := NewMockUserRepository()
+	userRepo.Create(ctx, &domain.User{UserID: "user1", Username: "alice"})
+
+	submissionRepo := NewMockSubmissionRepository()
+	// s1 と s2 は同じ時刻に提出されたものとする
+	offsets := []int{0, 1, 1, 2, 3}
+	for i, offset := range offsets {
+		submissionRepo.Create(ctx, &domain.Submission{
+			SubmissionID: fmt.Sprintf("s%d", i),
+			UserID:       "user1",
+			ChallengeID:  "1",
+			IsCorrect:    i == 4,
+			SubmittedAt:  base.Add(time.Duration(offset) * time.Second),
+		})
+	}
+	submissionRepo.Create(ctx, &domain.Submission{SubmissionID: "other", UserID: "user2", ChallengeID: "1", SubmittedAt: base})
+
+	uc := &AdminServiceUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		userRepo:       userRepo,
+	}
+
+	tests := []struct {
+		name   string
+		filter domain.SubmissionFilter
+		want   []string
+	}{
+		{
+			name:   "newest first",
+			filter: domain.SubmissionFilter{UserID: "user1", Limit: 2},
+			want:   []string{"s4", "s3", "s2", "s1", "s0"},
+		},
+		{
+			name:   "oldest first",
+			filter: domain.SubmissionFilter{UserID: "user1", Order: domain.SubmissionOrderOldestFirst, Limit: 2},
+			want:   []string{"s0", "s1", "s2", "s3", "s4"},
+		},
+		{
+			name:   "incorrect only",
+			filter: domain.SubmissionFilter{UserID: "user1", Result: domain.SubmissionResultIncorrect, Limit: 3},
+			want:   []string{"s3", "s2", "s1", "s0"},
+		},
+		{
+			name:   "time range",
+			filter: domain.SubmissionFilter{UserID: "user1", Since: base.Add(time.Second), Until: base.Add(2 * time.Second)},
+			want:   []string{"s2", "s1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			cursor := ""
+			for page := 0; page < 10; page++ {
+				filter := tt.filter
+				result, err := uc.ListSubmissions(ctx, &filter, cursor)
+				if err != nil {
+					t.Fatalf("ListSubmissions() error = %v", err)
+				}
+				if tt.filter.Limit > 0 && len(result.Submissions) > tt.filter.Limit {
+					t.Fatalf("ListSubmissions() returned %d submissions, want at most %d", len(result.Submissions), tt.filter.Limit)
+				}
+				for _, s := range result.Submissions {
+					got = append(got, s.SubmissionID)
+				}
+				if result.Usernames["user1"] != "alice" || result.ChallengeNames["1"] != "warmup" {
+					t.Errorf("ListSubmissions() names = %v, %v", result.Usernames, result.ChallengeNames)
+				}
+				if result.NextCursor == "" {
+					break
+				}
+				cursor = result.NextCursor
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("ListSubmissions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := uc.ListSubmissions(ctx, &domain.SubmissionFilter{}, "!!!"); err != domain.ErrInvalidCursor {
+		t.Errorf("ListSubmissions() error = %v, want %v", err, domain.ErrInvalidCursor)
+	}
+}
+
+func TestAdminServiceUsecase_InvalidateSubmission(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	submissionRepo.challengeRepo = challengeRepo
+
+	challengeRepo.Create(ctx, &domain.Challenge{
+		ChallengeID:   "1",
+		Flag:          "flag{dynamic}",
+		Points:        500,
+		ScoringType:   domain.ScoringTypeDynamic,
+		InitialPoints: 500,
+		MinimumPoints: 100,
+		Decay:         2,
+	})
+
+	client := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+	}
+	for _, userID := range []string{"user1", "user2"} {
+		if _, _, err := client.SubmitFlag(ctx, userID, "1", "flag{dynamic}"); err != nil {
+			t.Fatalf("SubmitFlag() error = %v", err)
+		}
+	}
+	if _, _, err := client.SubmitFlag(ctx, "user3", "1", "flag{wrong}"); err != nil {
+		t.Fatalf("SubmitFlag() error = %v", err)
+	}
+
+	solveOf := func(userID string) *domain.Submission {
+		submissions, _ := submissionRepo.FindByUserAndChallenge(ctx, userID, "1")
+		return submissions[0]
+	}
+
+	uc := &AdminServiceUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+	}
+
+	if err := uc.InvalidateSubmission(ctx, solveOf("user3").SubmissionID); err != domain.ErrSubmissionNotCorrect {
+		t.Errorf("InvalidateSubmission() incorrect error = %v, want %v", err, domain.ErrSubmissionNotCorrect)
+	}
+
+	first := solveOf("user1")
+	if err := uc.InvalidateSubmission(ctx, first.SubmissionID); err != nil {
+		t.Fatalf("InvalidateSubmission() error = %v", err)
+	}
+
+	if first.IsCorrect || first.InvalidatedAt.IsZero() {
+		t.Errorf("InvalidateSubmission() submission = %+v, want invalidated", first)
+	}
+	if rank := solveOf("user2").SolveRank; rank != 1 {
+		t.Errorf("second solver rank = %v, want 1", rank)
+	}
+	if updated, _ := challengeRepo.FindByID(ctx, "1"); updated.Points != 500 {
+		t.Errorf("challenge Points = %v, want 500", updated.Points)
+	}
+
+	if err := uc.InvalidateSubmission(ctx, first.SubmissionID); err != domain.ErrSubmissionNotCorrect {
+		t.Errorf("InvalidateSubmission() twice error = %v, want %v", err, domain.ErrSubmissionNotCorrect)
+	}
+}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ListUsers は filter に一致するユーザーを1ページ分返す。cursor が空の場合は最初のページを返す
+func (u *AdminServiceUsecase) ListUsers(ctx context.Context, filter *domain.UserFilter, cursor string) (*domain.UserPage, error) {
+	users, next, err := domain.FetchPage(cursor, filter.Limit, domain.DecodeUserCursor, domain.NewUserCursor,
+		func(after *domain.UserCursor, limit int) ([]*domain.User, error) {
+			filter.After = after
+			filter.Limit = limit
+			return u.userRepo.FindUsers(ctx, filter)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.UserPage{Users: users, NextCursor: next}, nil
+}
+
+// GetUser はユーザーと、そのユーザーが解いた問題・起動中のインスタンスを返す
+func (u *AdminServiceUsecase) GetUser(ctx context.Context, userID string) (*domain.UserDetail, error) {
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	submissions, err := u.submissionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var solves []*domain.Submission
+	for _, s := range submissions {
+		if s.IsSolve() {
+			solves = append(solves, s)
+		}
+	}
+
+	instances, err := u.instanceRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &domain.UserDetail{
+		User:           user,
+		Solves:         solves,
+		Instances:      instances,
+		ChallengeNames: make(map[string]string),
+	}
+	usernames := map[string]string{user.UserID: user.Username}
+	if err := u.resolveNames(ctx, solves, detail.ChallengeNames, usernames); err != nil {
+		return nil, err
+	}
+	for _, i := range instances {
+		if _, ok := detail.ChallengeNames[i.ChallengeID]; ok {
+			continue
+		}
+		challenge, err := u.challengeRepo.FindByID(ctx, i.ChallengeID)
+		if err != nil && err != domain.ErrChallengeNotFound {
+			return nil, err
+		}
+		if challenge != nil {
+			detail.ChallengeNames[i.ChallengeID] = challenge.Name
+		}
+	}
+
+	return detail, nil
+}
+
+// BanUser はユーザーの利用を停止し、すべてのセッションを削除する
+// 運営アカウントは先に役割を外す必要がある
+func (u *AdminServiceUsecase) BanUser(ctx context.Context, userID string) error {
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Role.IsStaff() {
+		return domain.ErrUserIsStaff
+	}
+
+	if !user.IsBanned() {
+		if err := u.userRepo.SetBanned(ctx, userID, time.Now()); err != nil {
+			return err
+		}
+		if err := u.recalculateSolvedChallenges(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	return u.sessionRepo.DeleteByUserID(ctx, userID)
+}
+
+func (u *AdminServiceUsecase) UnbanUser(ctx context.Context, userID string) error {
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsBanned() {
+		return nil
+	}
+
+	if err := u.userRepo.SetBanned(ctx, userID, time.Time{}); err != nil {
+		return err
+	}
+
+	return u.recalculateSolvedChallenges(ctx, userID)
+}
+
+// recalculateSolvedChallenges は userID が解いた問題の順位と dynamic scoring の得点を再計算する。
+// 利用停止中のユーザーの正解は順位と解答数に含めないため、停止・解除のたびに呼ぶ
+func (u *AdminServiceUsecase) recalculateSolvedChallenges(ctx context.Context, userID string) error {
+	submissions, err := u.submissionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	solvedChallengeIDs := make(map[string]bool)
+	for _, s := range submissions {
+		if s.IsCorrect && s.PartID == "" {
+			solvedChallengeIDs[s.ChallengeID] = true
+		}
+	}
+
+	return u.recalculateChallenges(ctx, solvedChallengeIDs)
+}
+
+func (u *AdminServiceUsecase) recalculateChallenges(ctx context.Context, challengeIDs map[string]bool) error {
+	for challengeID := range challengeIDs {
+		if err := u.submissionRepo.Rescore(ctx, challengeID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResetUserPassword は一時パスワードを発行して返し、すべてのセッションを削除する
+func (u *AdminServiceUsecase) ResetUserPassword(ctx context.Context, userID string) (string, error) {
+	password, err := generateTemporaryPassword()
+	if err != nil {
+		return "", err
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	if err := u.userRepo.UpdatePassword(ctx, userID, string(hashedPassword)); err != nil {
+		return "", err
+	}
+
+	if err := u.sessionRepo.DeleteByUserID(ctx, userID); err != nil {
+		return "", err
+	}
+
+	return password, nil
+}
+
+// DeleteUser はインスタンスを破棄し、正解を取り消してからアカウントを削除する
+// 正解の取り消しで後に解いた主体の順位を繰り上げ、dynamic scoring の問題は得点を再計算する
+// 運営アカウントは先に役割を外す必要がある
+func (u *AdminServiceUsecase) DeleteUser(ctx context.Context, userID string) error {
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Role.IsStaff() {
+		return domain.ErrUserIsStaff
+	}
+
+	instances, err := u.instanceRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, i := range instances {
+		if err := u.managerClient.DestroyInstance(ctx, i.InstanceID); err != nil {
+			return fmt.Errorf("failed to destroy instance: %w", err)
+		}
+	}
+
+	submissions, err := u.submissionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	solvedChallengeIDs := make(map[string]bool)
+	now := time.Now()
+	for _, s := range submissions {
+		if !s.IsCorrect {
+			continue
+		}
+		if err := u.submissionRepo.Invalidate(ctx, s.SubmissionID, now); err != nil {
+			return err
+		}
+		if s.PartID == "" {
+			solvedChallengeIDs[s.ChallengeID] = true
+		}
+	}
+
+	if err := u.userRepo.Delete(ctx, userID); err != nil {
+		return err
+	}
+
+	return u.recalculateChallenges(ctx, solvedChallengeIDs)
+}
+
+// generateTemporaryPassword は運営が再設定したときに渡す推測されにくいパスワードを返す
+func generateTemporaryPassword() (string, error) {
+	token, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+	return token[:16], nil
+}
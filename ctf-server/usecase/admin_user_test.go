@@ -0,0 +1,380 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MockInstanceRepository struct {
+	instances map[string]*domain.Instance
+}
+
+func NewMockInstanceRepository() *MockInstanceRepository {
+	return &MockInstanceRepository{
+		instances: make(map[string]*domain.Instance),
+	}
+}
+
+func (m *MockInstanceRepository) Create(ctx context.Context, instance *domain.Instance) error {
+	m.instances[instance.InstanceID] = instance
+	return nil
+}
+
+func (m *MockInstanceRepository) FindByID(ctx context.Context, instanceID string) (*domain.Instance, error) {
+	instance, ok := m.instances[instanceID]
+	if !ok {
+		return nil, domain.ErrInstanceNotFound
+	}
+	return instance, nil
+}
+
+func (m *MockInstanceRepository) FindByUserAndChallenge(ctx context.Context, userID, challengeID string) (*domain.Instance, error) {
+	for _, instance := range m.instances {
+		if instance.UserID == userID && instance.ChallengeID == challengeID && instance.Status != domain.InstanceStatusDestroyed {
+			return instance, nil
+		}
+	}
+	return nil, domain.ErrInstanceNotFound
+}
+
+func (m *MockInstanceRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.Instance, error) {
+	var result []*domain.Instance
+	for _, instance := range m.instances {
+		if instance.UserID == userID && instance.Status != domain.InstanceStatusDestroyed {
+			result = append(result, instance)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockInstanceRepository) Update(ctx context.Context, instance *domain.Instance) error {
+	if _, ok := m.instances[instance.InstanceID]; !ok {
+		return domain.ErrInstanceNotFound
+	}
+	m.instances[instance.InstanceID] = instance
+	return nil
+}
+
+func (m *MockInstanceRepository) Delete(ctx context.Context, instanceID string) error {
+	if _, ok := m.instances[instanceID]; !ok {
+		return domain.ErrInstanceNotFound
+	}
+	delete(m.instances, instanceID)
+	return nil
+}
+
+func TestAdminServiceUsecase_ListUsers(t *testing.T) {
+	ctx := context.Background()
+	base := time.Now().Truncate(time.Second)
+
+	userRepo := NewMockUserRepository()
+	// user1 と user2 は同じ時刻に登録されたものとする
+	offsets := []int{0, 1, 1, 2, 3}
+	for i, offset := range offsets {
+		userRepo.Create(ctx, &domain.User{
+			UserID:    fmt.Sprintf("u%d", i),
+			Username:  fmt.Sprintf("user%d", i),
+			CreatedAt: base.Add(time.Duration(offset) * time.Second),
+		})
+	}
+	userRepo.Create(ctx, &domain.User{UserID: "root", Username: "root", CreatedAt: base})
+
+	uc := &AdminServiceUsecase{userRepo: userRepo}
+
+	var got []string
+	cursor := ""
+	for page := 0; page < 10; page++ {
+		result, err := uc.ListUsers(ctx, &domain.UserFilter{Query: "user", Limit: 2}, cursor)
+		if err != nil {
+			t.Fatalf("ListUsers() error = %v", err)
+		}
+		if len(result.Users) > 2 {
+			t.Fatalf("ListUsers() returned %d users, want at most 2", len(result.Users))
+		}
+		for _, u := range result.Users {
+			got = append(got, u.UserID)
+		}
+		if result.NextCursor == "" {
+			break
+		}
+		cursor = result.NextCursor
+	}
+
+	if want := []string{"u4", "u3", "u2", "u1", "u0"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("ListUsers() = %v, want %v", got, want)
+	}
+
+	if _, err := uc.ListUsers(ctx, &domain.UserFilter{}, "!!!"); err != domain.ErrInvalidCursor {
+		t.Errorf("ListUsers() error = %v, want %v", err, domain.ErrInvalidCursor)
+	}
+}
+
+func TestAdminServiceUsecase_BanUser(t *testing.T) {
+	ctx := context.Background()
+	userRepo := NewMockUserRepository()
+	sessionRepo := NewMockSessionRepository()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	submissionRepo.userRepo = userRepo
+	submissionRepo.challengeRepo = challengeRepo
+	auth := NewUserAuthUsecase(userRepo, sessionRepo)
+
+	userID, err := auth.Register(ctx, "player", "password123")
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	token, err := auth.Login(ctx, "player", "password123")
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	userRepo.Create(ctx, &domain.User{UserID: "staff", Username: "staff", Role: domain.RoleSupport})
+
+	challengeRepo.Create(ctx, &domain.Challenge{
+		ChallengeID:   "1",
+		Name:          "warmup",
+		Flag:          "flag{dynamic}",
+		Points:        500,
+		ScoringType:   domain.ScoringTypeDynamic,
+		InitialPoints: 500,
+		MinimumPoints: 100,
+		Decay:         2,
+	})
+	client := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+	}
+	userRepo.Create(ctx, &domain.User{UserID: "other", Username: "other"})
+	for _, id := range []string{"other", userID} {
+		if _, _, err := client.SubmitFlag(ctx, id, "1", "flag{dynamic}"); err != nil {
+			t.Fatalf("SubmitFlag() error = %v", err)
+		}
+	}
+	solvedPoints, _ := challengeRepo.FindByID(ctx, "1")
+	if solvedPoints.Points == 500 {
+		t.Fatalf("challenge Points after 2 solves = %v, want less than 500", solvedPoints.Points)
+	}
+	pointsAfterSolve := solvedPoints.Points
+
+	uc := &AdminServiceUsecase{
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+	}
+
+	if err := uc.BanUser(ctx, "staff"); err != domain.ErrUserIsStaff {
+		t.Errorf("BanUser() staff error = %v, want %v", err, domain.ErrUserIsStaff)
+	}
+
+	if err := uc.BanUser(ctx, userID); err != nil {
+		t.Fatalf("BanUser() error = %v", err)
+	}
+	if _, err := auth.ValidateToken(ctx, token); err != domain.ErrSessionNotFound {
+		t.Errorf("ValidateToken() after ban error = %v, want %v", err, domain.ErrSessionNotFound)
+	}
+	if _, err := auth.Login(ctx, "player", "password123"); err != domain.ErrUserBanned {
+		t.Errorf("Login() after ban error = %v, want %v", err, domain.ErrUserBanned)
+	}
+	// 利用停止中のユーザーの正解は動的配点の解答数に含めない
+	if updated, _ := challengeRepo.FindByID(ctx, "1"); updated.Points != 500 {
+		t.Errorf("challenge Points after ban = %v, want 500", updated.Points)
+	}
+
+	if err := uc.UnbanUser(ctx, userID); err != nil {
+		t.Fatalf("UnbanUser() error = %v", err)
+	}
+	if _, err := auth.Login(ctx, "player", "password123"); err != nil {
+		t.Errorf("Login() after unban error = %v", err)
+	}
+	if updated, _ := challengeRepo.FindByID(ctx, "1"); updated.Points != pointsAfterSolve {
+		t.Errorf("challenge Points after unban = %v, want %v", updated.Points, pointsAfterSolve)
+	}
+}
+
+func TestAdminServiceUsecase_BanUser_SolveRanks(t *testing.T) {
+	ctx := context.Background()
+	userRepo := NewMockUserRepository()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	submissionRepo.userRepo = userRepo
+	submissionRepo.challengeRepo = challengeRepo
+
+	challengeRepo.Create(ctx, &domain.Challenge{
+		ChallengeID:  "1",
+		Flag:         "flag{blood}",
+		Points:       100,
+		BloodBonuses: []int{30, 20, 10},
+	})
+	client := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+	}
+	uc := &AdminServiceUsecase{
+		userRepo:       userRepo,
+		sessionRepo:    NewMockSessionRepository(),
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+	}
+
+	for _, userID := range []string{"user1", "user2"} {
+		userRepo.Create(ctx, &domain.User{UserID: userID, Username: userID})
+		if _, _, err := client.SubmitFlag(ctx, userID, "1", "flag{blood}"); err != nil {
+			t.Fatalf("SubmitFlag() error = %v", err)
+		}
+	}
+
+	rankOf := func(userID string) int {
+		submissions, _ := submissionRepo.FindByUserAndChallenge(ctx, userID, "1")
+		for _, s := range submissions {
+			if s.IsSolve() {
+				return s.SolveRank
+			}
+		}
+		return 0
+	}
+
+	if err := uc.BanUser(ctx, "user1"); err != nil {
+		t.Fatalf("BanUser() error = %v", err)
+	}
+	// 利用停止中のユーザーは順位を持たず、次に解いたユーザーが1番目になる
+	if rank := rankOf("user1"); rank != 0 {
+		t.Errorf("banned user rank = %v, want 0", rank)
+	}
+	if rank := rankOf("user2"); rank != 1 {
+		t.Errorf("user2 rank after ban = %v, want 1", rank)
+	}
+
+	userRepo.Create(ctx, &domain.User{UserID: "user3", Username: "user3"})
+	_, points, err := client.SubmitFlag(ctx, "user3", "1", "flag{blood}")
+	if err != nil {
+		t.Fatalf("SubmitFlag() error = %v", err)
+	}
+	if points != 120 {
+		t.Errorf("SubmitFlag() after ban points = %v, want 120", points)
+	}
+
+	if err := uc.UnbanUser(ctx, "user1"); err != nil {
+		t.Fatalf("UnbanUser() error = %v", err)
+	}
+	for userID, want := range map[string]int{"user1": 1, "user2": 2, "user3": 3} {
+		if rank := rankOf(userID); rank != want {
+			t.Errorf("%s rank after unban = %v, want %v", userID, rank, want)
+		}
+	}
+}
+
+func TestAdminServiceUsecase_ResetUserPassword(t *testing.T) {
+	ctx := context.Background()
+	userRepo := NewMockUserRepository()
+	sessionRepo := NewMockSessionRepository()
+	auth := NewUserAuthUsecase(userRepo, sessionRepo)
+
+	userID, _ := auth.Register(ctx, "player", "password123")
+	token, _ := auth.Login(ctx, "player", "password123")
+
+	uc := &AdminServiceUsecase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+	}
+
+	password, err := uc.ResetUserPassword(ctx, userID)
+	if err != nil {
+		t.Fatalf("ResetUserPassword() error = %v", err)
+	}
+	if password == "" || password == "password123" {
+		t.Errorf("ResetUserPassword() = %q, want a new password", password)
+	}
+
+	if _, err := auth.ValidateToken(ctx, token); err != domain.ErrSessionNotFound {
+		t.Errorf("ValidateToken() after reset error = %v, want %v", err, domain.ErrSessionNotFound)
+	}
+	if _, err := auth.Login(ctx, "player", "password123"); err != domain.ErrInvalidPassword {
+		t.Errorf("Login() with old password error = %v, want %v", err, domain.ErrInvalidPassword)
+	}
+	if _, err := auth.Login(ctx, "player", password); err != nil {
+		t.Errorf("Login() with temporary password error = %v", err)
+	}
+
+	if _, err := uc.ResetUserPassword(ctx, "unknown"); err != domain.ErrUserNotFound {
+		t.Errorf("ResetUserPassword() unknown user error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+}
+
+func TestAdminServiceUsecase_DeleteUser(t *testing.T) {
+	ctx := context.Background()
+	userRepo := NewMockUserRepository()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	submissionRepo.challengeRepo = challengeRepo
+
+	for _, userID := range []string{"user1", "user2"} {
+		userRepo.Create(ctx, &domain.User{UserID: userID, Username: userID})
+	}
+	userRepo.Create(ctx, &domain.User{UserID: "staff", Username: "staff", Role: domain.RoleAuthor})
+
+	challengeRepo.Create(ctx, &domain.Challenge{
+		ChallengeID:   "1",
+		Name:          "warmup",
+		Flag:          "flag{dynamic}",
+		Points:        500,
+		ScoringType:   domain.ScoringTypeDynamic,
+		InitialPoints: 500,
+		MinimumPoints: 100,
+		Decay:         2,
+	})
+
+	client := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+	}
+	for _, userID := range []string{"user1", "user2"} {
+		if _, _, err := client.SubmitFlag(ctx, userID, "1", "flag{dynamic}"); err != nil {
+			t.Fatalf("SubmitFlag() error = %v", err)
+		}
+	}
+
+	uc := &AdminServiceUsecase{
+		userRepo:       userRepo,
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		instanceRepo:   NewMockInstanceRepository(),
+	}
+
+	detail, err := uc.GetUser(ctx, "user1")
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if len(detail.Solves) != 1 || detail.ChallengeNames["1"] != "warmup" {
+		t.Errorf("GetUser() = %+v, want 1 solve of warmup", detail)
+	}
+
+	if err := uc.DeleteUser(ctx, "staff"); err != domain.ErrUserIsStaff {
+		t.Errorf("DeleteUser() staff error = %v, want %v", err, domain.ErrUserIsStaff)
+	}
+
+	if err := uc.DeleteUser(ctx, "user1"); err != nil {
+		t.Fatalf("DeleteUser() error = %v", err)
+	}
+	if _, err := userRepo.FindByID(ctx, "user1"); err != domain.ErrUserNotFound {
+		t.Errorf("FindByID() after delete error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+
+	solves, _ := submissionRepo.FindByUserAndChallenge(ctx, "user2", "1")
+	if rank := solves[0].SolveRank; rank != 1 {
+		t.Errorf("second solver rank = %v, want 1", rank)
+	}
+	if updated, _ := challengeRepo.FindByID(ctx, "1"); updated.Points != 500 {
+		t.Errorf("challenge Points = %v, want 500", updated.Points)
+	}
+
+	if err := uc.DeleteUser(ctx, "user1"); err != domain.ErrUserNotFound {
+		t.Errorf("DeleteUser() twice error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+}
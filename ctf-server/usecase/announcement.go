@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+// GetAnnouncements はお知らせを新しい順に返す。非公開の問題に関するお知らせは含めない
+func (u *ClientChallengeUsecase) GetAnnouncements(ctx context.Context) ([]*domain.Announcement, error) {
+	announcements, err := u.announcementRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]*domain.Announcement, 0, len(announcements))
+	for _, a := range announcements {
+		if a.ChallengeID != "" {
+			if _, err := u.findReleasedChallenge(ctx, a.ChallengeID); err == domain.ErrChallengeNotFound {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		visible = append(visible, a)
+	}
+
+	return visible, nil
+}
+
+// CreateAnnouncement はお知らせを作成して配信する
+// 非公開の問題に関するお知らせは、問題の存在が知られないよう配信しない
+func (u *AdminServiceUsecase) CreateAnnouncement(ctx context.Context, challengeID, title, content string) (string, error) {
+	var challenge *domain.Challenge
+	if challengeID != "" {
+		c, err := u.challengeRepo.FindByID(ctx, challengeID)
+		if err != nil {
+			return "", err
+		}
+		challenge = c
+	}
+
+	now := time.Now()
+	announcement := &domain.Announcement{
+		AnnouncementID: uuid.New().String(),
+		ChallengeID:    challengeID,
+		Title:          title,
+		Content:        content,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := announcement.Validate(); err != nil {
+		return "", err
+	}
+
+	if err := u.announcementRepo.Create(ctx, announcement); err != nil {
+		return "", err
+	}
+
+	if challenge == nil || challenge.IsReleased(now) {
+		event := &domain.LiveEvent{
+			Type:       domain.LiveEventAnnouncement,
+			Message:    announcement.Title,
+			OccurredAt: now,
+		}
+		if challenge != nil {
+			event.ChallengeID = challenge.ChallengeID
+			event.ChallengeName = challenge.Name
+		}
+		publishEvent(ctx, u.eventHub, event)
+	}
+
+	return announcement.AnnouncementID, nil
+}
+
+// UpdateAnnouncement はお知らせの対象の問題・タイトル・内容を更新する
+func (u *AdminServiceUsecase) UpdateAnnouncement(ctx context.Context, announcement *domain.Announcement) error {
+	existing, err := u.announcementRepo.FindByID(ctx, announcement.AnnouncementID)
+	if err != nil {
+		return err
+	}
+
+	if announcement.ChallengeID != "" {
+		if _, err := u.challengeRepo.FindByID(ctx, announcement.ChallengeID); err != nil {
+			return err
+		}
+	}
+
+	existing.ChallengeID = announcement.ChallengeID
+	existing.Title = announcement.Title
+	existing.Content = announcement.Content
+	existing.UpdatedAt = time.Now()
+	if err := existing.Validate(); err != nil {
+		return err
+	}
+
+	return u.announcementRepo.Update(ctx, existing)
+}
+
+func (u *AdminServiceUsecase) DeleteAnnouncement(ctx context.Context, announcementID string) error {
+	return u.announcementRepo.Delete(ctx, announcementID)
+}
+
+func (u *AdminServiceUsecase) ListAnnouncements(ctx context.Context) ([]*domain.Announcement, error) {
+	return u.announcementRepo.FindAll(ctx)
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MockAnnouncementRepository struct {
+	announcements map[string]*domain.Announcement
+}
+
+func NewMockAnnouncementRepository() *MockAnnouncementRepository {
+	return &MockAnnouncementRepository{
+		announcements: make(map[string]*domain.Announcement),
+	}
+}
+
+func (m *MockAnnouncementRepository) Create(ctx context.Context, announcement *domain.Announcement) error {
+	m.announcements[announcement.AnnouncementID] = announcement
+	return nil
+}
+
+func (m *MockAnnouncementRepository) FindByID(ctx context.Context, announcementID string) (*domain.Announcement, error) {
+	announcement, ok := m.announcements[announcementID]
+	if !ok {
+		return nil, domain.ErrAnnouncementNotFound
+	}
+	return announcement, nil
+}
+
+func (m *MockAnnouncementRepository) FindAll(ctx context.Context) ([]*domain.Announcement, error) {
+	var result []*domain.Announcement
+	for _, a := range m.announcements {
+		result = append(result, a)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func (m *MockAnnouncementRepository) Update(ctx context.Context, announcement *domain.Announcement) error {
+	if _, ok := m.announcements[announcement.AnnouncementID]; !ok {
+		return domain.ErrAnnouncementNotFound
+	}
+	m.announcements[announcement.AnnouncementID] = announcement
+	return nil
+}
+
+func (m *MockAnnouncementRepository) Delete(ctx context.Context, announcementID string) error {
+	if _, ok := m.announcements[announcementID]; !ok {
+		return domain.ErrAnnouncementNotFound
+	}
+	delete(m.announcements, announcementID)
+	return nil
+}
+
+func TestAdminServiceUsecase_CreateAnnouncement(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "visible", Name: "warmup", Flag: "flag{a}"})
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "draft", Name: "secret", Flag: "flag{b}", Visibility: domain.VisibilityDraft})
+
+	tests := []struct {
+		name        string
+		challengeID string
+		title       string
+		wantErr     error
+		wantEvent   bool
+	}{
+		{name: "event-wide announcement", title: "welcome", wantEvent: true},
+		{name: "announcement for a released challenge", challengeID: "visible", title: "fixed", wantEvent: true},
+		{name: "announcement for a draft challenge is not published", challengeID: "draft", title: "fixed", wantEvent: false},
+		{name: "unknown challenge", challengeID: "missing", title: "fixed", wantErr: domain.ErrChallengeNotFound},
+		{name: "empty title", title: "", wantErr: domain.ErrInvalidAnnouncementData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			announcementRepo := NewMockAnnouncementRepository()
+			hub := &MockEventHub{}
+			uc := &AdminServiceUsecase{
+				challengeRepo:    challengeRepo,
+				announcementRepo: announcementRepo,
+				eventHub:         hub,
+			}
+
+			id, err := uc.CreateAnnouncement(ctx, tt.challengeID, tt.title, "body")
+			if err != tt.wantErr {
+				t.Fatalf("CreateAnnouncement() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if _, err := announcementRepo.FindByID(ctx, id); err != nil {
+				t.Errorf("CreateAnnouncement() did not store the announcement: %v", err)
+			}
+
+			if got := len(hub.events) == 1; got != tt.wantEvent {
+				t.Fatalf("CreateAnnouncement() published %d events, want event = %v", len(hub.events), tt.wantEvent)
+			}
+			if tt.wantEvent {
+				e := hub.events[0]
+				if e.Type != domain.LiveEventAnnouncement || e.Message != tt.title || e.ChallengeID != tt.challengeID {
+					t.Errorf("CreateAnnouncement() event = %+v", e)
+				}
+			}
+		})
+	}
+}
+
+func TestClientChallengeUsecase_GetAnnouncements(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	challengeRepo := NewMockChallengeRepository()
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "visible", Flag: "flag{a}"})
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "draft", Flag: "flag{b}", Visibility: domain.VisibilityDraft})
+
+	announcementRepo := NewMockAnnouncementRepository()
+	announcementRepo.Create(ctx, &domain.Announcement{AnnouncementID: "global", Title: "welcome", Content: "hi", CreatedAt: now.Add(-2 * time.Minute)})
+	announcementRepo.Create(ctx, &domain.Announcement{AnnouncementID: "scoped", ChallengeID: "visible", Title: "fixed", Content: "typo", CreatedAt: now.Add(-time.Minute)})
+	announcementRepo.Create(ctx, &domain.Announcement{AnnouncementID: "hidden", ChallengeID: "draft", Title: "soon", Content: "wip", CreatedAt: now})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:    challengeRepo,
+		announcementRepo: announcementRepo,
+	}
+
+	announcements, err := uc.GetAnnouncements(ctx)
+	if err != nil {
+		t.Fatalf("GetAnnouncements() error = %v", err)
+	}
+
+	var got []string
+	for _, a := range announcements {
+		got = append(got, a.AnnouncementID)
+	}
+	want := []string{"scoped", "global"}
+	if len(got) != len(want) {
+		t.Fatalf("GetAnnouncements() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("GetAnnouncements()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
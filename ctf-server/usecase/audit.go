@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+// AuditUsecase は管理画面での変更操作を監査ログに記録する
+type AuditUsecase struct {
+	auditRepo        domain.AuditRepository
+	challengeRepo    domain.ChallengeRepository
+	hintRepo         domain.HintRepository
+	announcementRepo domain.AnnouncementRepository
+	eventRepo        domain.EventConfigRepository
+	submissionRepo   domain.SubmissionRepository
+	userRepo         domain.UserRepository
+}
+
+func NewAuditUsecase(
+	auditRepo domain.AuditRepository,
+	challengeRepo domain.ChallengeRepository,
+	hintRepo domain.HintRepository,
+	announcementRepo domain.AnnouncementRepository,
+	eventRepo domain.EventConfigRepository,
+	submissionRepo domain.SubmissionRepository,
+	userRepo domain.UserRepository,
+) *AuditUsecase {
+	return &AuditUsecase{
+		auditRepo:        auditRepo,
+		challengeRepo:    challengeRepo,
+		hintRepo:         hintRepo,
+		announcementRepo: announcementRepo,
+		eventRepo:        eventRepo,
+		submissionRepo:   submissionRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// Record は event に ID と操作者の名前を設定して記録する。長すぎる文字列は切り詰める
+func (u *AuditUsecase) Record(ctx context.Context, event *domain.AuditEvent) error {
+	event.AuditEventID = uuid.New().String()
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	if user, err := u.userRepo.FindByID(ctx, event.ActorID); err == nil {
+		event.ActorName = user.Username
+	}
+	event.Truncate()
+
+	return u.auditRepo.Create(ctx, event)
+}
+
+// FindUserID は username のユーザーIDを返す。存在しない場合は空文字列
+func (u *AuditUsecase) FindUserID(ctx context.Context, username string) string {
+	user, err := u.userRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return ""
+	}
+	return user.UserID
+}
+
+// Snapshot は監査対象の現在の状態を返す。対象が存在しない場合は nil を返す
+// イベント設定は1つしかないため targetID を使わない
+func (u *AuditUsecase) Snapshot(ctx context.Context, targetType domain.AuditTargetType, targetID string) (domain.AuditSnapshot, error) {
+	if targetID == "" && targetType != domain.AuditTargetEventConfig {
+		return nil, nil
+	}
+
+	switch targetType {
+	case domain.AuditTargetChallenge:
+		challenge, err := u.challengeRepo.FindByID(ctx, targetID)
+		if err == domain.ErrChallengeNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return challengeSnapshot(challenge), nil
+
+	case domain.AuditTargetHint:
+		hint, err := u.hintRepo.FindByID(ctx, targetID)
+		if err == domain.ErrHintNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return domain.AuditSnapshot{
+			"challenge_id": hint.ChallengeID,
+			"content":      hint.Content,
+			"cost":         strconv.Itoa(hint.Cost),
+			"position":     strconv.Itoa(hint.Position),
+		}, nil
+
+	case domain.AuditTargetAnnouncement:
+		announcement, err := u.announcementRepo.FindByID(ctx, targetID)
+		if err == domain.ErrAnnouncementNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return domain.AuditSnapshot{
+			"challenge_id": announcement.ChallengeID,
+			"title":        announcement.Title,
+			"content":      announcement.Content,
+		}, nil
+
+	case domain.AuditTargetEventConfig:
+		config, err := u.eventRepo.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return domain.AuditSnapshot{
+			"start_at":  formatAuditTime(config.StartAt),
+			"end_at":    formatAuditTime(config.EndAt),
+			"freeze_at": formatAuditTime(config.FreezeAt),
+		}, nil
+
+	case domain.AuditTargetSubmission:
+		submission, err := u.submissionRepo.FindByID(ctx, targetID)
+		if err == domain.ErrSubmissionNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return domain.AuditSnapshot{
+			"user_id":        submission.UserID,
+			"challenge_id":   submission.ChallengeID,
+			"is_correct":     strconv.FormatBool(submission.IsCorrect),
+			"solve_rank":     strconv.Itoa(submission.SolveRank),
+			"invalidated_at": formatAuditTime(submission.InvalidatedAt),
+		}, nil
+
+	case domain.AuditTargetUser:
+		user, err := u.userRepo.FindByID(ctx, targetID)
+		if err == domain.ErrUserNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return domain.AuditSnapshot{
+			"username":      user.Username,
+			"role":          string(user.Role),
+			"banned_at":     formatAuditTime(user.BannedAt),
+			"password_hash": user.PasswordHash,
+		}, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func challengeSnapshot(c *domain.Challenge) domain.AuditSnapshot {
+	bonuses := make([]string, 0, len(c.BloodBonuses))
+	for _, b := range c.BloodBonuses {
+		bonuses = append(bonuses, strconv.Itoa(b))
+	}
+	parts := make([]string, 0, len(c.Parts))
+	partFlags := make([]string, 0, len(c.Parts))
+	for _, p := range c.Parts {
+		parts = append(parts, p.Name+":"+strconv.Itoa(p.Points))
+		partFlags = append(partFlags, p.Flag)
+	}
+	attachments := make([]string, 0, len(c.Attachments))
+	for _, a := range c.Attachments {
+		attachments = append(attachments, a.Filename)
+	}
+
+	return domain.AuditSnapshot{
+		"name":              c.Name,
+		"description":       c.Description,
+		"flag":              c.Flag,
+		"accepted_flags":    strings.Join(c.AcceptedFlags, "\n"),
+		"flag_match_mode":   string(c.FlagMatchMode),
+		"dynamic_flag":      strconv.FormatBool(c.DynamicFlag),
+		"points":            strconv.Itoa(c.Points),
+		"genre":             c.Genre,
+		"requires_instance": strconv.FormatBool(c.RequiresInstance),
+		"scoring_type":      string(c.ScoringType),
+		"initial_points":    strconv.Itoa(c.InitialPoints),
+		"minimum_points":    strconv.Itoa(c.MinimumPoints),
+		"decay":             strconv.Itoa(c.Decay),
+		"prerequisites":     strings.Join(c.Prerequisites, ","),
+		"prerequisite_mode": string(c.PrerequisiteMode),
+		"visibility":        string(c.Visibility),
+		"release_at":        formatAuditTime(c.ReleaseAt),
+		"blood_bonuses":     strings.Join(bonuses, ","),
+		"parts":             strings.Join(parts, ","),
+		"part_flags":        strings.Join(partFlags, "\n"),
+		"attachments":       strings.Join(attachments, ","),
+		"author_id":         c.AuthorID,
+	}
+}
+
+func formatAuditTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func (u *AuditUsecase) ListAuditEvents(ctx context.Context, filter *domain.AuditEventFilter, cursor string) (*domain.AuditEventPage, error) {
+	events, next, err := domain.FetchPage(cursor, filter.Limit, domain.DecodeAuditEventCursor, domain.NewAuditEventCursor,
+		func(after *domain.AuditEventCursor, limit int) ([]*domain.AuditEvent, error) {
+			filter.After = after
+			filter.Limit = limit
+			return u.auditRepo.FindEvents(ctx, filter)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AuditEventPage{Events: events, NextCursor: next}, nil
+}
@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MockAuditRepository struct {
+	events []*domain.AuditEvent
+}
+
+func NewMockAuditRepository() *MockAuditRepository {
+	return &MockAuditRepository{}
+}
+
+func (m *MockAuditRepository) Create(ctx context.Context, event *domain.AuditEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *MockAuditRepository) FindEvents(ctx context.Context, filter *domain.AuditEventFilter) ([]*domain.AuditEvent, error) {
+	var result []*domain.AuditEvent
+	for _, e := range m.events {
+		if filter.ActorID != "" && e.ActorID != filter.ActorID {
+			continue
+		}
+		if filter.Action != "" && e.Action != filter.Action {
+			continue
+		}
+		if filter.TargetType != "" && e.TargetType != filter.TargetType {
+			continue
+		}
+		if filter.TargetID != "" && e.TargetID != filter.TargetID {
+			continue
+		}
+		if filter.After != nil && !isOlderAuditEvent(e, filter.After) {
+			continue
+		}
+		result = append(result, e)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return isOlderAuditEvent(result[j], domain.NewAuditEventCursor(result[i]))
+	})
+	if len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+	return result, nil
+}
+
+func isOlderAuditEvent(e *domain.AuditEvent, cursor *domain.AuditEventCursor) bool {
+	if !e.OccurredAt.Equal(cursor.OccurredAt) {
+		return e.OccurredAt.Before(cursor.OccurredAt)
+	}
+	return e.AuditEventID < cursor.AuditEventID
+}
+
+func TestAuditUsecase_ListAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	base := time.Now().Truncate(time.Second)
+
+	auditRepo := NewMockAuditRepository()
+	userRepo := NewMockUserRepository()
+	userRepo.Create(ctx, &domain.User{UserID: "root", Username: "root", Role: domain.RoleSuperadmin})
+
+	uc := &AuditUsecase{auditRepo: auditRepo, userRepo: userRepo}
+
+	// c1 と c2 は同じ時刻に記録されたものとする
+	offsets := []int{0, 1, 1, 2, 3}
+	for i, offset := range offsets {
+		actorID := "root"
+		if i == 3 {
+			actorID = "someone"
+		}
+		event := &domain.AuditEvent{
+			ActorID:    actorID,
+			Action:     "UpdateChallenge",
+			TargetType: domain.AuditTargetChallenge,
+			TargetID:   fmt.Sprintf("c%d", i),
+			OccurredAt: base.Add(time.Duration(offset) * time.Second),
+		}
+		if err := uc.Record(ctx, event); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	if got := auditRepo.events[0].ActorName; got != "root" {
+		t.Errorf("Record() ActorName = %q, want root", got)
+	}
+
+	var got []string
+	cursor := ""
+	for page := 0; page < 10; page++ {
+		result, err := uc.ListAuditEvents(ctx, &domain.AuditEventFilter{ActorID: "root", Limit: 2}, cursor)
+		if err != nil {
+			t.Fatalf("ListAuditEvents() error = %v", err)
+		}
+		if len(result.Events) > 2 {
+			t.Fatalf("ListAuditEvents() returned %d events, want at most 2", len(result.Events))
+		}
+		for _, e := range result.Events {
+			got = append(got, e.TargetID)
+		}
+		if result.NextCursor == "" {
+			break
+		}
+		cursor = result.NextCursor
+	}
+
+	if len(got) != 4 {
+		t.Fatalf("ListAuditEvents() = %v, want 4 events", got)
+	}
+	// 同じ時刻の c1 と c2 の順序はランダムな ID で決まる
+	sort.Strings(got[1:3])
+	if want := []string{"c4", "c1", "c2", "c0"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("ListAuditEvents() = %v, want %v", got, want)
+	}
+
+	if _, err := uc.ListAuditEvents(ctx, &domain.AuditEventFilter{}, "!!!"); err != domain.ErrInvalidCursor {
+		t.Errorf("ListAuditEvents() error = %v, want %v", err, domain.ErrInvalidCursor)
+	}
+}
+
+func TestAuditUsecase_Snapshot_RedactsFlags(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "1", Name: "warmup", Flag: "flag{old}", Points: 100})
+
+	uc := &AuditUsecase{challengeRepo: challengeRepo}
+
+	before, err := uc.Snapshot(ctx, domain.AuditTargetChallenge, "1")
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	updated, _ := challengeRepo.FindByID(ctx, "1")
+	updated.Flag = "flag{new}"
+	updated.Points = 200
+
+	after, err := uc.Snapshot(ctx, domain.AuditTargetChallenge, "1")
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	changes := domain.DiffAuditSnapshots(before, after)
+	if len(changes) != 2 {
+		t.Fatalf("DiffAuditSnapshots() = %d changes, want 2", len(changes))
+	}
+	for _, c := range changes {
+		if strings.Contains(c.Before+c.After, "flag{") {
+			t.Errorf("change %+v leaks the flag", c)
+		}
+	}
+
+	missing, err := uc.Snapshot(ctx, domain.AuditTargetChallenge, "missing")
+	if err != nil || missing != nil {
+		t.Errorf("Snapshot() missing = %v, %v, want nil, nil", missing, err)
+	}
+}
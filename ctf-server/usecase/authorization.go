@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+// Authorizer は管理画面の操作ごとに、セッションのユーザーの役割が必要な権限を持つかを確認する
+// 役割は毎回読み直すため、変更は既存のセッションにもすぐに反映される
+type Authorizer struct {
+	userRepo      domain.UserRepository
+	challengeRepo domain.ChallengeRepository
+	hintRepo      domain.HintRepository
+}
+
+func NewAuthorizer(userRepo domain.UserRepository, challengeRepo domain.ChallengeRepository, hintRepo domain.HintRepository) *Authorizer {
+	return &Authorizer{
+		userRepo:      userRepo,
+		challengeRepo: challengeRepo,
+		hintRepo:      hintRepo,
+	}
+}
+
+// Authorize は管理者モードのセッションのユーザーが permission を持つ場合にそのユーザーを返す
+func (a *Authorizer) Authorize(ctx context.Context, session *domain.Session, permission domain.Permission) (*domain.User, error) {
+	if !session.IsAdmin {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	user, err := a.userRepo.FindByID(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.Role.HasPermission(permission) {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	return user, nil
+}
+
+// AuthorizeChallenge はセッションのユーザーが問題を編集できるかを確認する
+func (a *Authorizer) AuthorizeChallenge(ctx context.Context, session *domain.Session, challengeID string) (*domain.User, error) {
+	user, err := a.Authorize(ctx, session, domain.PermissionManageOwnChallenges)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := a.challengeRepo.FindByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.CanManageChallenge(challenge) {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	return user, nil
+}
+
+// AuthorizeHint はヒントが属する問題をセッションのユーザーが編集できるかを確認する
+// 権限の確認は AuthorizeChallenge に任せ、ユーザーの読み込みを1回にする
+func (a *Authorizer) AuthorizeHint(ctx context.Context, session *domain.Session, hintID string) (*domain.User, error) {
+	hint, err := a.hintRepo.FindByID(ctx, hintID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.AuthorizeChallenge(ctx, session, hint.ChallengeID)
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+func TestAuthorizer(t *testing.T) {
+	ctx := context.Background()
+
+	userRepo := NewMockUserRepository()
+	userRepo.Create(ctx, &domain.User{UserID: "root", Username: "root", Role: domain.RoleSuperadmin})
+	userRepo.Create(ctx, &domain.User{UserID: "alice", Username: "alice", Role: domain.RoleAuthor})
+	userRepo.Create(ctx, &domain.User{UserID: "bob", Username: "bob", Role: domain.RoleAuthor})
+	userRepo.Create(ctx, &domain.User{UserID: "carol", Username: "carol", Role: domain.RoleSupport})
+	userRepo.Create(ctx, &domain.User{UserID: "player", Username: "player"})
+
+	challengeRepo := NewMockChallengeRepository()
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "mine", Flag: "flag{a}", AuthorID: "alice"})
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "legacy", Flag: "flag{b}"})
+
+	hintRepo := NewMockHintRepository()
+	hintRepo.Create(ctx, &domain.Hint{HintID: "hint", ChallengeID: "mine"})
+
+	authorizer := NewAuthorizer(userRepo, challengeRepo, hintRepo)
+
+	tests := []struct {
+		name      string
+		userID    string
+		adminMode bool
+		authorize func(session *domain.Session) error
+		wantErr   error
+	}{
+		{
+			name:      "superadmin manages staff",
+			userID:    "root",
+			adminMode: true,
+			authorize: authorizePermission(authorizer, domain.PermissionManageStaff),
+		},
+		{
+			name:      "admin mode is required",
+			userID:    "root",
+			authorize: authorizePermission(authorizer, domain.PermissionManageStaff),
+			wantErr:   domain.ErrPermissionDenied,
+		},
+		{
+			name:      "player cannot use the admin panel",
+			userID:    "player",
+			adminMode: true,
+			authorize: authorizePermission(authorizer, domain.PermissionViewSubmissions),
+			wantErr:   domain.ErrPermissionDenied,
+		},
+		{
+			name:      "support views submissions",
+			userID:    "carol",
+			adminMode: true,
+			authorize: authorizePermission(authorizer, domain.PermissionViewSubmissions),
+		},
+		{
+			name:      "support cannot invalidate submissions",
+			userID:    "carol",
+			adminMode: true,
+			authorize: authorizePermission(authorizer, domain.PermissionManageSubmissions),
+			wantErr:   domain.ErrPermissionDenied,
+		},
+		{
+			name:      "support cannot see the live scoreboard",
+			userID:    "carol",
+			adminMode: true,
+			authorize: authorizePermission(authorizer, domain.PermissionViewLiveScoreboard),
+			wantErr:   domain.ErrPermissionDenied,
+		},
+		{
+			name:      "author cannot see the live scoreboard",
+			userID:    "alice",
+			adminMode: true,
+			authorize: authorizePermission(authorizer, domain.PermissionViewLiveScoreboard),
+			wantErr:   domain.ErrPermissionDenied,
+		},
+		{
+			name:      "support cannot ban users",
+			userID:    "carol",
+			adminMode: true,
+			authorize: authorizePermission(authorizer, domain.PermissionManageUsers),
+			wantErr:   domain.ErrPermissionDenied,
+		},
+		{
+			name:      "support cannot edit challenges",
+			userID:    "carol",
+			adminMode: true,
+			authorize: authorizeChallenge(authorizer, "mine"),
+			wantErr:   domain.ErrPermissionDenied,
+		},
+		{
+			name:      "author edits own challenge",
+			userID:    "alice",
+			adminMode: true,
+			authorize: authorizeChallenge(authorizer, "mine"),
+		},
+		{
+			name:      "author edits hints of own challenge",
+			userID:    "alice",
+			adminMode: true,
+			authorize: authorizeHint(authorizer, "hint"),
+		},
+		{
+			name:      "author cannot edit other's challenge",
+			userID:    "bob",
+			adminMode: true,
+			authorize: authorizeChallenge(authorizer, "mine"),
+			wantErr:   domain.ErrPermissionDenied,
+		},
+		{
+			name:      "author cannot edit hints of other's challenge",
+			userID:    "bob",
+			adminMode: true,
+			authorize: authorizeHint(authorizer, "hint"),
+			wantErr:   domain.ErrPermissionDenied,
+		},
+		{
+			name:      "challenge without author needs superadmin",
+			userID:    "alice",
+			adminMode: true,
+			authorize: authorizeChallenge(authorizer, "legacy"),
+			wantErr:   domain.ErrPermissionDenied,
+		},
+		{
+			name:      "superadmin edits any challenge",
+			userID:    "root",
+			adminMode: true,
+			authorize: authorizeChallenge(authorizer, "legacy"),
+		},
+		{
+			name:      "author cannot view submissions",
+			userID:    "alice",
+			adminMode: true,
+			authorize: authorizePermission(authorizer, domain.PermissionViewSubmissions),
+			wantErr:   domain.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := &domain.Session{UserID: tt.userID, IsAdmin: tt.adminMode}
+			if err := tt.authorize(session); err != tt.wantErr {
+				t.Errorf("authorize() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func authorizePermission(a *Authorizer, p domain.Permission) func(session *domain.Session) error {
+	return func(session *domain.Session) error {
+		_, err := a.Authorize(context.Background(), session, p)
+		return err
+	}
+}
+
+func authorizeChallenge(a *Authorizer, challengeID string) func(session *domain.Session) error {
+	return func(session *domain.Session) error {
+		_, err := a.AuthorizeChallenge(context.Background(), session, challengeID)
+		return err
+	}
+}
+
+func authorizeHint(a *Authorizer, hintID string) func(session *domain.Session) error {
+	return func(session *domain.Session) error {
+		_, err := a.AuthorizeHint(context.Background(), session, hintID)
+		return err
+	}
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,8 +17,19 @@ type ClientChallengeUsecase struct {
 	submissionRepo    domain.SubmissionRepository
 	instanceRepo      domain.InstanceRepository
 	attachmentRepo    domain.AttachmentRepository
+	teamRepo          domain.TeamRepository
+	eventRepo         domain.EventConfigRepository
+	issuedFlagRepo    domain.IssuedFlagRepository
+	hintRepo          domain.HintRepository
+	partSolveRepo     domain.FlagPartSolveRepository
+	announcementRepo  domain.AnnouncementRepository
+	userRepo          domain.UserRepository
+	submitLimiter     domain.RateLimiter // nilの場合は提出回数を制限しない
+	eventHub          domain.EventHub    // nilの場合はイベントを配信しない
 	managerClient     *client.ManagerClient
 	attachmentStorage *storage.AttachmentStorage
+	// teamMode が有効な場合、正解はチーム単位で扱う
+	teamMode bool
 }
 
 func NewClientChallengeUsecase(
@@ -25,6 +37,15 @@ func NewClientChallengeUsecase(
 	submissionRepo domain.SubmissionRepository,
 	instanceRepo domain.InstanceRepository,
 	attachmentRepo domain.AttachmentRepository,
+	teamRepo domain.TeamRepository,
+	eventRepo domain.EventConfigRepository,
+	issuedFlagRepo domain.IssuedFlagRepository,
+	hintRepo domain.HintRepository,
+	partSolveRepo domain.FlagPartSolveRepository,
+	announcementRepo domain.AnnouncementRepository,
+	userRepo domain.UserRepository,
+	submitLimiter domain.RateLimiter,
+	eventHub domain.EventHub,
 	managerClient *client.ManagerClient,
 	attachmentStorage *storage.AttachmentStorage,
 ) *ClientChallengeUsecase {
@@ -33,65 +54,455 @@ func NewClientChallengeUsecase(
 		submissionRepo:    submissionRepo,
 		instanceRepo:      instanceRepo,
 		attachmentRepo:    attachmentRepo,
+		teamRepo:          teamRepo,
+		eventRepo:         eventRepo,
+		issuedFlagRepo:    issuedFlagRepo,
+		hintRepo:          hintRepo,
+		partSolveRepo:     partSolveRepo,
+		announcementRepo:  announcementRepo,
+		userRepo:          userRepo,
+		submitLimiter:     submitLimiter,
+		eventHub:          eventHub,
 		managerClient:     managerClient,
 		attachmentStorage: attachmentStorage,
+		teamMode:          os.Getenv("TEAM_MODE") == "true",
 	}
 }
 
-func (u *ClientChallengeUsecase) GetChallenges(ctx context.Context) ([]*domain.Challenge, error) {
+// GetChallenges は公開中の問題一覧を正解状況とともに返す。前提問題を解いていない問題はロックし、説明と添付ファイルを隠す
+func (u *ClientChallengeUsecase) GetChallenges(ctx context.Context, userID string) ([]*domain.Challenge, error) {
+	event, err := u.eventRepo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !event.HasStarted(time.Now()) {
+		return nil, domain.ErrEventNotStarted
+	}
+
 	challenges, err := u.challengeRepo.FindAll(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	hasParts := false
+	for _, c := range challenges {
+		hasParts = hasParts || c.IsMultiPart()
+	}
+
+	// スコアボードの凍結中は、凍結後の正解を正解数と最初の正解者に含めない
+	now := time.Now()
+	var until time.Time
+	if event.IsFrozen(now) {
+		until = event.FreezeAt
+	}
+
+	stats, solvedParts, err := u.findSolveStats(ctx, userID, until, hasParts)
+	if err != nil {
+		return nil, err
+	}
+
+	solved := make(map[string]bool, len(stats))
+	for id, st := range stats {
+		solved[id] = st.SolvedByMe
+	}
+
+	released := make([]*domain.Challenge, 0, len(challenges))
 	for _, c := range challenges {
+		if !c.IsReleased(now) {
+			continue
+		}
 		c.Flag = ""
+		c.AcceptedFlags = nil
+		c.Parts = hideParts(c.Parts, solvedParts)
+		c.SolveStats = stats[c.ChallengeID]
+		if c.SolveStats == nil {
+			c.SolveStats = &domain.ChallengeSolveStats{ChallengeID: c.ChallengeID}
+		}
+		// 凍結後の正解で下がった得点を見せると凍結後に解かれた数がわかるため、凍結時点の正解数から計算する
+		if !until.IsZero() && c.IsDynamic() {
+			c.Points = c.DynamicPoints(c.SolveStats.SolveCount)
+		}
+		if !c.IsUnlocked(solved) {
+			c.Locked = true
+			c.Description = ""
+			c.Attachments = nil
+		}
+		released = append(released, c)
 	}
 
-	return challenges, nil
+	return released, nil
 }
 
 func (u *ClientChallengeUsecase) SubmitFlag(ctx context.Context, userID, challengeID, submittedFlag string) (bool, int, error) {
-	challenge, err := u.challengeRepo.FindByID(ctx, challengeID)
+	if err := u.checkEventRunning(ctx); err != nil {
+		return false, 0, err
+	}
+
+	challenge, err := u.findReleasedChallenge(ctx, challengeID)
 	if err != nil {
 		return false, 0, err
 	}
 
-	previousSubmissions, err := u.submissionRepo.FindByUserAndChallenge(ctx, userID, challengeID)
+	teamID, err := u.resolveTeamID(ctx, userID)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if err := u.checkUnlocked(ctx, challenge, userID, teamID); err != nil {
+		return false, 0, err
+	}
+
+	var previousSubmissions []*domain.Submission
+	if u.teamMode {
+		previousSubmissions, err = u.submissionRepo.FindByTeamAndChallenge(ctx, teamID, challengeID)
+	} else {
+		previousSubmissions, err = u.submissionRepo.FindByUserAndChallenge(ctx, userID, challengeID)
+	}
 	if err != nil {
 		return false, 0, err
 	}
 
 	for _, sub := range previousSubmissions {
-		if sub.IsCorrect {
+		if sub.IsSolve() {
 			return false, 0, nil
 		}
 	}
 
-	isCorrect := challenge.Flag == submittedFlag
+	if err := u.checkSubmitRate(ctx, userID, challengeID); err != nil {
+		return false, 0, err
+	}
+
+	if challenge.IsMultiPart() {
+		return u.submitPart(ctx, challenge, userID, teamID, submittedFlag)
+	}
+
+	isCorrect, err := u.checkFlag(ctx, challenge, flagOwnerID(userID, teamID), submittedFlag)
+	if err != nil {
+		return false, 0, err
+	}
 
 	submission := &domain.Submission{
 		SubmissionID:  uuid.New().String(),
 		UserID:        userID,
+		TeamID:        teamID,
 		ChallengeID:   challengeID,
 		SubmittedFlag: submittedFlag,
 		IsCorrect:     isCorrect,
 		SubmittedAt:   time.Now(),
 	}
 
-	if err := u.submissionRepo.Create(ctx, submission); err != nil {
-		return false, 0, err
+	if !isCorrect {
+		if err := u.submissionRepo.Create(ctx, submission); err != nil {
+			return false, 0, err
+		}
+		return false, 0, nil
+	}
+
+	pointsAwarded, err := u.recordSolve(ctx, challenge, submission)
+	if err == domain.ErrAlreadySolved {
+		// 同時に提出された正解のうち、記録されなかったものは解き済みの問題への再提出と同じく扱う
+		return false, 0, nil
+	}
+	if err != nil {
+		return true, pointsAwarded, err
+	}
+
+	return true, pointsAwarded, nil
+}
+
+// recordSolve は問題を解いた提出を記録し、解いた順位のボーナスを含めた得点を返す
+func (u *ClientChallengeUsecase) recordSolve(ctx context.Context, challenge *domain.Challenge, submission *domain.Submission) (int, error) {
+	if err := u.submissionRepo.CreateSolve(ctx, submission); err != nil {
+		return 0, err
+	}
+	return u.awardSolve(ctx, challenge, submission)
+}
+
+// awardSolve は記録済みの問題を解いた提出を通知し、解いた順位のボーナスを含めた得点を返す
+func (u *ClientChallengeUsecase) awardSolve(ctx context.Context, challenge *domain.Challenge, submission *domain.Submission) (int, error) {
+	u.publishSolve(ctx, challenge, submission)
+
+	points, err := u.solvePoints(ctx, challenge)
+	if err != nil {
+		return 0, err
+	}
+
+	return points + challenge.BloodBonus(submission.SolveRank), nil
+}
+
+// solvePoints は問題を解いたときに得られる得点を返す。dynamic scoringの場合は正解の記録時に再計算された得点を読み直す
+func (u *ClientChallengeUsecase) solvePoints(ctx context.Context, challenge *domain.Challenge) (int, error) {
+	if !challenge.IsDynamic() {
+		return challenge.Points, nil
 	}
 
-	pointsAwarded := 0
-	if isCorrect {
-		pointsAwarded = challenge.Points
+	updated, err := u.challengeRepo.FindByID(ctx, challenge.ChallengeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load recalculated points: %w", err)
+	}
+	challenge.Points = updated.Points
+	return challenge.Points, nil
+}
+
+// checkSubmitRate はユーザーごと・問題ごとの提出回数が制限を超えていれば *domain.RateLimitedError を返す
+func (u *ClientChallengeUsecase) checkSubmitRate(ctx context.Context, userID, challengeID string) error {
+	if u.submitLimiter == nil {
+		return nil
 	}
 
-	return isCorrect, pointsAwarded, nil
+	allowed, retryAfter, err := u.submitLimiter.Allow(ctx, "submit:"+userID+":"+challengeID)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return &domain.RateLimitedError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// resolveTeamID はチームモードの場合にユーザーの所属チームIDを返す
+func (u *ClientChallengeUsecase) resolveTeamID(ctx context.Context, userID string) (string, error) {
+	if !u.teamMode {
+		return "", nil
+	}
+
+	team, err := u.teamRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrTeamNotFound {
+			return "", domain.ErrNotInTeam
+		}
+		return "", err
+	}
+
+	return team.TeamID, nil
+}
+
+// findReleasedChallenge は公開中の問題を返す。非公開の問題は存在しないものとして扱う
+func (u *ClientChallengeUsecase) findReleasedChallenge(ctx context.Context, challengeID string) (*domain.Challenge, error) {
+	challenge, err := u.challengeRepo.FindByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if !challenge.IsReleased(time.Now()) {
+		return nil, domain.ErrChallengeNotFound
+	}
+	return challenge, nil
+}
+
+// findSolveStats は問題ごとの正解状況と、withParts が true の場合はユーザー(チームモードではチーム)が解いた部分フラグIDの集合を返す
+// チームモードでチームに所属していない場合は何も解いていないものとして扱う
+func (u *ClientChallengeUsecase) findSolveStats(ctx context.Context, userID string, until time.Time, withParts bool) (map[string]*domain.ChallengeSolveStats, map[string]bool, error) {
+	teamID, err := u.resolveTeamID(ctx, userID)
+	if err == domain.ErrNotInTeam {
+		stats, err := u.submissionRepo.GetChallengeSolveStats(ctx, "", "", until)
+		return stats, map[string]bool{}, err
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats, err := u.submissionRepo.GetChallengeSolveStats(ctx, userID, teamID, until)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !withParts {
+		return stats, map[string]bool{}, nil
+	}
+
+	solvedParts, err := u.partSolveRepo.FindSolvedPartIDs(ctx, flagOwnerID(userID, teamID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stats, solvedParts, nil
+}
+
+// checkUnlocked は前提問題を解いていない場合に ErrChallengeLocked を返す
+func (u *ClientChallengeUsecase) checkUnlocked(ctx context.Context, challenge *domain.Challenge, userID, teamID string) error {
+	if len(challenge.Prerequisites) == 0 {
+		return nil
+	}
+
+	solved, err := u.submissionRepo.FindSolvedChallengeIDs(ctx, userID, teamID)
+	if err != nil {
+		return err
+	}
+	if !challenge.IsUnlocked(solved) {
+		return domain.ErrChallengeLocked
+	}
+	return nil
+}
+
+// checkEventRunning は競技期間外であればエラーを返す
+func (u *ClientChallengeUsecase) checkEventRunning(ctx context.Context) error {
+	event, err := u.eventRepo.Get(ctx)
+	if err != nil {
+		return err
+	}
+	return event.CheckRunning(time.Now())
+}
+
+// GetScoreboard はスコアボードを返す。live が false かつ凍結時刻を過ぎている場合は凍結時点の順位を返す
+func (u *ClientChallengeUsecase) GetScoreboard(ctx context.Context, live bool) ([]*domain.ScoreboardEntry, bool, error) {
+	until, frozen, err := u.scoreboardCutoff(ctx, live)
+	if err != nil {
+		return nil, false, err
+	}
+
+	points, err := u.frozenPoints(ctx, until)
+	if err != nil {
+		return nil, false, err
+	}
+
+	entries, err := u.rankedScoreboard(ctx, until, points)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return entries, frozen, nil
+}
+
+const (
+	defaultScoreHistoryTop = 10
+	maxScoreHistoryTop     = 50
+)
+
+// GetScoreHistory はスコアボードの上位 top 件について累計得点の推移を返す
+// top が0以下の場合は defaultScoreHistoryTop 件、maxScoreHistoryTop を超える場合は maxScoreHistoryTop 件とする
+func (u *ClientChallengeUsecase) GetScoreHistory(ctx context.Context, top int, live bool) ([]*domain.ScoreHistory, bool, error) {
+	if top <= 0 {
+		top = defaultScoreHistoryTop
+	}
+	if top > maxScoreHistoryTop {
+		top = maxScoreHistoryTop
+	}
+
+	until, frozen, err := u.scoreboardCutoff(ctx, live)
+	if err != nil {
+		return nil, false, err
+	}
+
+	points, err := u.frozenPoints(ctx, until)
+	if err != nil {
+		return nil, false, err
+	}
+
+	entries, err := u.rankedScoreboard(ctx, until, points)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(entries) > top {
+		entries = entries[:top]
+	}
+
+	ownerIDs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		ownerIDs = append(ownerIDs, e.OwnerID())
+	}
+
+	var events []*domain.ScoreEvent
+	if u.teamMode {
+		events, err = u.submissionRepo.FindTeamScoreEvents(ctx, ownerIDs, until, points)
+	} else {
+		events, err = u.submissionRepo.FindScoreEvents(ctx, ownerIDs, until, points)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return domain.BuildScoreHistory(entries, events), frozen, nil
+}
+
+// scoreboardCutoff はスコアボードの集計期限を返す。live が false で凍結中の場合は凍結時刻までを集計する
+func (u *ClientChallengeUsecase) scoreboardCutoff(ctx context.Context, live bool) (time.Time, bool, error) {
+	event, err := u.eventRepo.Get(ctx)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	if !live && event.IsFrozen(time.Now()) {
+		return event.FreezeAt, true, nil
+	}
+	return time.Time{}, false, nil
+}
+
+// frozenPoints は until より前の正解数から計算した dynamic scoring の問題の得点を返す。until がゼロ値の場合は nil
+// 現在の得点は凍結後の正解でも下がるため、凍結中のスコアボードには使わない
+func (u *ClientChallengeUsecase) frozenPoints(ctx context.Context, until time.Time) (map[string]int, error) {
+	if until.IsZero() {
+		return nil, nil
+	}
+
+	challenges, err := u.challengeRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := u.submissionRepo.GetChallengeSolveStats(ctx, "", "", until)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make(map[string]int)
+	for _, c := range challenges {
+		if !c.IsDynamic() {
+			continue
+		}
+		solves := 0
+		if st, ok := stats[c.ChallengeID]; ok {
+			solves = st.SolveCount
+		}
+		points[c.ChallengeID] = c.DynamicPoints(solves)
+	}
+	return points, nil
+}
+
+// rankedScoreboard は until より前の正解を集計し、順位を付けたスコアボードを返す
+func (u *ClientChallengeUsecase) rankedScoreboard(ctx context.Context, until time.Time, points map[string]int) ([]*domain.ScoreboardEntry, error) {
+	var entries []*domain.ScoreboardEntry
+	var err error
+	if u.teamMode {
+		entries, err = u.submissionRepo.GetTeamScoreboard(ctx, until, points)
+	} else {
+		entries, err = u.submissionRepo.GetScoreboard(ctx, until, points)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	domain.RankScoreboard(entries)
+	return entries, nil
 }
 
 func (u *ClientChallengeUsecase) StartInstance(ctx context.Context, userID, challengeID string) (string, int32, error) {
+	if err := u.checkEventRunning(ctx); err != nil {
+		return "", 0, err
+	}
+
+	challenge, err := u.findReleasedChallenge(ctx, challengeID)
+	if err != nil {
+		return "", 0, err
+	}
+
+	var teamID string
+	if challenge.DynamicFlag || len(challenge.Prerequisites) > 0 {
+		teamID, err = u.resolveTeamID(ctx, userID)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+
+	if err := u.checkUnlocked(ctx, challenge, userID, teamID); err != nil {
+		return "", 0, err
+	}
+
+	var flag string
+	if challenge.DynamicFlag {
+		flag, err = u.issueFlag(ctx, challenge, flagOwnerID(userID, teamID))
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to issue flag: %w", err)
+		}
+	}
+
 	// TODO: 現在の実装では使わずにすぐにDestroyしている
 	existingInstance, err := u.instanceRepo.FindByUserAndChallenge(ctx, userID, challengeID)
 	if err == nil {
@@ -102,7 +513,7 @@ func (u *ClientChallengeUsecase) StartInstance(ctx context.Context, userID, chal
 		// 停止中のインスタンスがある場合は再起動
 		if existingInstance.Status == domain.InstanceStatusStopped {
 			ttlSeconds := int64(3600)
-			_, connInfo, err := u.managerClient.StartInstance(ctx, existingInstance.ImageTag, ttlSeconds)
+			_, connInfo, err := u.managerClient.StartInstance(ctx, existingInstance.ImageTag, ttlSeconds, flag)
 			if err != nil {
 				return "", 0, fmt.Errorf("failed to restart instance: %w", err)
 			}
@@ -123,7 +534,7 @@ func (u *ClientChallengeUsecase) StartInstance(ctx context.Context, userID, chal
 	imageTag := fmt.Sprintf("ctf-%s:latest", challengeID)
 	ttlSeconds := int64(3600)
 
-	id, connInfo, err := u.managerClient.StartInstance(ctx, imageTag, ttlSeconds)
+	id, connInfo, err := u.managerClient.StartInstance(ctx, imageTag, ttlSeconds, flag)
 	if err != nil {
 		return "", 0, fmt.Errorf("failed to start instance: %w", err)
 	}
@@ -2,7 +2,13 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/kavos113/quickctf/ctf-server/domain"
 )
@@ -21,6 +27,10 @@ func (m *MockChallengeRepository) Create(ctx context.Context, challenge *domain.
 	if _, exists := m.challenges[challenge.ChallengeID]; exists {
 		return domain.ErrChallengeAlreadyExists
 	}
+	// DBのデフォルト値と同じく公開状態にする
+	if challenge.Visibility == "" {
+		challenge.Visibility = domain.VisibilityVisible
+	}
 	m.challenges[challenge.ChallengeID] = challenge
 	return nil
 }
@@ -36,7 +46,8 @@ func (m *MockChallengeRepository) FindByID(ctx context.Context, challengeID stri
 func (m *MockChallengeRepository) FindAll(ctx context.Context) ([]*domain.Challenge, error) {
 	result := make([]*domain.Challenge, 0, len(m.challenges))
 	for _, c := range m.challenges {
-		result = append(result, c)
+		copied := *c
+		result = append(result, &copied)
 	}
 	return result, nil
 }
@@ -49,6 +60,17 @@ func (m *MockChallengeRepository) Update(ctx context.Context, challenge *domain.
 	return nil
 }
 
+func (m *MockChallengeRepository) ReleaseScheduled(ctx context.Context, now time.Time) ([]string, error) {
+	var released []string
+	for _, c := range m.challenges {
+		if c.Visibility == domain.VisibilityScheduled && !c.ReleaseAt.After(now) {
+			c.Visibility = domain.VisibilityVisible
+			released = append(released, c.ChallengeID)
+		}
+	}
+	return released, nil
+}
+
 func (m *MockChallengeRepository) Delete(ctx context.Context, challengeID string) error {
 	if _, exists := m.challenges[challengeID]; !exists {
 		return domain.ErrChallengeNotFound
@@ -59,7 +81,22 @@ func (m *MockChallengeRepository) Delete(ctx context.Context, challengeID string
 
 // MockSubmissionRepository is a mock implementation of domain.SubmissionRepository
 type MockSubmissionRepository struct {
+	// mu は同時に呼び出すテストのために submissions を保護する
+	mu          sync.Mutex
 	submissions map[string]*domain.Submission
+	scoreboard  []*domain.ScoreboardEntry
+	teamBoard   []*domain.ScoreboardEntry
+	events      []*domain.ScoreEvent
+	// lastUntil と lastPoints は直近の GetScoreboard に渡された集計期限と問題の得点
+	lastUntil  time.Time
+	lastPoints map[string]int
+	// userRepo が設定されていれば、利用停止中のユーザーの正解を CountSolves で数えない
+	userRepo *MockUserRepository
+	// partSolveRepo は CreatePartSolve で部分フラグの正解を記録する先で、設定されていれば Invalidate で正解記録も削除する
+	partSolveRepo *MockFlagPartSolveRepository
+	// challengeRepo が設定されていれば、正解の記録と取り消しで dynamic scoring の得点を再計算する
+	// CreatePartSolve は解き終えたかどうかの判定に challengeRepo の部分フラグを使う
+	challengeRepo *MockChallengeRepository
 }
 
 func NewMockSubmissionRepository() *MockSubmissionRepository {
@@ -69,11 +106,78 @@ func NewMockSubmissionRepository() *MockSubmissionRepository {
 }
 
 func (m *MockSubmissionRepository) Create(ctx context.Context, submission *domain.Submission) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.submissions[submission.SubmissionID] = submission
+	return nil
+}
+
+func (m *MockSubmissionRepository) CreateSolve(ctx context.Context, submission *domain.Submission) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.insertSolve(submission)
+}
+
+func (m *MockSubmissionRepository) CreatePartSolve(ctx context.Context, submission *domain.Submission, solve *domain.FlagPartSolve) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.partSolveRepo.mu.Lock()
+	key := solve.PartID + "/" + solve.OwnerID
+	if _, exists := m.partSolveRepo.solves[key]; exists {
+		m.partSolveRepo.mu.Unlock()
+		return domain.ErrAlreadySolved
+	}
+	m.partSolveRepo.solves[key] = solve
+	completed := true
+	for _, p := range m.challengeRepo.challenges[submission.ChallengeID].Parts {
+		if _, ok := m.partSolveRepo.solves[p.PartID+"/"+solve.OwnerID]; !ok {
+			completed = false
+		}
+	}
+	m.partSolveRepo.mu.Unlock()
+
+	if completed {
+		submission.CompletedPartID = solve.PartID
+		if err := m.insertSolve(submission); err != domain.ErrAlreadySolved {
+			return err
+		}
+		submission.CompletedPartID = ""
+	}
+
+	submission.IsCorrect = true
+	submission.PartID = solve.PartID
+	m.submissions[submission.SubmissionID] = submission
+	return nil
+}
+
+// insertSolve は mu を保持した状態で呼ぶ
+func (m *MockSubmissionRepository) insertSolve(submission *domain.Submission) error {
+	rank := 1
+	for _, s := range m.submissions {
+		if s.ChallengeID != submission.ChallengeID || !s.IsSolve() {
+			continue
+		}
+		if s.OwnerID() == submission.OwnerID() {
+			return domain.ErrAlreadySolved
+		}
+		if s.SolveRank >= rank {
+			rank = s.SolveRank + 1
+		}
+	}
+	submission.IsCorrect = true
+	submission.SolveRank = rank
 	m.submissions[submission.SubmissionID] = submission
+	m.updateDynamicPoints(submission.ChallengeID)
 	return nil
 }
 
 func (m *MockSubmissionRepository) FindByID(ctx context.Context, submissionID string) (*domain.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	submission, exists := m.submissions[submissionID]
 	if !exists {
 		return nil, domain.ErrSubmissionNotFound
@@ -82,6 +186,9 @@ func (m *MockSubmissionRepository) FindByID(ctx context.Context, submissionID st
 }
 
 func (m *MockSubmissionRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	result := make([]*domain.Submission, 0)
 	for _, s := range m.submissions {
 		if s.UserID == userID {
@@ -92,6 +199,9 @@ func (m *MockSubmissionRepository) FindByUserID(ctx context.Context, userID stri
 }
 
 func (m *MockSubmissionRepository) FindByChallengeID(ctx context.Context, challengeID string) ([]*domain.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	result := make([]*domain.Submission, 0)
 	for _, s := range m.submissions {
 		if s.ChallengeID == challengeID {
@@ -102,6 +212,9 @@ func (m *MockSubmissionRepository) FindByChallengeID(ctx context.Context, challe
 }
 
 func (m *MockSubmissionRepository) FindByUserAndChallenge(ctx context.Context, userID, challengeID string) ([]*domain.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	result := make([]*domain.Submission, 0)
 	for _, s := range m.submissions {
 		if s.UserID == userID && s.ChallengeID == challengeID {
@@ -111,6 +224,351 @@ func (m *MockSubmissionRepository) FindByUserAndChallenge(ctx context.Context, u
 	return result, nil
 }
 
+func (m *MockSubmissionRepository) FindByTeamAndChallenge(ctx context.Context, teamID, challengeID string) ([]*domain.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make([]*domain.Submission, 0)
+	for _, s := range m.submissions {
+		if s.TeamID == teamID && s.ChallengeID == challengeID {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockSubmissionRepository) FindSolvedChallengeIDs(ctx context.Context, userID, teamID string) (map[string]bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	solved := make(map[string]bool)
+	for _, s := range m.submissions {
+		if !s.IsSolve() {
+			continue
+		}
+		if (teamID != "" && s.TeamID == teamID) || (teamID == "" && s.UserID == userID) {
+			solved[s.ChallengeID] = true
+		}
+	}
+	return solved, nil
+}
+
+func (m *MockSubmissionRepository) CountSolves(ctx context.Context, challengeID string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.countSolves(challengeID), nil
+}
+
+func (m *MockSubmissionRepository) Rescore(ctx context.Context, challengeID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var solves []*domain.Submission
+	for _, s := range m.submissions {
+		if s.ChallengeID != challengeID || !s.IsSolve() {
+			continue
+		}
+		s.SolveRank = 0
+		if m.userRepo != nil {
+			if user, ok := m.userRepo.users[s.UserID]; ok && user.IsBanned() {
+				continue
+			}
+		}
+		solves = append(solves, s)
+	}
+	sort.Slice(solves, func(i, j int) bool {
+		if !solves[i].SubmittedAt.Equal(solves[j].SubmittedAt) {
+			return solves[i].SubmittedAt.Before(solves[j].SubmittedAt)
+		}
+		return solves[i].SubmissionID < solves[j].SubmissionID
+	})
+	for i, s := range solves {
+		s.SolveRank = i + 1
+	}
+
+	m.updateDynamicPoints(challengeID)
+	return nil
+}
+
+// updateDynamicPoints は mu を保持した状態で呼ぶ
+func (m *MockSubmissionRepository) updateDynamicPoints(challengeID string) {
+	if m.challengeRepo == nil {
+		return
+	}
+	challenge, ok := m.challengeRepo.challenges[challengeID]
+	if !ok || !challenge.IsDynamic() {
+		return
+	}
+	challenge.Points = challenge.DynamicPoints(m.countSolves(challengeID))
+}
+
+// countSolves は mu を保持した状態で呼ぶ
+func (m *MockSubmissionRepository) countSolves(challengeID string) int {
+	solvers := make(map[string]bool)
+	for _, s := range m.submissions {
+		if s.ChallengeID == challengeID && s.IsSolve() {
+			if m.userRepo != nil {
+				if user, ok := m.userRepo.users[s.UserID]; ok && user.IsBanned() {
+					continue
+				}
+			}
+			if s.TeamID != "" {
+				solvers["team:"+s.TeamID] = true
+			} else {
+				solvers[s.UserID] = true
+			}
+		}
+	}
+	return len(solvers)
+}
+
+func (m *MockSubmissionRepository) GetChallengeSolveStats(ctx context.Context, userID, teamID string, until time.Time) (map[string]*domain.ChallengeSolveStats, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := make(map[string]*domain.ChallengeSolveStats)
+	solvers := make(map[string]bool)
+	for _, s := range m.submissions {
+		if !s.IsSolve() {
+			continue
+		}
+		st, ok := stats[s.ChallengeID]
+		if !ok {
+			st = &domain.ChallengeSolveStats{ChallengeID: s.ChallengeID}
+			stats[s.ChallengeID] = st
+		}
+		if (teamID != "" && s.TeamID == teamID) || (teamID == "" && userID != "" && s.UserID == userID) {
+			st.SolvedByMe = true
+		}
+		if !until.IsZero() && !s.SubmittedAt.Before(until) {
+			continue
+		}
+
+		owner := s.UserID
+		if s.TeamID != "" {
+			owner = "team:" + s.TeamID
+		}
+		if !solvers[s.ChallengeID+"/"+owner] {
+			solvers[s.ChallengeID+"/"+owner] = true
+			st.SolveCount++
+		}
+		if st.FirstBlood == nil || s.SubmittedAt.Before(st.FirstBlood.SolvedAt) {
+			st.FirstBlood = &domain.FirstBlood{UserID: s.UserID, TeamID: s.TeamID, SolvedAt: s.SubmittedAt}
+		}
+	}
+	return stats, nil
+}
+
+func (m *MockSubmissionRepository) GetScoreboard(ctx context.Context, until time.Time, points map[string]int) ([]*domain.ScoreboardEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.lastUntil = until
+	m.lastPoints = points
+	result := make([]*domain.ScoreboardEntry, 0, len(m.scoreboard))
+	result = append(result, m.scoreboard...)
+	return result, nil
+}
+
+func (m *MockSubmissionRepository) FindScoreEvents(ctx context.Context, userIDs []string, until time.Time, points map[string]int) ([]*domain.ScoreEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.filterEvents(userIDs, until), nil
+}
+
+func (m *MockSubmissionRepository) FindTeamScoreEvents(ctx context.Context, teamIDs []string, until time.Time, points map[string]int) ([]*domain.ScoreEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.filterEvents(teamIDs, until), nil
+}
+
+func (m *MockSubmissionRepository) filterEvents(ownerIDs []string, until time.Time) []*domain.ScoreEvent {
+	owners := make(map[string]bool, len(ownerIDs))
+	for _, id := range ownerIDs {
+		owners[id] = true
+	}
+
+	result := make([]*domain.ScoreEvent, 0)
+	for _, e := range m.events {
+		if owners[e.OwnerID] && (until.IsZero() || e.OccurredAt.Before(until)) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func (m *MockSubmissionRepository) FindSharedIncorrect(ctx context.Context) ([]*domain.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make([]*domain.Submission, 0)
+	for _, s := range m.submissions {
+		if !s.IsCorrect {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockSubmissionRepository) FindSolves(ctx context.Context) ([]*domain.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make([]*domain.Submission, 0)
+	for _, s := range m.submissions {
+		if s.IsSolve() {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockSubmissionRepository) GetTeamScoreboard(ctx context.Context, until time.Time, points map[string]int) ([]*domain.ScoreboardEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.lastUntil = until
+	m.lastPoints = points
+	result := make([]*domain.ScoreboardEntry, 0, len(m.teamBoard))
+	result = append(result, m.teamBoard...)
+	return result, nil
+}
+
+func (m *MockSubmissionRepository) FindSubmissions(ctx context.Context, filter *domain.SubmissionFilter) ([]*domain.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	less := func(a, b *domain.SubmissionCursor) bool {
+		if !a.SubmittedAt.Equal(b.SubmittedAt) {
+			return a.SubmittedAt.Before(b.SubmittedAt)
+		}
+		return a.SubmissionID < b.SubmissionID
+	}
+	// before は a が並び順で b より前かを返す
+	before := func(a, b *domain.SubmissionCursor) bool {
+		if filter.Order == domain.SubmissionOrderOldestFirst {
+			return less(a, b)
+		}
+		return less(b, a)
+	}
+
+	result := make([]*domain.Submission, 0)
+	for _, s := range m.submissions {
+		if filter.UserID != "" && s.UserID != filter.UserID {
+			continue
+		}
+		if filter.ChallengeID != "" && s.ChallengeID != filter.ChallengeID {
+			continue
+		}
+		if filter.Result == domain.SubmissionResultCorrect && !s.IsCorrect ||
+			filter.Result == domain.SubmissionResultIncorrect && s.IsCorrect {
+			continue
+		}
+		if !filter.Since.IsZero() && s.SubmittedAt.Before(filter.Since) ||
+			!filter.Until.IsZero() && !s.SubmittedAt.Before(filter.Until) {
+			continue
+		}
+		if filter.After != nil && !before(filter.After, domain.NewSubmissionCursor(s)) {
+			continue
+		}
+		result = append(result, s)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return before(domain.NewSubmissionCursor(result[i]), domain.NewSubmissionCursor(result[j]))
+	})
+	if len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+	return result, nil
+}
+
+func (m *MockSubmissionRepository) Invalidate(ctx context.Context, submissionID string, invalidatedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	submission, ok := m.submissions[submissionID]
+	if !ok {
+		return domain.ErrSubmissionNotFound
+	}
+	if !submission.IsCorrect {
+		return domain.ErrSubmissionNotCorrect
+	}
+
+	rank := submission.SolveRank
+	submission.IsCorrect = false
+	submission.SolveRank = 0
+	submission.InvalidatedAt = invalidatedAt
+	if m.partSolveRepo != nil {
+		m.partSolveRepo.mu.Lock()
+		for _, partID := range []string{submission.PartID, submission.CompletedPartID} {
+			delete(m.partSolveRepo.solves, partID+"/"+submission.OwnerID())
+		}
+		m.partSolveRepo.mu.Unlock()
+	}
+	if rank == 0 {
+		return nil
+	}
+	for _, s := range m.submissions {
+		if s.ChallengeID == submission.ChallengeID && s.SolveRank > rank {
+			s.SolveRank--
+		}
+	}
+	m.updateDynamicPoints(submission.ChallengeID)
+	return nil
+}
+
+type MockEventConfigRepository struct {
+	config *domain.EventConfig
+}
+
+func NewMockEventConfigRepository() *MockEventConfigRepository {
+	return &MockEventConfigRepository{
+		config: &domain.EventConfig{},
+	}
+}
+
+func (m *MockEventConfigRepository) Get(ctx context.Context) (*domain.EventConfig, error) {
+	config := *m.config
+	return &config, nil
+}
+
+func (m *MockEventConfigRepository) Save(ctx context.Context, config *domain.EventConfig) error {
+	saved := *config
+	m.config = &saved
+	return nil
+}
+
+type MockIssuedFlagRepository struct {
+	flags map[string]*domain.IssuedFlag
+}
+
+func NewMockIssuedFlagRepository() *MockIssuedFlagRepository {
+	return &MockIssuedFlagRepository{
+		flags: make(map[string]*domain.IssuedFlag),
+	}
+}
+
+func (m *MockIssuedFlagRepository) Create(ctx context.Context, issuedFlag *domain.IssuedFlag) error {
+	key := issuedFlag.ChallengeID + "/" + issuedFlag.OwnerID
+	if _, exists := m.flags[key]; exists {
+		return fmt.Errorf("duplicate issued flag")
+	}
+	m.flags[key] = issuedFlag
+	return nil
+}
+
+func (m *MockIssuedFlagRepository) FindByOwner(ctx context.Context, challengeID, ownerID string) (*domain.IssuedFlag, error) {
+	issuedFlag, exists := m.flags[challengeID+"/"+ownerID]
+	if !exists {
+		return nil, domain.ErrIssuedFlagNotFound
+	}
+	return issuedFlag, nil
+}
+
 func TestClientChallengeUsecase_GetChallenges(t *testing.T) {
 	ctx := context.Background()
 	challengeRepo := NewMockChallengeRepository()
@@ -138,9 +596,10 @@ func TestClientChallengeUsecase_GetChallenges(t *testing.T) {
 	uc := &ClientChallengeUsecase{
 		challengeRepo:  challengeRepo,
 		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
 	}
 
-	challenges, err := uc.GetChallenges(ctx)
+	challenges, err := uc.GetChallenges(ctx, "user1")
 	if err != nil {
 		t.Fatalf("GetChallenges() error = %v", err)
 	}
@@ -156,6 +615,76 @@ func TestClientChallengeUsecase_GetChallenges(t *testing.T) {
 	}
 }
 
+func TestClientChallengeUsecase_GetChallenges_SolveStats(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	eventRepo := NewMockEventConfigRepository()
+
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "1", Flag: "flag{1}", Points: 100})
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "2", Flag: "flag{2}", Points: 100})
+
+	freezeAt := time.Now().Add(-time.Hour)
+	submissionRepo.Create(ctx, &domain.Submission{SubmissionID: "s1", UserID: "user2", ChallengeID: "1", IsCorrect: true, SubmittedAt: freezeAt.Add(-2 * time.Hour)})
+	submissionRepo.Create(ctx, &domain.Submission{SubmissionID: "s2", UserID: "user1", ChallengeID: "1", IsCorrect: true, SubmittedAt: freezeAt.Add(-time.Hour)})
+	submissionRepo.Create(ctx, &domain.Submission{SubmissionID: "s3", UserID: "user1", ChallengeID: "2", IsCorrect: true, SubmittedAt: freezeAt.Add(time.Minute)})
+	submissionRepo.Create(ctx, &domain.Submission{SubmissionID: "s4", UserID: "user3", ChallengeID: "2", IsCorrect: false, SubmittedAt: freezeAt.Add(-time.Hour)})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      eventRepo,
+	}
+
+	tests := []struct {
+		name           string
+		freezeAt       time.Time
+		challengeID    string
+		wantCount      int
+		wantSolvedByMe bool
+		wantFirstBlood string
+	}{
+		{name: "solved by others first", challengeID: "1", wantCount: 2, wantSolvedByMe: true, wantFirstBlood: "user2"},
+		{name: "solved only by me", challengeID: "2", wantCount: 1, wantSolvedByMe: true, wantFirstBlood: "user1"},
+		{name: "frozen solves are hidden from count", freezeAt: freezeAt, challengeID: "2", wantCount: 0, wantSolvedByMe: true, wantFirstBlood: ""},
+		{name: "solves before freeze are counted", freezeAt: freezeAt, challengeID: "1", wantCount: 2, wantSolvedByMe: true, wantFirstBlood: "user2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo.config.FreezeAt = tt.freezeAt
+
+			challenges, err := uc.GetChallenges(ctx, "user1")
+			if err != nil {
+				t.Fatalf("GetChallenges() error = %v", err)
+			}
+
+			var stats *domain.ChallengeSolveStats
+			for _, c := range challenges {
+				if c.ChallengeID == tt.challengeID {
+					stats = c.SolveStats
+				}
+			}
+			if stats == nil {
+				t.Fatalf("GetChallenges() challenge %s has no solve stats", tt.challengeID)
+			}
+			if stats.SolveCount != tt.wantCount {
+				t.Errorf("GetChallenges() solve count = %v, want %v", stats.SolveCount, tt.wantCount)
+			}
+			if stats.SolvedByMe != tt.wantSolvedByMe {
+				t.Errorf("GetChallenges() solved by me = %v, want %v", stats.SolvedByMe, tt.wantSolvedByMe)
+			}
+			gotFirstBlood := ""
+			if stats.FirstBlood != nil {
+				gotFirstBlood = stats.FirstBlood.UserID
+			}
+			if gotFirstBlood != tt.wantFirstBlood {
+				t.Errorf("GetChallenges() first blood = %v, want %v", gotFirstBlood, tt.wantFirstBlood)
+			}
+		})
+	}
+}
+
 func TestClientChallengeUsecase_SubmitFlag(t *testing.T) {
 	ctx := context.Background()
 	challengeRepo := NewMockChallengeRepository()
@@ -174,6 +703,7 @@ func TestClientChallengeUsecase_SubmitFlag(t *testing.T) {
 	uc := &ClientChallengeUsecase{
 		challengeRepo:  challengeRepo,
 		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
 	}
 
 	tests := []struct {
@@ -241,6 +771,66 @@ func TestClientChallengeUsecase_SubmitFlag(t *testing.T) {
 	}
 }
 
+func TestClientChallengeUsecase_SubmitFlag_BloodBonus(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+
+	challengeRepo.Create(ctx, &domain.Challenge{
+		ChallengeID:  "1",
+		Flag:         "flag{test}",
+		Points:       100,
+		BloodBonuses: []int{30, 20},
+	})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+	}
+
+	tests := []struct {
+		userID     string
+		wantRank   int
+		wantPoints int
+	}{
+		{userID: "user1", wantRank: 1, wantPoints: 130},
+		{userID: "user2", wantRank: 2, wantPoints: 120},
+		{userID: "user3", wantRank: 3, wantPoints: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.userID, func(t *testing.T) {
+			// 不正解は順位を消費しない
+			if _, _, err := uc.SubmitFlag(ctx, tt.userID, "1", "flag{wrong}"); err != nil {
+				t.Fatalf("SubmitFlag() error = %v", err)
+			}
+
+			isCorrect, points, err := uc.SubmitFlag(ctx, tt.userID, "1", "flag{test}")
+			if err != nil {
+				t.Fatalf("SubmitFlag() error = %v", err)
+			}
+			if !isCorrect {
+				t.Fatalf("SubmitFlag() correct = false, want true")
+			}
+			if points != tt.wantPoints {
+				t.Errorf("SubmitFlag() points = %v, want %v", points, tt.wantPoints)
+			}
+
+			submissions, _ := submissionRepo.FindByUserAndChallenge(ctx, tt.userID, "1")
+			rank := 0
+			for _, s := range submissions {
+				if s.IsSolve() {
+					rank = s.SolveRank
+				}
+			}
+			if rank != tt.wantRank {
+				t.Errorf("SubmitFlag() solve rank = %v, want %v", rank, tt.wantRank)
+			}
+		})
+	}
+}
+
 func TestClientChallengeUsecase_SubmitFlag_AlreadySolved(t *testing.T) {
 	ctx := context.Background()
 	challengeRepo := NewMockChallengeRepository()
@@ -259,6 +849,7 @@ func TestClientChallengeUsecase_SubmitFlag_AlreadySolved(t *testing.T) {
 	uc := &ClientChallengeUsecase{
 		challengeRepo:  challengeRepo,
 		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
 	}
 
 	isCorrect, pointsAwarded, err := uc.SubmitFlag(ctx, "user1", "1", "flag{correct}")
@@ -278,3 +869,662 @@ func TestClientChallengeUsecase_SubmitFlag_AlreadySolved(t *testing.T) {
 		t.Errorf("Second submission should return false and 0 points (already solved)")
 	}
 }
+
+func TestClientChallengeUsecase_SubmitFlag_Concurrent(t *testing.T) {
+	tests := []struct {
+		name     string
+		teamMode bool
+		users    []string
+	}{
+		{name: "same user", teamMode: false, users: []string{"user1"}},
+		{name: "team members", teamMode: true, users: []string{"user1", "user2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			challengeRepo := NewMockChallengeRepository()
+			submissionRepo := NewMockSubmissionRepository()
+			teamRepo := NewMockTeamRepository()
+
+			challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "1", Flag: "flag{correct}", Points: 100})
+			teamRepo.Create(ctx, &domain.Team{TeamID: "team1", Name: "team one", InviteCode: "code1"}, tt.users[0])
+			for _, userID := range tt.users[1:] {
+				teamRepo.AddMember(ctx, "team1", userID, time.Now())
+			}
+
+			uc := &ClientChallengeUsecase{
+				challengeRepo:  challengeRepo,
+				submissionRepo: submissionRepo,
+				teamRepo:       teamRepo,
+				teamMode:       tt.teamMode,
+				eventRepo:      NewMockEventConfigRepository(),
+			}
+
+			const workers = 20
+			var (
+				wg      sync.WaitGroup
+				mu      sync.Mutex
+				awards  int
+				total   int
+				errList []error
+			)
+			start := make(chan struct{})
+			for i := 0; i < workers; i++ {
+				wg.Add(1)
+				go func(userID string) {
+					defer wg.Done()
+					<-start
+					isCorrect, points, err := uc.SubmitFlag(ctx, userID, "1", "flag{correct}")
+
+					mu.Lock()
+					defer mu.Unlock()
+					if err != nil {
+						errList = append(errList, err)
+						return
+					}
+					if isCorrect {
+						awards++
+						total += points
+					}
+				}(tt.users[i%len(tt.users)])
+			}
+			close(start)
+			wg.Wait()
+
+			if len(errList) > 0 {
+				t.Fatalf("SubmitFlag() errors = %v", errList)
+			}
+			if awards != 1 {
+				t.Errorf("SubmitFlag() awarded %d times, want 1", awards)
+			}
+			if total != 100 {
+				t.Errorf("SubmitFlag() total points = %v, want 100", total)
+			}
+
+			solves, _ := submissionRepo.FindSolves(ctx)
+			if len(solves) != 1 {
+				t.Errorf("recorded %d solves, want 1", len(solves))
+			}
+		})
+	}
+}
+
+func TestClientChallengeUsecase_SubmitFlag_TeamMode(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	teamRepo := NewMockTeamRepository()
+
+	challenge := &domain.Challenge{
+		ChallengeID: "1",
+		Name:        "Test Challenge",
+		Flag:        "flag{correct}",
+		Points:      100,
+		Genre:       "web",
+	}
+	challengeRepo.Create(ctx, challenge)
+
+	teamRepo.Create(ctx, &domain.Team{TeamID: "team1", Name: "team one", InviteCode: "code1"}, "user1")
+	teamRepo.AddMember(ctx, "team1", "user2", time.Now())
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		teamRepo:       teamRepo,
+		teamMode:       true,
+		eventRepo:      NewMockEventConfigRepository(),
+	}
+
+	isCorrect, pointsAwarded, err := uc.SubmitFlag(ctx, "user1", "1", "flag{correct}")
+	if err != nil {
+		t.Fatalf("First submission failed: %v", err)
+	}
+	if !isCorrect || pointsAwarded != 100 {
+		t.Fatalf("First submission should be correct with 100 points")
+	}
+
+	// Teammate submits the same challenge (already solved by the team)
+	isCorrect, pointsAwarded, err = uc.SubmitFlag(ctx, "user2", "1", "flag{correct}")
+	if err != nil {
+		t.Fatalf("Teammate submission failed: %v", err)
+	}
+	if isCorrect || pointsAwarded != 0 {
+		t.Errorf("Teammate submission should return false and 0 points (already solved by team)")
+	}
+
+	// User without a team cannot submit
+	_, _, err = uc.SubmitFlag(ctx, "user3", "1", "flag{correct}")
+	if err != domain.ErrNotInTeam {
+		t.Errorf("SubmitFlag() without team error = %v, want %v", err, domain.ErrNotInTeam)
+	}
+}
+
+func TestClientChallengeUsecase_SubmitFlag_DynamicScoring(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	submissionRepo.challengeRepo = challengeRepo
+
+	challenge := &domain.Challenge{
+		ChallengeID:   "1",
+		Name:          "Dynamic Challenge",
+		Flag:          "flag{dynamic}",
+		Points:        500,
+		Genre:         "pwn",
+		ScoringType:   domain.ScoringTypeDynamic,
+		InitialPoints: 500,
+		MinimumPoints: 100,
+		Decay:         2,
+	}
+	challengeRepo.Create(ctx, challenge)
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+	}
+
+	wantPoints := []int{500, 400, 100, 100}
+	for i, want := range wantPoints {
+		userID := fmt.Sprintf("user%d", i)
+		isCorrect, pointsAwarded, err := uc.SubmitFlag(ctx, userID, "1", "flag{dynamic}")
+		if err != nil {
+			t.Fatalf("SubmitFlag() error = %v", err)
+		}
+		if !isCorrect {
+			t.Fatalf("SubmitFlag() isCorrect = false, want true")
+		}
+		if pointsAwarded != want {
+			t.Errorf("SubmitFlag() solve %d pointsAwarded = %v, want %v", i+1, pointsAwarded, want)
+		}
+	}
+
+	updated, _ := challengeRepo.FindByID(ctx, "1")
+	if updated.Points != 100 {
+		t.Errorf("challenge Points = %v, want 100", updated.Points)
+	}
+}
+
+func TestClientChallengeUsecase_GetScoreboard(t *testing.T) {
+	ctx := context.Background()
+	submissionRepo := NewMockSubmissionRepository()
+
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	submissionRepo.scoreboard = []*domain.ScoreboardEntry{
+		{UserID: "user-c", Username: "carol", Score: 100, SolveCount: 1, LastSolveAt: base.Add(3 * time.Minute)},
+		{UserID: "user-a", Username: "alice", Score: 300, SolveCount: 2, LastSolveAt: base.Add(10 * time.Minute)},
+		{UserID: "user-b", Username: "bob", Score: 300, SolveCount: 2, LastSolveAt: base.Add(5 * time.Minute)},
+		{UserID: "user-d", Username: "dave", Score: 100, SolveCount: 1, LastSolveAt: base.Add(3 * time.Minute)},
+	}
+
+	uc := &ClientChallengeUsecase{
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+	}
+
+	entries, frozen, err := uc.GetScoreboard(ctx, false)
+	if err != nil {
+		t.Fatalf("GetScoreboard() error = %v", err)
+	}
+	if frozen {
+		t.Errorf("GetScoreboard() frozen = true, want false")
+	}
+
+	wantOrder := []string{"user-b", "user-a", "user-c", "user-d"}
+	if len(entries) != len(wantOrder) {
+		t.Fatalf("GetScoreboard() returned %d entries, want %d", len(entries), len(wantOrder))
+	}
+
+	for i, e := range entries {
+		if e.UserID != wantOrder[i] {
+			t.Errorf("GetScoreboard()[%d].UserID = %v, want %v", i, e.UserID, wantOrder[i])
+		}
+		if e.Rank != i+1 {
+			t.Errorf("GetScoreboard()[%d].Rank = %v, want %v", i, e.Rank, i+1)
+		}
+	}
+}
+
+func TestClientChallengeUsecase_GetScoreHistory(t *testing.T) {
+	ctx := context.Background()
+	submissionRepo := NewMockSubmissionRepository()
+	eventRepo := NewMockEventConfigRepository()
+
+	base := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+	submissionRepo.scoreboard = []*domain.ScoreboardEntry{
+		{UserID: "user-a", Score: 300, LastSolveAt: base.Add(20 * time.Minute)},
+		{UserID: "user-b", Score: 200, LastSolveAt: base.Add(10 * time.Minute)},
+		{UserID: "user-c", Score: 100, LastSolveAt: base},
+	}
+	submissionRepo.events = []*domain.ScoreEvent{
+		{OwnerID: "user-c", Points: 100, OccurredAt: base},
+		{OwnerID: "user-a", Points: 100, OccurredAt: base.Add(5 * time.Minute)},
+		{OwnerID: "user-b", Points: 200, OccurredAt: base.Add(10 * time.Minute)},
+		{OwnerID: "user-a", Points: 200, OccurredAt: base.Add(20 * time.Minute)},
+		{OwnerID: "user-a", Points: 500, OccurredAt: base.Add(90 * time.Minute)},
+	}
+	eventRepo.config.FreezeAt = base.Add(time.Hour)
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  NewMockChallengeRepository(),
+		submissionRepo: submissionRepo,
+		eventRepo:      eventRepo,
+	}
+
+	tests := []struct {
+		name       string
+		top        int
+		live       bool
+		wantOwners []string
+		wantFinal  []int
+	}{
+		{name: "top two frozen", top: 2, live: false, wantOwners: []string{"user-a", "user-b"}, wantFinal: []int{300, 200}},
+		{name: "default top live", top: 0, live: true, wantOwners: []string{"user-a", "user-b", "user-c"}, wantFinal: []int{800, 200, 100}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			histories, frozen, err := uc.GetScoreHistory(ctx, tt.top, tt.live)
+			if err != nil {
+				t.Fatalf("GetScoreHistory() error = %v", err)
+			}
+			if frozen == tt.live {
+				t.Errorf("GetScoreHistory() frozen = %v, want %v", frozen, !tt.live)
+			}
+			if len(histories) != len(tt.wantOwners) {
+				t.Fatalf("GetScoreHistory() returned %d histories, want %d", len(histories), len(tt.wantOwners))
+			}
+			for i, h := range histories {
+				if h.Entry.UserID != tt.wantOwners[i] {
+					t.Errorf("GetScoreHistory()[%d] owner = %v, want %v", i, h.Entry.UserID, tt.wantOwners[i])
+				}
+				final := h.Points[len(h.Points)-1].Score
+				if final != tt.wantFinal[i] {
+					t.Errorf("GetScoreHistory()[%d] final score = %v, want %v", i, final, tt.wantFinal[i])
+				}
+			}
+		})
+	}
+}
+
+func TestClientChallengeUsecase_GetScoreboard_Frozen(t *testing.T) {
+	ctx := context.Background()
+	submissionRepo := NewMockSubmissionRepository()
+	eventRepo := NewMockEventConfigRepository()
+
+	freezeAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	eventRepo.config.FreezeAt = freezeAt
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  NewMockChallengeRepository(),
+		submissionRepo: submissionRepo,
+		eventRepo:      eventRepo,
+	}
+
+	tests := []struct {
+		name       string
+		live       bool
+		wantFrozen bool
+		wantUntil  time.Time
+	}{
+		{name: "player sees frozen standings", live: false, wantFrozen: true, wantUntil: freezeAt},
+		{name: "admin sees live standings", live: true, wantFrozen: false, wantUntil: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, frozen, err := uc.GetScoreboard(ctx, tt.live)
+			if err != nil {
+				t.Fatalf("GetScoreboard() error = %v", err)
+			}
+			if frozen != tt.wantFrozen {
+				t.Errorf("GetScoreboard() frozen = %v, want %v", frozen, tt.wantFrozen)
+			}
+			if !submissionRepo.lastUntil.Equal(tt.wantUntil) {
+				t.Errorf("GetScoreboard() until = %v, want %v", submissionRepo.lastUntil, tt.wantUntil)
+			}
+		})
+	}
+}
+
+func TestClientChallengeUsecase_FrozenDynamicPoints(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	submissionRepo.challengeRepo = challengeRepo
+	eventRepo := NewMockEventConfigRepository()
+
+	freezeAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	eventRepo.config.FreezeAt = freezeAt
+
+	challengeRepo.Create(ctx, &domain.Challenge{
+		ChallengeID:   "1",
+		Flag:          "flag{dynamic}",
+		Points:        500,
+		ScoringType:   domain.ScoringTypeDynamic,
+		InitialPoints: 500,
+		MinimumPoints: 100,
+		Decay:         2,
+	})
+	// 凍結前の正解
+	submissionRepo.CreateSolve(ctx, &domain.Submission{
+		SubmissionID: "s1",
+		UserID:       "user1",
+		ChallengeID:  "1",
+		SubmittedAt:  freezeAt.Add(-time.Minute),
+	})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      eventRepo,
+	}
+
+	frozenPoints := func() (int, int) {
+		t.Helper()
+		challenges, err := uc.GetChallenges(ctx, "user3")
+		if err != nil || len(challenges) != 1 {
+			t.Fatalf("GetChallenges() = %v, %v", challenges, err)
+		}
+		if _, _, err := uc.GetScoreboard(ctx, false); err != nil {
+			t.Fatalf("GetScoreboard() error = %v", err)
+		}
+		return challenges[0].Points, submissionRepo.lastPoints["1"]
+	}
+
+	listedBefore, boardBefore := frozenPoints()
+	if listedBefore != 500 || boardBefore != 500 {
+		t.Fatalf("points before = (%v, %v), want (500, 500)", listedBefore, boardBefore)
+	}
+
+	if _, _, err := uc.SubmitFlag(ctx, "user2", "1", "flag{dynamic}"); err != nil {
+		t.Fatalf("SubmitFlag() error = %v", err)
+	}
+	if live, _ := challengeRepo.FindByID(ctx, "1"); live.Points != 400 {
+		t.Fatalf("live Points after the solve = %v, want 400", live.Points)
+	}
+
+	// 凍結後の正解では凍結中の得点は変わらない
+	listedAfter, boardAfter := frozenPoints()
+	if listedAfter != listedBefore || boardAfter != boardBefore {
+		t.Errorf("points after = (%v, %v), want (%v, %v)", listedAfter, boardAfter, listedBefore, boardBefore)
+	}
+
+	if _, _, err := uc.GetScoreboard(ctx, true); err != nil {
+		t.Fatalf("GetScoreboard() live error = %v", err)
+	}
+	if submissionRepo.lastPoints != nil {
+		t.Errorf("GetScoreboard() live points = %v, want nil", submissionRepo.lastPoints)
+	}
+}
+
+func TestClientChallengeUsecase_EventWindow(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	eventRepo := NewMockEventConfigRepository()
+
+	challengeRepo.Create(ctx, &domain.Challenge{
+		ChallengeID: "1",
+		Name:        "Test Challenge",
+		Flag:        "flag{correct}",
+		Points:      100,
+		Genre:       "web",
+	})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      eventRepo,
+	}
+
+	now := time.Now()
+	tests := []struct {
+		name             string
+		config           domain.EventConfig
+		wantChallengeErr error
+		wantSubmitErr    error
+	}{
+		{
+			name:             "not configured",
+			config:           domain.EventConfig{},
+			wantChallengeErr: nil,
+			wantSubmitErr:    nil,
+		},
+		{
+			name:             "before start",
+			config:           domain.EventConfig{StartAt: now.Add(time.Hour)},
+			wantChallengeErr: domain.ErrEventNotStarted,
+			wantSubmitErr:    domain.ErrEventNotStarted,
+		},
+		{
+			name:             "after end",
+			config:           domain.EventConfig{StartAt: now.Add(-2 * time.Hour), EndAt: now.Add(-time.Hour)},
+			wantChallengeErr: nil,
+			wantSubmitErr:    domain.ErrEventEnded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			eventRepo.config = &config
+
+			_, err := uc.GetChallenges(ctx, "user1")
+			if err != tt.wantChallengeErr {
+				t.Errorf("GetChallenges() error = %v, want %v", err, tt.wantChallengeErr)
+			}
+
+			_, _, err = uc.SubmitFlag(ctx, "user1", "1", "flag{wrong}")
+			if err != tt.wantSubmitErr {
+				t.Errorf("SubmitFlag() error = %v, want %v", err, tt.wantSubmitErr)
+			}
+
+			// 期間内の起動は ManagerClient が必要になるため期間外のみ確認する
+			if tt.wantSubmitErr != nil {
+				_, _, err = uc.StartInstance(ctx, "user1", "1")
+				if err != tt.wantSubmitErr {
+					t.Errorf("StartInstance() error = %v, want %v", err, tt.wantSubmitErr)
+				}
+			}
+		})
+	}
+}
+
+func TestClientChallengeUsecase_SubmitFlag_DynamicFlag(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	issuedFlagRepo := NewMockIssuedFlagRepository()
+
+	challenge := &domain.Challenge{
+		ChallengeID:      "1",
+		Name:             "Dynamic Flag Challenge",
+		Flag:             "ctf{template}",
+		Points:           100,
+		Genre:            "web",
+		RequiresInstance: true,
+		DynamicFlag:      true,
+	}
+	challengeRepo.Create(ctx, challenge)
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+		issuedFlagRepo: issuedFlagRepo,
+	}
+
+	flag1, err := uc.issueFlag(ctx, challenge, "user1")
+	if err != nil {
+		t.Fatalf("issueFlag() error = %v", err)
+	}
+	again, _ := uc.issueFlag(ctx, challenge, "user1")
+	if again != flag1 {
+		t.Errorf("issueFlag() should return the already issued flag, got %v, want %v", again, flag1)
+	}
+	flag2, _ := uc.issueFlag(ctx, challenge, "user2")
+	if flag2 == flag1 {
+		t.Fatalf("issueFlag() returned the same flag for different users")
+	}
+
+	tests := []struct {
+		name          string
+		userID        string
+		submittedFlag string
+		wantCorrect   bool
+	}{
+		{name: "static flag is not accepted", userID: "user1", submittedFlag: "ctf{template}", wantCorrect: false},
+		{name: "other user's flag", userID: "user1", submittedFlag: flag2, wantCorrect: false},
+		{name: "no flag issued", userID: "user3", submittedFlag: flag1, wantCorrect: false},
+		{name: "own flag", userID: "user1", submittedFlag: flag1, wantCorrect: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isCorrect, _, err := uc.SubmitFlag(ctx, tt.userID, "1", tt.submittedFlag)
+			if err != nil {
+				t.Fatalf("SubmitFlag() error = %v", err)
+			}
+			if isCorrect != tt.wantCorrect {
+				t.Errorf("SubmitFlag() isCorrect = %v, want %v", isCorrect, tt.wantCorrect)
+			}
+		})
+	}
+}
+
+func TestGenerateDynamicFlag(t *testing.T) {
+	tests := []struct {
+		name       string
+		baseFlag   string
+		wantPrefix string
+	}{
+		{name: "keeps prefix", baseFlag: "ctf{template}", wantPrefix: "ctf{"},
+		{name: "default prefix", baseFlag: "template", wantPrefix: "flag{"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flag, err := generateDynamicFlag(tt.baseFlag)
+			if err != nil {
+				t.Fatalf("generateDynamicFlag() error = %v", err)
+			}
+			if !strings.HasPrefix(flag, tt.wantPrefix) || !strings.HasSuffix(flag, "}") {
+				t.Errorf("generateDynamicFlag() = %v, want prefix %v", flag, tt.wantPrefix)
+			}
+		})
+	}
+}
+
+func TestClientChallengeUsecase_Prerequisites(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "1", Name: "Intro", Description: "intro", Flag: "flag{intro}", Points: 100})
+	challengeRepo.Create(ctx, &domain.Challenge{
+		ChallengeID:      "2",
+		Name:             "Stage 2",
+		Description:      "stage 2",
+		Flag:             "flag{stage2}",
+		Points:           200,
+		Prerequisites:    []string{"1"},
+		PrerequisiteMode: domain.PrerequisiteModeAll,
+	})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+	}
+
+	challenges, err := uc.GetChallenges(ctx, "user1")
+	if err != nil {
+		t.Fatalf("GetChallenges() error = %v", err)
+	}
+	for _, c := range challenges {
+		wantLocked := c.ChallengeID == "2"
+		if c.Locked != wantLocked {
+			t.Errorf("GetChallenges() challenge %s locked = %v, want %v", c.ChallengeID, c.Locked, wantLocked)
+		}
+		if c.Locked && c.Description != "" {
+			t.Errorf("GetChallenges() locked challenge description = %v, want empty", c.Description)
+		}
+	}
+
+	if _, _, err := uc.SubmitFlag(ctx, "user1", "2", "flag{stage2}"); err != domain.ErrChallengeLocked {
+		t.Errorf("SubmitFlag() locked error = %v, want %v", err, domain.ErrChallengeLocked)
+	}
+	if _, _, err := uc.StartInstance(ctx, "user1", "2"); err != domain.ErrChallengeLocked {
+		t.Errorf("StartInstance() locked error = %v, want %v", err, domain.ErrChallengeLocked)
+	}
+
+	if _, _, err := uc.SubmitFlag(ctx, "user1", "1", "flag{intro}"); err != nil {
+		t.Fatalf("SubmitFlag() error = %v", err)
+	}
+
+	isCorrect, _, err := uc.SubmitFlag(ctx, "user1", "2", "flag{stage2}")
+	if err != nil {
+		t.Fatalf("SubmitFlag() after prerequisite error = %v", err)
+	}
+	if !isCorrect {
+		t.Errorf("SubmitFlag() after prerequisite correct = %v, want true", isCorrect)
+	}
+}
+
+// stubRateLimiter は limit 回まで許可し、それ以降は retryAfter を返す
+type stubRateLimiter struct {
+	limit      int
+	retryAfter time.Duration
+	counts     map[string]int
+}
+
+func (l *stubRateLimiter) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	if l.counts[key] >= l.limit {
+		return false, l.retryAfter, nil
+	}
+	l.counts[key]++
+	return true, 0, nil
+}
+
+func TestClientChallengeUsecase_SubmitFlag_RateLimit(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "1", Flag: "flag{correct}", Points: 100})
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "2", Flag: "flag{other}", Points: 100})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+		submitLimiter:  &stubRateLimiter{limit: 2, retryAfter: 30 * time.Second, counts: map[string]int{}},
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := uc.SubmitFlag(ctx, "user1", "1", "flag{wrong}"); err != nil {
+			t.Fatalf("SubmitFlag() attempt %d error = %v", i+1, err)
+		}
+	}
+
+	_, _, err := uc.SubmitFlag(ctx, "user1", "1", "flag{correct}")
+	var rateLimited *domain.RateLimitedError
+	if !errors.As(err, &rateLimited) {
+		t.Fatalf("SubmitFlag() over limit error = %v, want RateLimitedError", err)
+	}
+	if rateLimited.RetryAfter != 30*time.Second {
+		t.Errorf("SubmitFlag() RetryAfter = %v, want %v", rateLimited.RetryAfter, 30*time.Second)
+	}
+	if len(submissionRepo.submissions) != 2 {
+		t.Errorf("SubmitFlag() recorded %d submissions, want 2", len(submissionRepo.submissions))
+	}
+
+	// 制限は問題ごと・ユーザーごと
+	if _, _, err := uc.SubmitFlag(ctx, "user1", "2", "flag{other}"); err != nil {
+		t.Errorf("SubmitFlag() other challenge error = %v", err)
+	}
+	if _, _, err := uc.SubmitFlag(ctx, "user2", "1", "flag{correct}"); err != nil {
+		t.Errorf("SubmitFlag() other user error = %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+// flagOwnerID はフラグの発行先を返す。チームモードではチーム単位で発行する
+func flagOwnerID(userID, teamID string) string {
+	if teamID != "" {
+		return teamID
+	}
+	return userID
+}
+
+// issueFlag は発行済みのフラグを返す。未発行の場合は新しく発行して保存する
+func (u *ClientChallengeUsecase) issueFlag(ctx context.Context, challenge *domain.Challenge, ownerID string) (string, error) {
+	issued, err := u.issuedFlagRepo.FindByOwner(ctx, challenge.ChallengeID, ownerID)
+	if err == nil {
+		return issued.Flag, nil
+	}
+	if err != domain.ErrIssuedFlagNotFound {
+		return "", err
+	}
+
+	flag, err := generateDynamicFlag(challenge.Flag)
+	if err != nil {
+		return "", err
+	}
+
+	issued = &domain.IssuedFlag{
+		IssuedFlagID: uuid.New().String(),
+		ChallengeID:  challenge.ChallengeID,
+		OwnerID:      ownerID,
+		Flag:         flag,
+		IssuedAt:     time.Now(),
+	}
+	if err := u.issuedFlagRepo.Create(ctx, issued); err != nil {
+		// 同時に発行された場合は先に保存された方を使う
+		existing, findErr := u.issuedFlagRepo.FindByOwner(ctx, challenge.ChallengeID, ownerID)
+		if findErr != nil {
+			return "", err
+		}
+		return existing.Flag, nil
+	}
+
+	return flag, nil
+}
+
+// checkFlag は提出されたフラグが正しいかを判定する
+// dynamic flagの問題では照合モードに関わらず提出者に発行したフラグとのみ完全一致で比較する
+func (u *ClientChallengeUsecase) checkFlag(ctx context.Context, challenge *domain.Challenge, ownerID, submittedFlag string) (bool, error) {
+	if !challenge.DynamicFlag {
+		return matchFlag(challenge, submittedFlag), nil
+	}
+
+	issued, err := u.issuedFlagRepo.FindByOwner(ctx, challenge.ChallengeID, ownerID)
+	if err == domain.ErrIssuedFlagNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return issued.Flag == submittedFlag, nil
+}
+
+// generateDynamicFlag は baseFlag の接頭辞 (例: "ctf{") を引き継いだランダムなフラグを生成する
+func generateDynamicFlag(baseFlag string) (string, error) {
+	prefix := "flag"
+	if i := strings.Index(baseFlag, "{"); i > 0 {
+		prefix = baseFlag[:i]
+	}
+
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return prefix + "{" + hex.EncodeToString(bytes) + "}", nil
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+// FlagMatcher は問題に設定されたフラグと提出されたフラグを照合する
+// 照合方法を増やす場合は実装を追加して flagMatchers に登録する
+type FlagMatcher interface {
+	// Validate は問題作成・更新時に設定されたフラグが照合に使えるかを検証する
+	Validate(expected string) error
+	Match(expected, submitted string) bool
+}
+
+var flagMatchers = map[domain.FlagMatchMode]FlagMatcher{
+	domain.FlagMatchModeExact:           exactFlagMatcher{},
+	domain.FlagMatchModeCaseInsensitive: caseInsensitiveFlagMatcher{},
+	domain.FlagMatchModeRegex:           regexFlagMatcher{},
+}
+
+type exactFlagMatcher struct{}
+
+func (exactFlagMatcher) Validate(expected string) error {
+	return nil
+}
+
+func (exactFlagMatcher) Match(expected, submitted string) bool {
+	return expected == submitted
+}
+
+type caseInsensitiveFlagMatcher struct{}
+
+func (caseInsensitiveFlagMatcher) Validate(expected string) error {
+	return nil
+}
+
+func (caseInsensitiveFlagMatcher) Match(expected, submitted string) bool {
+	return strings.EqualFold(expected, submitted)
+}
+
+// regexFlagMatcher は提出されたフラグ全体が正規表現に一致するかを判定する
+type regexFlagMatcher struct{}
+
+func (regexFlagMatcher) compile(expected string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + expected + `)$`)
+}
+
+func (m regexFlagMatcher) Validate(expected string) error {
+	if _, err := m.compile(expected); err != nil {
+		return domain.ErrInvalidChallengeData
+	}
+	return nil
+}
+
+func (m regexFlagMatcher) Match(expected, submitted string) bool {
+	re, err := m.compile(expected)
+	if err != nil {
+		return false
+	}
+	return re.MatchString(submitted)
+}
+
+func flagMatcherFor(mode domain.FlagMatchMode) (FlagMatcher, error) {
+	if mode == "" {
+		mode = domain.FlagMatchModeExact
+	}
+
+	matcher, ok := flagMatchers[mode]
+	if !ok {
+		return nil, domain.ErrInvalidChallengeData
+	}
+	return matcher, nil
+}
+
+// validateFlagMatching は問題の照合モードと、照合に使うすべてのフラグを検証する
+func validateFlagMatching(challenge *domain.Challenge) error {
+	matcher, err := flagMatcherFor(challenge.FlagMatchMode)
+	if err != nil {
+		return err
+	}
+
+	for _, flag := range challenge.AcceptedFlags {
+		if flag == "" {
+			return domain.ErrInvalidChallengeData
+		}
+	}
+
+	for _, flag := range challenge.CandidateFlags() {
+		if err := matcher.Validate(flag); err != nil {
+			return err
+		}
+	}
+	for _, part := range challenge.Parts {
+		if err := matcher.Validate(part.Flag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchFlag は提出されたフラグが問題のいずれかのフラグに一致するかを判定する
+func matchFlag(challenge *domain.Challenge, submitted string) bool {
+	matcher, err := flagMatcherFor(challenge.FlagMatchMode)
+	if err != nil {
+		return false
+	}
+
+	for _, flag := range challenge.CandidateFlags() {
+		if matcher.Match(flag, submitted) {
+			return true
+		}
+	}
+	return false
+}
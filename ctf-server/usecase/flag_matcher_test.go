@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+func TestFlagMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      domain.FlagMatchMode
+		expected  string
+		submitted string
+		want      bool
+	}{
+		{name: "exact match", mode: domain.FlagMatchModeExact, expected: "flag{Abc}", submitted: "flag{Abc}", want: true},
+		{name: "exact case differs", mode: domain.FlagMatchModeExact, expected: "flag{Abc}", submitted: "flag{abc}", want: false},
+		{name: "case insensitive match", mode: domain.FlagMatchModeCaseInsensitive, expected: "flag{Abc}", submitted: "FLAG{aBC}", want: true},
+		{name: "case insensitive mismatch", mode: domain.FlagMatchModeCaseInsensitive, expected: "flag{Abc}", submitted: "flag{abd}", want: false},
+		{name: "regex match", mode: domain.FlagMatchModeRegex, expected: `flag\{[0-9]+\}`, submitted: "flag{1234}", want: true},
+		{name: "regex mismatch", mode: domain.FlagMatchModeRegex, expected: `flag\{[0-9]+\}`, submitted: "flag{abc}", want: false},
+		{name: "regex is anchored", mode: domain.FlagMatchModeRegex, expected: `flag\{[0-9]+\}`, submitted: "xflag{1}x", want: false},
+		{name: "regex alternation is anchored", mode: domain.FlagMatchModeRegex, expected: `flag\{a\}|flag\{b\}`, submitted: "flag{b}x", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := flagMatcherFor(tt.mode)
+			if err != nil {
+				t.Fatalf("flagMatcherFor() error = %v", err)
+			}
+			if got := matcher.Match(tt.expected, tt.submitted); got != tt.want {
+				t.Errorf("Match(%q, %q) = %v, want %v", tt.expected, tt.submitted, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchFlag_AcceptedFlags(t *testing.T) {
+	challenge := &domain.Challenge{
+		Flag:          "flag{primary}",
+		FlagMatchMode: domain.FlagMatchModeCaseInsensitive,
+		AcceptedFlags: []string{"flag{alternate}", "flag{legacy}"},
+	}
+
+	tests := []struct {
+		submitted string
+		want      bool
+	}{
+		{submitted: "flag{primary}", want: true},
+		{submitted: "FLAG{ALTERNATE}", want: true},
+		{submitted: "flag{legacy}", want: true},
+		{submitted: "flag{unknown}", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.submitted, func(t *testing.T) {
+			if got := matchFlag(challenge, tt.submitted); got != tt.want {
+				t.Errorf("matchFlag(%q) = %v, want %v", tt.submitted, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFlagMatching(t *testing.T) {
+	tests := []struct {
+		name      string
+		challenge domain.Challenge
+		wantErr   bool
+	}{
+		{name: "default mode", challenge: domain.Challenge{Flag: "flag{a}"}, wantErr: false},
+		{name: "valid regex", challenge: domain.Challenge{Flag: `flag\{.+\}`, FlagMatchMode: domain.FlagMatchModeRegex}, wantErr: false},
+		{name: "invalid regex", challenge: domain.Challenge{Flag: `flag\{(`, FlagMatchMode: domain.FlagMatchModeRegex}, wantErr: true},
+		{name: "invalid accepted regex", challenge: domain.Challenge{Flag: "a", FlagMatchMode: domain.FlagMatchModeRegex, AcceptedFlags: []string{"["}}, wantErr: true},
+		{name: "empty accepted flag", challenge: domain.Challenge{Flag: "flag{a}", AcceptedFlags: []string{""}}, wantErr: true},
+		{name: "unknown mode", challenge: domain.Challenge{Flag: "flag{a}", FlagMatchMode: "fuzzy"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFlagMatching(&tt.challenge)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateFlagMatching() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
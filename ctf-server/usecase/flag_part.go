@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+// submitPart は部分フラグを持つ問題への提出を処理する
+// 一致した部分フラグの得点を与え、すべての部分を解き終えた場合は問題の得点と解いた順位のボーナスも加える
+func (u *ClientChallengeUsecase) submitPart(ctx context.Context, challenge *domain.Challenge, userID, teamID, submittedFlag string) (bool, int, error) {
+	ownerID := flagOwnerID(userID, teamID)
+	solved, err := u.partSolveRepo.FindSolvedPartIDs(ctx, ownerID)
+	if err != nil {
+		return false, 0, err
+	}
+
+	submission := &domain.Submission{
+		SubmissionID:  uuid.New().String(),
+		UserID:        userID,
+		TeamID:        teamID,
+		ChallengeID:   challenge.ChallengeID,
+		SubmittedFlag: submittedFlag,
+		SubmittedAt:   time.Now(),
+	}
+
+	part := matchPart(challenge, submittedFlag)
+	if part == nil {
+		if err := u.submissionRepo.Create(ctx, submission); err != nil {
+			return false, 0, err
+		}
+		return false, 0, nil
+	}
+
+	// 解いた部分フラグの再提出は、解いた問題への再提出と同じく記録しない
+	if solved[part.PartID] {
+		return false, 0, nil
+	}
+
+	// 解き終えたかどうかは、同時に解かれた他の部分も含めてリポジトリが問題の行をロックしてから判定する
+	err = u.submissionRepo.CreatePartSolve(ctx, submission, &domain.FlagPartSolve{
+		SolveID:     uuid.New().String(),
+		PartID:      part.PartID,
+		ChallengeID: challenge.ChallengeID,
+		UserID:      userID,
+		TeamID:      teamID,
+		OwnerID:     ownerID,
+		SolvedAt:    submission.SubmittedAt,
+	})
+	if err == domain.ErrAlreadySolved {
+		return false, 0, nil
+	}
+	if err != nil {
+		return false, 0, err
+	}
+	if !submission.IsSolve() {
+		return true, part.Points, nil
+	}
+
+	points, err := u.awardSolve(ctx, challenge, submission)
+	if err != nil {
+		return true, part.Points, err
+	}
+
+	return true, part.Points + points, nil
+}
+
+// matchPart は提出されたフラグに一致する部分フラグを返す。照合には問題の照合モードを使う
+func matchPart(challenge *domain.Challenge, submitted string) *domain.FlagPart {
+	matcher, err := flagMatcherFor(challenge.FlagMatchMode)
+	if err != nil {
+		return nil
+	}
+
+	for _, p := range challenge.Parts {
+		if matcher.Match(p.Flag, submitted) {
+			return p
+		}
+	}
+	return nil
+}
+
+// hideParts は参加者向けに部分フラグのフラグを隠し、解いたかどうかを設定する
+func hideParts(parts []*domain.FlagPart, solved map[string]bool) []*domain.FlagPart {
+	if len(parts) == 0 {
+		return nil
+	}
+
+	hidden := make([]*domain.FlagPart, 0, len(parts))
+	for _, p := range parts {
+		hidden = append(hidden, &domain.FlagPart{
+			PartID:      p.PartID,
+			ChallengeID: p.ChallengeID,
+			Name:        p.Name,
+			Points:      p.Points,
+			Position:    p.Position,
+			Solved:      solved[p.PartID],
+		})
+	}
+	return hidden
+}
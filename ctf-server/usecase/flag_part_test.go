@@ -0,0 +1,293 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MockFlagPartSolveRepository struct {
+	// mu は同時に提出するテストのために solves を保護する
+	mu     sync.Mutex
+	solves map[string]*domain.FlagPartSolve
+}
+
+func NewMockFlagPartSolveRepository() *MockFlagPartSolveRepository {
+	return &MockFlagPartSolveRepository{
+		solves: make(map[string]*domain.FlagPartSolve),
+	}
+}
+
+// Create はテストの準備のために部分フラグの正解を記録する
+func (m *MockFlagPartSolveRepository) Create(ctx context.Context, solve *domain.FlagPartSolve) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.solves[solve.PartID+"/"+solve.OwnerID] = solve
+}
+
+func (m *MockFlagPartSolveRepository) FindSolvedPartIDs(ctx context.Context, ownerID string) (map[string]bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	solved := make(map[string]bool)
+	for _, s := range m.solves {
+		if s.OwnerID == ownerID {
+			solved[s.PartID] = true
+		}
+	}
+	return solved, nil
+}
+
+func TestClientChallengeUsecase_SubmitFlag_MultiPart(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	partSolveRepo := NewMockFlagPartSolveRepository()
+	submissionRepo.partSolveRepo = partSolveRepo
+	submissionRepo.challengeRepo = challengeRepo
+
+	challengeRepo.Create(ctx, &domain.Challenge{
+		ChallengeID: "1",
+		Points:      50, // すべて解いたときのボーナス
+		Parts: []*domain.FlagPart{
+			{PartID: "p1", ChallengeID: "1", Name: "user", Flag: "flag{user}", Points: 100, Position: 1},
+			{PartID: "p2", ChallengeID: "1", Name: "root", Flag: "flag{root}", Points: 200, Position: 2},
+		},
+	})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+		partSolveRepo:  partSolveRepo,
+	}
+
+	tests := []struct {
+		name        string
+		flag        string
+		wantCorrect bool
+		wantPoints  int
+	}{
+		{name: "wrong flag", flag: "flag{wrong}", wantCorrect: false, wantPoints: 0},
+		{name: "first part", flag: "flag{user}", wantCorrect: true, wantPoints: 100},
+		{name: "same part again", flag: "flag{user}", wantCorrect: false, wantPoints: 0},
+		{name: "last part completes challenge", flag: "flag{root}", wantCorrect: true, wantPoints: 250},
+		{name: "after completion", flag: "flag{root}", wantCorrect: false, wantPoints: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isCorrect, points, err := uc.SubmitFlag(ctx, "user1", "1", tt.flag)
+			if err != nil {
+				t.Fatalf("SubmitFlag() error = %v", err)
+			}
+			if isCorrect != tt.wantCorrect {
+				t.Errorf("SubmitFlag() correct = %v, want %v", isCorrect, tt.wantCorrect)
+			}
+			if points != tt.wantPoints {
+				t.Errorf("SubmitFlag() points = %v, want %v", points, tt.wantPoints)
+			}
+		})
+	}
+
+	solved, err := submissionRepo.FindSolvedChallengeIDs(ctx, "user1", "")
+	if err != nil {
+		t.Fatalf("FindSolvedChallengeIDs() error = %v", err)
+	}
+	if !solved["1"] {
+		t.Errorf("challenge should be solved after all parts are solved")
+	}
+}
+
+func TestClientChallengeUsecase_SubmitFlag_ConcurrentLastParts(t *testing.T) {
+	ctx := context.Background()
+
+	// 最後の2つの部分を同時に解いても、どちらか一方が問題を解き終えたことにする
+	for i := 0; i < 20; i++ {
+		challengeRepo := NewMockChallengeRepository()
+		submissionRepo := NewMockSubmissionRepository()
+		partSolveRepo := NewMockFlagPartSolveRepository()
+		teamRepo := NewMockTeamRepository()
+		submissionRepo.partSolveRepo = partSolveRepo
+		submissionRepo.challengeRepo = challengeRepo
+
+		challengeRepo.Create(ctx, &domain.Challenge{
+			ChallengeID: "1",
+			Points:      50,
+			Parts: []*domain.FlagPart{
+				{PartID: "p1", ChallengeID: "1", Name: "user", Flag: "flag{user}", Points: 100, Position: 1},
+				{PartID: "p2", ChallengeID: "1", Name: "root", Flag: "flag{root}", Points: 200, Position: 2},
+			},
+		})
+		teamRepo.Create(ctx, &domain.Team{TeamID: "team1", Name: "team one", InviteCode: "code1"}, "user1")
+		teamRepo.AddMember(ctx, "team1", "user2", time.Now())
+
+		uc := &ClientChallengeUsecase{
+			challengeRepo:  challengeRepo,
+			submissionRepo: submissionRepo,
+			teamRepo:       teamRepo,
+			teamMode:       true,
+			eventRepo:      NewMockEventConfigRepository(),
+			partSolveRepo:  partSolveRepo,
+		}
+
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			total   int
+			errList []error
+		)
+		start := make(chan struct{})
+		for userID, flag := range map[string]string{"user1": "flag{user}", "user2": "flag{root}"} {
+			wg.Add(1)
+			go func(userID, flag string) {
+				defer wg.Done()
+				<-start
+				isCorrect, points, err := uc.SubmitFlag(ctx, userID, "1", flag)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					errList = append(errList, err)
+					return
+				}
+				if !isCorrect {
+					errList = append(errList, fmt.Errorf("SubmitFlag(%s) was not correct", flag))
+				}
+				total += points
+			}(userID, flag)
+		}
+		close(start)
+		wg.Wait()
+
+		if len(errList) > 0 {
+			t.Fatalf("SubmitFlag() errors = %v", errList)
+		}
+		if total != 350 {
+			t.Errorf("SubmitFlag() total points = %v, want 350", total)
+		}
+
+		solved, _ := submissionRepo.FindSolvedChallengeIDs(ctx, "", "team1")
+		if !solved["1"] {
+			t.Fatalf("challenge should be solved after both parts are solved concurrently")
+		}
+	}
+}
+
+func TestAdminServiceUsecase_InvalidateSubmission_CompletingPart(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	submissionRepo := NewMockSubmissionRepository()
+	partSolveRepo := NewMockFlagPartSolveRepository()
+	submissionRepo.partSolveRepo = partSolveRepo
+	submissionRepo.challengeRepo = challengeRepo
+
+	challengeRepo.Create(ctx, &domain.Challenge{
+		ChallengeID: "1",
+		Points:      50,
+		Parts: []*domain.FlagPart{
+			{PartID: "p1", ChallengeID: "1", Name: "user", Flag: "flag{user}", Points: 100, Position: 1},
+			{PartID: "p2", ChallengeID: "1", Name: "root", Flag: "flag{root}", Points: 200, Position: 2},
+		},
+	})
+
+	client := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      NewMockEventConfigRepository(),
+		partSolveRepo:  partSolveRepo,
+	}
+	admin := &AdminServiceUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: submissionRepo,
+	}
+
+	for _, flag := range []string{"flag{user}", "flag{root}"} {
+		if _, _, err := client.SubmitFlag(ctx, "user1", "1", flag); err != nil {
+			t.Fatalf("SubmitFlag() error = %v", err)
+		}
+	}
+
+	submissions, _ := submissionRepo.FindByUserAndChallenge(ctx, "user1", "1")
+	var solveID string
+	for _, s := range submissions {
+		if s.IsSolve() {
+			solveID = s.SubmissionID
+		}
+	}
+	if solveID == "" {
+		t.Fatalf("no solve submission recorded")
+	}
+
+	if err := admin.InvalidateSubmission(ctx, solveID); err != nil {
+		t.Fatalf("InvalidateSubmission() error = %v", err)
+	}
+
+	// 最後の部分フラグの正解記録も取り消され、最初の部分は解いたまま残る
+	solvedParts, _ := partSolveRepo.FindSolvedPartIDs(ctx, "user1")
+	if !solvedParts["p1"] || solvedParts["p2"] {
+		t.Errorf("solved parts after invalidation = %v, want only p1", solvedParts)
+	}
+
+	isCorrect, points, err := client.SubmitFlag(ctx, "user1", "1", "flag{root}")
+	if err != nil {
+		t.Fatalf("SubmitFlag() after invalidation error = %v", err)
+	}
+	if !isCorrect || points != 250 {
+		t.Errorf("SubmitFlag() after invalidation = (%v, %v), want (true, 250)", isCorrect, points)
+	}
+
+	solved, _ := submissionRepo.FindSolvedChallengeIDs(ctx, "user1", "")
+	if !solved["1"] {
+		t.Errorf("challenge should be solved again after resubmitting the last part")
+	}
+}
+
+func TestClientChallengeUsecase_GetChallenges_PartProgress(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	partSolveRepo := NewMockFlagPartSolveRepository()
+
+	challengeRepo.Create(ctx, &domain.Challenge{
+		ChallengeID: "1",
+		Parts: []*domain.FlagPart{
+			{PartID: "p1", ChallengeID: "1", Name: "user", Flag: "flag{user}", Points: 100, Position: 1},
+			{PartID: "p2", ChallengeID: "1", Name: "root", Flag: "flag{root}", Points: 200, Position: 2},
+		},
+	})
+	partSolveRepo.Create(ctx, &domain.FlagPartSolve{PartID: "p1", ChallengeID: "1", UserID: "user1", OwnerID: "user1"})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: NewMockSubmissionRepository(),
+		eventRepo:      NewMockEventConfigRepository(),
+		partSolveRepo:  partSolveRepo,
+	}
+
+	challenges, err := uc.GetChallenges(ctx, "user1")
+	if err != nil {
+		t.Fatalf("GetChallenges() error = %v", err)
+	}
+	if len(challenges) != 1 || len(challenges[0].Parts) != 2 {
+		t.Fatalf("GetChallenges() returned unexpected challenges: %+v", challenges)
+	}
+
+	for _, p := range challenges[0].Parts {
+		if p.Flag != "" {
+			t.Errorf("GetChallenges() part %s flag = %v, want empty", p.Name, p.Flag)
+		}
+		if wantSolved := p.PartID == "p1"; p.Solved != wantSolved {
+			t.Errorf("GetChallenges() part %s solved = %v, want %v", p.Name, p.Solved, wantSolved)
+		}
+	}
+
+	// リポジトリの部分フラグは書き換えない
+	if challengeRepo.challenges["1"].Parts[0].Flag != "flag{user}" {
+		t.Errorf("GetChallenges() modified stored part flag")
+	}
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+// GetHints は問題のヒント一覧と公開済みのヒントIDを返す。未公開のヒントは内容を空にする
+func (u *ClientChallengeUsecase) GetHints(ctx context.Context, userID, challengeID string) ([]*domain.Hint, map[string]bool, error) {
+	if _, err := u.findReleasedChallenge(ctx, challengeID); err != nil {
+		return nil, nil, err
+	}
+
+	teamID, err := u.resolveTeamID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hints, err := u.hintRepo.FindByChallengeID(ctx, challengeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unlocked, err := u.hintRepo.FindUnlockedHintIDs(ctx, challengeID, flagOwnerID(userID, teamID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, h := range hints {
+		if !unlocked[h.HintID] {
+			h.Content = ""
+		}
+	}
+
+	return hints, unlocked, nil
+}
+
+// UnlockHint はヒントを公開する。ヒントは順番に公開する必要があり、公開済みの場合は何もしない
+func (u *ClientChallengeUsecase) UnlockHint(ctx context.Context, userID, hintID string) (*domain.Hint, error) {
+	if err := u.checkEventRunning(ctx); err != nil {
+		return nil, err
+	}
+
+	hint, err := u.hintRepo.FindByID(ctx, hintID)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := u.findReleasedChallenge(ctx, hint.ChallengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	teamID, err := u.resolveTeamID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.checkUnlocked(ctx, challenge, userID, teamID); err != nil {
+		return nil, err
+	}
+	ownerID := flagOwnerID(userID, teamID)
+
+	unlocked, err := u.hintRepo.FindUnlockedHintIDs(ctx, hint.ChallengeID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if unlocked[hint.HintID] {
+		return hint, nil
+	}
+
+	hints, err := u.hintRepo.FindByChallengeID(ctx, hint.ChallengeID)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range hints {
+		if h.HintID == hint.HintID {
+			break
+		}
+		if !unlocked[h.HintID] {
+			return nil, domain.ErrPreviousHintLocked
+		}
+	}
+
+	unlock := &domain.HintUnlock{
+		UnlockID:   uuid.New().String(),
+		HintID:     hint.HintID,
+		UserID:     userID,
+		TeamID:     teamID,
+		OwnerID:    ownerID,
+		UnlockedAt: time.Now(),
+	}
+	if err := u.hintRepo.CreateUnlock(ctx, unlock); err != nil {
+		return nil, err
+	}
+
+	return hint, nil
+}
+
+// CreateHint は問題の末尾にヒントを追加する
+func (u *AdminServiceUsecase) CreateHint(ctx context.Context, challengeID, content string, cost int) (string, error) {
+	if _, err := u.challengeRepo.FindByID(ctx, challengeID); err != nil {
+		return "", err
+	}
+
+	hints, err := u.hintRepo.FindByChallengeID(ctx, challengeID)
+	if err != nil {
+		return "", err
+	}
+
+	position := 1
+	if len(hints) > 0 {
+		position = hints[len(hints)-1].Position + 1
+	}
+
+	hint := &domain.Hint{
+		HintID:      uuid.New().String(),
+		ChallengeID: challengeID,
+		Content:     content,
+		Cost:        cost,
+		Position:    position,
+		CreatedAt:   time.Now(),
+	}
+	if err := hint.Validate(); err != nil {
+		return "", err
+	}
+
+	if err := u.hintRepo.Create(ctx, hint); err != nil {
+		return "", err
+	}
+
+	return hint.HintID, nil
+}
+
+// UpdateHint はヒントの内容・コスト・順番を更新する。position が0の場合は順番を変えない
+func (u *AdminServiceUsecase) UpdateHint(ctx context.Context, hint *domain.Hint) error {
+	existing, err := u.hintRepo.FindByID(ctx, hint.HintID)
+	if err != nil {
+		return err
+	}
+
+	existing.Content = hint.Content
+	existing.Cost = hint.Cost
+	if hint.Position != 0 {
+		existing.Position = hint.Position
+	}
+	if err := existing.Validate(); err != nil {
+		return err
+	}
+
+	return u.hintRepo.Update(ctx, existing)
+}
+
+func (u *AdminServiceUsecase) DeleteHint(ctx context.Context, hintID string) error {
+	return u.hintRepo.Delete(ctx, hintID)
+}
+
+func (u *AdminServiceUsecase) ListHints(ctx context.Context, challengeID string) ([]*domain.Hint, error) {
+	return u.hintRepo.FindByChallengeID(ctx, challengeID)
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MockHintRepository struct {
+	hints   map[string]*domain.Hint
+	unlocks map[string]*domain.HintUnlock
+}
+
+func NewMockHintRepository() *MockHintRepository {
+	return &MockHintRepository{
+		hints:   make(map[string]*domain.Hint),
+		unlocks: make(map[string]*domain.HintUnlock),
+	}
+}
+
+func (m *MockHintRepository) Create(ctx context.Context, hint *domain.Hint) error {
+	m.hints[hint.HintID] = hint
+	return nil
+}
+
+func (m *MockHintRepository) FindByID(ctx context.Context, hintID string) (*domain.Hint, error) {
+	hint, exists := m.hints[hintID]
+	if !exists {
+		return nil, domain.ErrHintNotFound
+	}
+	copied := *hint
+	return &copied, nil
+}
+
+func (m *MockHintRepository) FindByChallengeID(ctx context.Context, challengeID string) ([]*domain.Hint, error) {
+	var result []*domain.Hint
+	for _, h := range m.hints {
+		if h.ChallengeID == challengeID {
+			copied := *h
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Position < result[j].Position
+	})
+	return result, nil
+}
+
+func (m *MockHintRepository) Update(ctx context.Context, hint *domain.Hint) error {
+	if _, exists := m.hints[hint.HintID]; !exists {
+		return domain.ErrHintNotFound
+	}
+	m.hints[hint.HintID] = hint
+	return nil
+}
+
+func (m *MockHintRepository) Delete(ctx context.Context, hintID string) error {
+	if _, exists := m.hints[hintID]; !exists {
+		return domain.ErrHintNotFound
+	}
+	delete(m.hints, hintID)
+	return nil
+}
+
+func (m *MockHintRepository) CreateUnlock(ctx context.Context, unlock *domain.HintUnlock) error {
+	key := unlock.HintID + "/" + unlock.OwnerID
+	if _, exists := m.unlocks[key]; !exists {
+		m.unlocks[key] = unlock
+	}
+	return nil
+}
+
+func (m *MockHintRepository) FindUnlockedHintIDs(ctx context.Context, challengeID, ownerID string) (map[string]bool, error) {
+	unlocked := make(map[string]bool)
+	for _, u := range m.unlocks {
+		hint, exists := m.hints[u.HintID]
+		if exists && hint.ChallengeID == challengeID && u.OwnerID == ownerID {
+			unlocked[u.HintID] = true
+		}
+	}
+	return unlocked, nil
+}
+
+func TestClientChallengeUsecase_UnlockHint(t *testing.T) {
+	ctx := context.Background()
+	hintRepo := NewMockHintRepository()
+	hintRepo.Create(ctx, &domain.Hint{HintID: "h1", ChallengeID: "c1", Content: "first", Cost: 10, Position: 1})
+	hintRepo.Create(ctx, &domain.Hint{HintID: "h2", ChallengeID: "c1", Content: "second", Cost: 20, Position: 2})
+
+	challengeRepo := NewMockChallengeRepository()
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "c1", Name: "Challenge 1", Flag: "flag{x}"})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: NewMockSubmissionRepository(),
+		eventRepo:      NewMockEventConfigRepository(),
+		hintRepo:       hintRepo,
+	}
+
+	if _, err := uc.UnlockHint(ctx, "user1", "h2"); err != domain.ErrPreviousHintLocked {
+		t.Errorf("UnlockHint() out of order error = %v, want %v", err, domain.ErrPreviousHintLocked)
+	}
+
+	hint, err := uc.UnlockHint(ctx, "user1", "h1")
+	if err != nil {
+		t.Fatalf("UnlockHint() error = %v", err)
+	}
+	if hint.Content != "first" {
+		t.Errorf("UnlockHint() content = %v, want %v", hint.Content, "first")
+	}
+
+	if _, err := uc.UnlockHint(ctx, "user1", "h1"); err != nil {
+		t.Errorf("UnlockHint() twice error = %v, want nil", err)
+	}
+	if len(hintRepo.unlocks) != 1 {
+		t.Errorf("UnlockHint() unlocks = %v, want 1", len(hintRepo.unlocks))
+	}
+
+	if _, err := uc.UnlockHint(ctx, "user1", "h2"); err != nil {
+		t.Errorf("UnlockHint() error = %v", err)
+	}
+
+	if _, err := uc.UnlockHint(ctx, "user1", "unknown"); err != domain.ErrHintNotFound {
+		t.Errorf("UnlockHint() unknown error = %v, want %v", err, domain.ErrHintNotFound)
+	}
+}
+
+func TestClientChallengeUsecase_GetHints(t *testing.T) {
+	ctx := context.Background()
+	hintRepo := NewMockHintRepository()
+	hintRepo.Create(ctx, &domain.Hint{HintID: "h1", ChallengeID: "c1", Content: "first", Cost: 10, Position: 1})
+	hintRepo.Create(ctx, &domain.Hint{HintID: "h2", ChallengeID: "c1", Content: "second", Cost: 20, Position: 2})
+
+	challengeRepo := NewMockChallengeRepository()
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "c1", Name: "Challenge 1", Flag: "flag{x}"})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: NewMockSubmissionRepository(),
+		eventRepo:      NewMockEventConfigRepository(),
+		hintRepo:       hintRepo,
+	}
+
+	if _, err := uc.UnlockHint(ctx, "user1", "h1"); err != nil {
+		t.Fatalf("UnlockHint() error = %v", err)
+	}
+
+	hints, unlocked, err := uc.GetHints(ctx, "user1", "c1")
+	if err != nil {
+		t.Fatalf("GetHints() error = %v", err)
+	}
+	if len(hints) != 2 {
+		t.Fatalf("GetHints() returned %d hints, want 2", len(hints))
+	}
+	if !unlocked["h1"] || hints[0].Content != "first" {
+		t.Errorf("GetHints() unlocked hint = %+v, want content visible", hints[0])
+	}
+	if unlocked["h2"] || hints[1].Content != "" {
+		t.Errorf("GetHints() locked hint = %+v, want content hidden", hints[1])
+	}
+
+	// 他のユーザーには公開されていない
+	_, unlocked, err = uc.GetHints(ctx, "user2", "c1")
+	if err != nil {
+		t.Fatalf("GetHints() error = %v", err)
+	}
+	if unlocked["h1"] {
+		t.Errorf("GetHints() hint unlocked for another user")
+	}
+}
+
+func TestAdminServiceUsecase_CreateHint(t *testing.T) {
+	ctx := context.Background()
+	challengeRepo := NewMockChallengeRepository()
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "c1", Name: "Challenge 1", Flag: "flag{x}"})
+	hintRepo := NewMockHintRepository()
+
+	uc := &AdminServiceUsecase{
+		challengeRepo: challengeRepo,
+		hintRepo:      hintRepo,
+	}
+
+	tests := []struct {
+		name         string
+		challengeID  string
+		content      string
+		cost         int
+		wantErr      error
+		wantPosition int
+	}{
+		{name: "first hint", challengeID: "c1", content: "look at the source", cost: 0, wantPosition: 1},
+		{name: "second hint", challengeID: "c1", content: "check the cookie", cost: 50, wantPosition: 2},
+		{name: "empty content", challengeID: "c1", content: "", cost: 0, wantErr: domain.ErrInvalidHintData},
+		{name: "negative cost", challengeID: "c1", content: "x", cost: -1, wantErr: domain.ErrInvalidHintData},
+		{name: "unknown challenge", challengeID: "c2", content: "x", cost: 0, wantErr: domain.ErrChallengeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hintID, err := uc.CreateHint(ctx, tt.challengeID, tt.content, tt.cost)
+			if err != tt.wantErr {
+				t.Fatalf("CreateHint() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got := hintRepo.hints[hintID].Position; got != tt.wantPosition {
+				t.Errorf("CreateHint() position = %v, want %v", got, tt.wantPosition)
+			}
+		})
+	}
+}
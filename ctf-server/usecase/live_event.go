@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+// publishEvent はイベントを配信する。hub が nil の場合は何もしない
+// 配信に失敗しても呼び出し元の処理は成功しているため、ログに残すだけにする
+func publishEvent(ctx context.Context, hub domain.EventHub, event *domain.LiveEvent) {
+	if hub == nil {
+		return
+	}
+	if err := hub.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish live event %s: %v", event.Type, err)
+	}
+}
+
+func newChallengeEvent(challenge *domain.Challenge, now time.Time) *domain.LiveEvent {
+	return &domain.LiveEvent{
+		Type:          domain.LiveEventNewChallenge,
+		ChallengeID:   challenge.ChallengeID,
+		ChallengeName: challenge.Name,
+		OccurredAt:    now,
+	}
+}
+
+// SubscribeEvents は ctx が終了するまで、配信されたイベントごとに handler を呼び出す
+func (u *ClientChallengeUsecase) SubscribeEvents(ctx context.Context, handler func(event *domain.LiveEvent)) error {
+	if u.eventHub == nil {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return u.eventHub.Subscribe(ctx, handler)
+}
+
+// publishSolve は問題を解いたことを配信する。最初に解いた場合は first blood として配信する
+// スコアボードの凍結中は順位が推測できないよう配信しない
+func (u *ClientChallengeUsecase) publishSolve(ctx context.Context, challenge *domain.Challenge, submission *domain.Submission) {
+	if u.eventHub == nil {
+		return
+	}
+
+	event, err := u.eventRepo.Get(ctx)
+	if err != nil {
+		log.Printf("Failed to get event config for live event: %v", err)
+		return
+	}
+	if event.IsFrozen(submission.SubmittedAt) {
+		return
+	}
+
+	liveEvent := &domain.LiveEvent{
+		Type:          domain.LiveEventSolve,
+		ChallengeID:   challenge.ChallengeID,
+		ChallengeName: challenge.Name,
+		UserID:        submission.UserID,
+		TeamID:        submission.TeamID,
+		OccurredAt:    submission.SubmittedAt,
+	}
+	if submission.SolveRank == 1 {
+		liveEvent.Type = domain.LiveEventFirstBlood
+	}
+
+	if user, err := u.userRepo.FindByID(ctx, submission.UserID); err == nil {
+		liveEvent.Username = user.Username
+	}
+	if submission.TeamID != "" {
+		if team, err := u.teamRepo.FindByID(ctx, submission.TeamID); err == nil {
+			liveEvent.TeamName = team.Name
+		}
+	}
+
+	publishEvent(ctx, u.eventHub, liveEvent)
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MockEventHub struct {
+	mu     sync.Mutex
+	events []*domain.LiveEvent
+}
+
+func (m *MockEventHub) Publish(ctx context.Context, event *domain.LiveEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *MockEventHub) Subscribe(ctx context.Context, handler func(event *domain.LiveEvent)) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestClientChallengeUsecase_SubmitFlag_LiveEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		freezeAt  time.Time
+		wantTypes []domain.LiveEventType
+	}{
+		{
+			name:      "first solver is announced as first blood",
+			wantTypes: []domain.LiveEventType{domain.LiveEventFirstBlood, domain.LiveEventSolve},
+		},
+		{
+			name:      "no events while the scoreboard is frozen",
+			freezeAt:  time.Now().Add(-time.Hour),
+			wantTypes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			challengeRepo := NewMockChallengeRepository()
+			challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "1", Name: "warmup", Flag: "flag{test}", Points: 100})
+
+			userRepo := NewMockUserRepository()
+			userRepo.Create(ctx, &domain.User{UserID: "user1", Username: "alice"})
+			userRepo.Create(ctx, &domain.User{UserID: "user2", Username: "bob"})
+
+			eventRepo := NewMockEventConfigRepository()
+			eventRepo.config.FreezeAt = tt.freezeAt
+
+			hub := &MockEventHub{}
+			uc := &ClientChallengeUsecase{
+				challengeRepo:  challengeRepo,
+				submissionRepo: NewMockSubmissionRepository(),
+				eventRepo:      eventRepo,
+				userRepo:       userRepo,
+				eventHub:       hub,
+			}
+
+			for _, userID := range []string{"user1", "user2"} {
+				if _, _, err := uc.SubmitFlag(ctx, userID, "1", "flag{wrong}"); err != nil {
+					t.Fatalf("SubmitFlag() error = %v", err)
+				}
+				if _, _, err := uc.SubmitFlag(ctx, userID, "1", "flag{test}"); err != nil {
+					t.Fatalf("SubmitFlag() error = %v", err)
+				}
+			}
+
+			if len(hub.events) != len(tt.wantTypes) {
+				t.Fatalf("published %d events, want %d", len(hub.events), len(tt.wantTypes))
+			}
+			for i, e := range hub.events {
+				if e.Type != tt.wantTypes[i] {
+					t.Errorf("event[%d].Type = %v, want %v", i, e.Type, tt.wantTypes[i])
+				}
+				if e.ChallengeName != "warmup" {
+					t.Errorf("event[%d].ChallengeName = %v, want warmup", i, e.ChallengeName)
+				}
+			}
+			if len(hub.events) > 0 && hub.events[0].Username != "alice" {
+				t.Errorf("event[0].Username = %v, want alice", hub.events[0].Username)
+			}
+		})
+	}
+}
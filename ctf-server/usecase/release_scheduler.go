@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+const defaultReleaseInterval = 10 * time.Second
+
+// ReleaseScheduler は予約公開の問題を公開時刻になったら公開状態に切り替える
+type ReleaseScheduler struct {
+	challengeRepo domain.ChallengeRepository
+	eventHub      domain.EventHub // nilの場合はイベントを配信しない
+	interval      time.Duration
+}
+
+func NewReleaseScheduler(challengeRepo domain.ChallengeRepository, eventHub domain.EventHub) *ReleaseScheduler {
+	interval := defaultReleaseInterval
+	if v := os.Getenv("RELEASE_SCHEDULER_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			interval = d
+		} else {
+			log.Printf("Invalid RELEASE_SCHEDULER_INTERVAL %q, using %s", v, defaultReleaseInterval)
+		}
+	}
+
+	return &ReleaseScheduler{
+		challengeRepo: challengeRepo,
+		eventHub:      eventHub,
+		interval:      interval,
+	}
+}
+
+// Run は ctx がキャンセルされるまで定期的に予約公開を処理する
+func (s *ReleaseScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ReleaseDue(ctx, time.Now()); err != nil {
+			log.Printf("Failed to release scheduled challenges: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReleaseDue は now の時点で公開時刻を過ぎた問題を公開し、そのIDを返す。公開した問題は新しい問題として配信する
+func (s *ReleaseScheduler) ReleaseDue(ctx context.Context, now time.Time) ([]string, error) {
+	released, err := s.challengeRepo.ReleaseScheduled(ctx, now)
+	for _, id := range released {
+		log.Printf("Released scheduled challenge %s", id)
+
+		if s.eventHub == nil {
+			continue
+		}
+		challenge, err := s.challengeRepo.FindByID(ctx, id)
+		if err != nil {
+			log.Printf("Failed to find released challenge %s: %v", id, err)
+			continue
+		}
+		publishEvent(ctx, s.eventHub, newChallengeEvent(challenge, now))
+	}
+	return released, err
+}
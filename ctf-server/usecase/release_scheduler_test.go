@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+func TestReleaseScheduler_ReleaseDue(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	challengeRepo := NewMockChallengeRepository()
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "due", Flag: "flag{a}", Visibility: domain.VisibilityScheduled, ReleaseAt: now.Add(-time.Minute)})
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "later", Flag: "flag{b}", Visibility: domain.VisibilityScheduled, ReleaseAt: now.Add(time.Hour)})
+	challengeRepo.Create(ctx, &domain.Challenge{ChallengeID: "draft", Flag: "flag{c}", Visibility: domain.VisibilityDraft})
+
+	uc := &ClientChallengeUsecase{
+		challengeRepo:  challengeRepo,
+		submissionRepo: NewMockSubmissionRepository(),
+		eventRepo:      NewMockEventConfigRepository(),
+	}
+
+	challenges, err := uc.GetChallenges(ctx, "user1")
+	if err != nil {
+		t.Fatalf("GetChallenges() error = %v", err)
+	}
+	if len(challenges) != 1 || challenges[0].ChallengeID != "due" {
+		t.Errorf("GetChallenges() = %v challenges, want only the released one", len(challenges))
+	}
+
+	if _, _, err := uc.SubmitFlag(ctx, "user1", "draft", "flag{c}"); err != domain.ErrChallengeNotFound {
+		t.Errorf("SubmitFlag() draft error = %v, want %v", err, domain.ErrChallengeNotFound)
+	}
+
+	hub := &MockEventHub{}
+	scheduler := &ReleaseScheduler{challengeRepo: challengeRepo, eventHub: hub}
+	released, err := scheduler.ReleaseDue(ctx, now)
+	if err != nil {
+		t.Fatalf("ReleaseDue() error = %v", err)
+	}
+	if len(released) != 1 || released[0] != "due" {
+		t.Errorf("ReleaseDue() = %v, want [due]", released)
+	}
+	if got := challengeRepo.challenges["due"].Visibility; got != domain.VisibilityVisible {
+		t.Errorf("ReleaseDue() visibility = %v, want %v", got, domain.VisibilityVisible)
+	}
+	if got := challengeRepo.challenges["later"].Visibility; got != domain.VisibilityScheduled {
+		t.Errorf("ReleaseDue() visibility = %v, want %v", got, domain.VisibilityScheduled)
+	}
+	if len(hub.events) != 1 || hub.events[0].Type != domain.LiveEventNewChallenge || hub.events[0].ChallengeID != "due" {
+		t.Errorf("ReleaseDue() published %v events, want one new_challenge event for due", len(hub.events))
+	}
+}
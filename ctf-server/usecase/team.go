@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type TeamUsecase struct {
+	teamRepo domain.TeamRepository
+}
+
+func NewTeamUsecase(teamRepo domain.TeamRepository) *TeamUsecase {
+	return &TeamUsecase{
+		teamRepo: teamRepo,
+	}
+}
+
+// CreateTeam はチームを作成し、作成者をメンバーとして登録する
+func (u *TeamUsecase) CreateTeam(ctx context.Context, userID, name string) (*domain.Team, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 255 {
+		return nil, domain.ErrInvalidTeamName
+	}
+
+	if err := u.ensureNoTeam(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	_, err := u.teamRepo.FindByName(ctx, name)
+	if err == nil {
+		return nil, domain.ErrTeamAlreadyExists
+	}
+	if err != domain.ErrTeamNotFound {
+		return nil, err
+	}
+
+	inviteCode, err := generateInviteCode()
+	if err != nil {
+		return nil, err
+	}
+
+	team := &domain.Team{
+		TeamID:     uuid.New().String(),
+		Name:       name,
+		InviteCode: inviteCode,
+		CreatedAt:  time.Now(),
+	}
+
+	if err := u.teamRepo.Create(ctx, team, userID); err != nil {
+		return nil, err
+	}
+
+	return u.withMembers(ctx, team)
+}
+
+func (u *TeamUsecase) JoinTeam(ctx context.Context, userID, inviteCode string) (*domain.Team, error) {
+	if err := u.ensureNoTeam(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	team, err := u.teamRepo.FindByInviteCode(ctx, inviteCode)
+	if err != nil {
+		if err == domain.ErrTeamNotFound {
+			return nil, domain.ErrInvalidInviteCode
+		}
+		return nil, err
+	}
+
+	if err := u.teamRepo.AddMember(ctx, team.TeamID, userID, time.Now()); err != nil {
+		// 招待コードを確認した後に最後のメンバーが抜けてチームが削除された
+		if err == domain.ErrTeamNotFound {
+			return nil, domain.ErrInvalidInviteCode
+		}
+		return nil, err
+	}
+
+	return u.withMembers(ctx, team)
+}
+
+// LeaveTeam はチームから脱退する。最後のメンバーが抜けた場合はチームを削除する
+func (u *TeamUsecase) LeaveTeam(ctx context.Context, userID string) error {
+	team, err := u.teamRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrTeamNotFound {
+			return domain.ErrNotInTeam
+		}
+		return err
+	}
+
+	return u.teamRepo.RemoveMember(ctx, team.TeamID, userID)
+}
+
+func (u *TeamUsecase) GetMyTeam(ctx context.Context, userID string) (*domain.Team, error) {
+	team, err := u.teamRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrTeamNotFound {
+			return nil, domain.ErrNotInTeam
+		}
+		return nil, err
+	}
+
+	return u.withMembers(ctx, team)
+}
+
+func (u *TeamUsecase) ensureNoTeam(ctx context.Context, userID string) error {
+	_, err := u.teamRepo.FindByUserID(ctx, userID)
+	if err == nil {
+		return domain.ErrAlreadyInTeam
+	}
+	if err != domain.ErrTeamNotFound {
+		return err
+	}
+	return nil
+}
+
+func (u *TeamUsecase) withMembers(ctx context.Context, team *domain.Team) (*domain.Team, error) {
+	members, err := u.teamRepo.FindMembers(ctx, team.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	team.Members = members
+	return team, nil
+}
+
+func generateInviteCode() (string, error) {
+	bytes := make([]byte, 8)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
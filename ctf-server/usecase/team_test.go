@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+type MockTeamRepository struct {
+	teams   map[string]*domain.Team
+	members map[string]string // userID -> teamID
+}
+
+func NewMockTeamRepository() *MockTeamRepository {
+	return &MockTeamRepository{
+		teams:   make(map[string]*domain.Team),
+		members: make(map[string]string),
+	}
+}
+
+func (m *MockTeamRepository) Create(ctx context.Context, team *domain.Team, ownerID string) error {
+	if _, exists := m.teams[team.TeamID]; exists {
+		return domain.ErrTeamAlreadyExists
+	}
+	if _, exists := m.members[ownerID]; exists {
+		return domain.ErrAlreadyInTeam
+	}
+	m.teams[team.TeamID] = team
+	m.members[ownerID] = team.TeamID
+	return nil
+}
+
+func (m *MockTeamRepository) FindByID(ctx context.Context, teamID string) (*domain.Team, error) {
+	team, exists := m.teams[teamID]
+	if !exists {
+		return nil, domain.ErrTeamNotFound
+	}
+	return team, nil
+}
+
+func (m *MockTeamRepository) FindByName(ctx context.Context, name string) (*domain.Team, error) {
+	for _, team := range m.teams {
+		if team.Name == name {
+			return team, nil
+		}
+	}
+	return nil, domain.ErrTeamNotFound
+}
+
+func (m *MockTeamRepository) FindByInviteCode(ctx context.Context, inviteCode string) (*domain.Team, error) {
+	for _, team := range m.teams {
+		if team.InviteCode == inviteCode {
+			return team, nil
+		}
+	}
+	return nil, domain.ErrTeamNotFound
+}
+
+func (m *MockTeamRepository) FindByUserID(ctx context.Context, userID string) (*domain.Team, error) {
+	teamID, exists := m.members[userID]
+	if !exists {
+		return nil, domain.ErrTeamNotFound
+	}
+	return m.FindByID(ctx, teamID)
+}
+
+func (m *MockTeamRepository) FindMembers(ctx context.Context, teamID string) ([]*domain.TeamMember, error) {
+	result := make([]*domain.TeamMember, 0)
+	for userID, id := range m.members {
+		if id == teamID {
+			result = append(result, &domain.TeamMember{UserID: userID})
+		}
+	}
+	return result, nil
+}
+
+func (m *MockTeamRepository) AddMember(ctx context.Context, teamID, userID string, joinedAt time.Time) error {
+	if _, exists := m.teams[teamID]; !exists {
+		return domain.ErrTeamNotFound
+	}
+	if _, exists := m.members[userID]; exists {
+		return domain.ErrAlreadyInTeam
+	}
+	m.members[userID] = teamID
+	return nil
+}
+
+func (m *MockTeamRepository) RemoveMember(ctx context.Context, teamID, userID string) error {
+	if m.members[userID] != teamID {
+		return domain.ErrNotInTeam
+	}
+	delete(m.members, userID)
+	for _, t := range m.members {
+		if t == teamID {
+			return nil
+		}
+	}
+	delete(m.teams, teamID)
+	return nil
+}
+
+func TestTeamUsecase_CreateTeam(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := NewMockTeamRepository()
+	uc := NewTeamUsecase(teamRepo)
+
+	team, err := uc.CreateTeam(ctx, "user1", "  team one  ")
+	if err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+	if team.Name != "team one" {
+		t.Errorf("CreateTeam() Name = %v, want %v", team.Name, "team one")
+	}
+	if team.InviteCode == "" {
+		t.Error("CreateTeam() InviteCode is empty")
+	}
+	if len(team.Members) != 1 || team.Members[0].UserID != "user1" {
+		t.Errorf("CreateTeam() Members = %v, want [user1]", team.Members)
+	}
+
+	tests := []struct {
+		name    string
+		userID  string
+		team    string
+		wantErr error
+	}{
+		{name: "empty name", userID: "user2", team: " ", wantErr: domain.ErrInvalidTeamName},
+		{name: "duplicate name", userID: "user2", team: "team one", wantErr: domain.ErrTeamAlreadyExists},
+		{name: "already in team", userID: "user1", team: "team two", wantErr: domain.ErrAlreadyInTeam},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.CreateTeam(ctx, tt.userID, tt.team)
+			if err != tt.wantErr {
+				t.Errorf("CreateTeam() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTeamUsecase_JoinTeam(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := NewMockTeamRepository()
+	uc := NewTeamUsecase(teamRepo)
+
+	created, err := uc.CreateTeam(ctx, "user1", "team one")
+	if err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		userID     string
+		inviteCode string
+		wantErr    error
+	}{
+		{name: "valid invite code", userID: "user2", inviteCode: created.InviteCode, wantErr: nil},
+		{name: "invalid invite code", userID: "user3", inviteCode: "invalid", wantErr: domain.ErrInvalidInviteCode},
+		{name: "already in team", userID: "user1", inviteCode: created.InviteCode, wantErr: domain.ErrAlreadyInTeam},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			team, err := uc.JoinTeam(ctx, tt.userID, tt.inviteCode)
+			if err != tt.wantErr {
+				t.Errorf("JoinTeam() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && team.TeamID != created.TeamID {
+				t.Errorf("JoinTeam() TeamID = %v, want %v", team.TeamID, created.TeamID)
+			}
+		})
+	}
+}
+
+func TestTeamUsecase_LeaveTeam(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := NewMockTeamRepository()
+	uc := NewTeamUsecase(teamRepo)
+
+	created, _ := uc.CreateTeam(ctx, "user1", "team one")
+	uc.JoinTeam(ctx, "user2", created.InviteCode)
+
+	if err := uc.LeaveTeam(ctx, "user1"); err != nil {
+		t.Fatalf("LeaveTeam() error = %v", err)
+	}
+	if _, err := teamRepo.FindByID(ctx, created.TeamID); err != nil {
+		t.Errorf("team should remain while it has members, got error = %v", err)
+	}
+
+	if err := uc.LeaveTeam(ctx, "user1"); err != domain.ErrNotInTeam {
+		t.Errorf("LeaveTeam() error = %v, want %v", err, domain.ErrNotInTeam)
+	}
+
+	if err := uc.LeaveTeam(ctx, "user2"); err != nil {
+		t.Fatalf("LeaveTeam() error = %v", err)
+	}
+	if _, err := teamRepo.FindByID(ctx, created.TeamID); err != domain.ErrTeamNotFound {
+		t.Errorf("team should be deleted after the last member leaves, got error = %v", err)
+	}
+}
@@ -31,6 +31,9 @@ func (u *UserAuthUsecase) Register(ctx context.Context, username, password strin
 	if password == "" {
 		return "", fmt.Errorf("password is required")
 	}
+	if err := domain.ValidatePassword(password); err != nil {
+		return "", err
+	}
 
 	_, err := u.userRepo.FindByUsername(ctx, username)
 	if err == nil {
@@ -73,6 +76,9 @@ func (u *UserAuthUsecase) Login(ctx context.Context, username, password string)
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
 		return "", domain.ErrInvalidPassword
 	}
+	if user.IsBanned() {
+		return "", domain.ErrUserBanned
+	}
 
 	token, err := generateToken()
 	if err != nil {
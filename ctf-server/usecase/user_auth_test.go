@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"errors"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -44,11 +46,38 @@ func (m *MockUserRepository) FindByUsername(ctx context.Context, username string
 	return nil, domain.ErrUserNotFound
 }
 
-func (m *MockUserRepository) Update(ctx context.Context, user *domain.User) error {
-	if _, exists := m.users[user.UserID]; !exists {
+func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
+	for _, user := range m.users {
+		if user.Email != "" && strings.EqualFold(user.Email, email) {
+			return user, nil
+		}
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+func (m *MockUserRepository) UpdateProfile(ctx context.Context, user *domain.User) error {
+	stored, exists := m.users[user.UserID]
+	if !exists {
 		return domain.ErrUserNotFound
 	}
-	m.users[user.UserID] = user
+	for _, other := range m.users {
+		if other.UserID != user.UserID && user.Email != "" && strings.EqualFold(other.Email, user.Email) {
+			return domain.ErrEmailAlreadyExists
+		}
+	}
+	stored.Email = user.Email
+	stored.DisplayName = user.DisplayName
+	stored.Affiliation = user.Affiliation
+	stored.Country = user.Country
+	return nil
+}
+
+func (m *MockUserRepository) UpdatePassword(ctx context.Context, userID, passwordHash string) error {
+	user, exists := m.users[userID]
+	if !exists {
+		return domain.ErrUserNotFound
+	}
+	user.PasswordHash = passwordHash
 	return nil
 }
 
@@ -60,6 +89,82 @@ func (m *MockUserRepository) Delete(ctx context.Context, userID string) error {
 	return nil
 }
 
+func (m *MockUserRepository) FindStaff(ctx context.Context) ([]*domain.User, error) {
+	var staff []*domain.User
+	for _, user := range m.users {
+		if user.Role != domain.RoleNone {
+			staff = append(staff, user)
+		}
+	}
+	return staff, nil
+}
+
+func (m *MockUserRepository) countSuperadmins() int {
+	count := 0
+	for _, user := range m.users {
+		if user.Role == domain.RoleSuperadmin {
+			count++
+		}
+	}
+	return count
+}
+
+func (m *MockUserRepository) SetRole(ctx context.Context, userID string, role domain.Role) error {
+	user, exists := m.users[userID]
+	if !exists {
+		return domain.ErrUserNotFound
+	}
+	if user.Role == domain.RoleSuperadmin && role != domain.RoleSuperadmin && m.countSuperadmins() == 1 {
+		return domain.ErrLastAdmin
+	}
+	user.Role = role
+	return nil
+}
+
+func (m *MockUserRepository) BootstrapAdmin(ctx context.Context, userID string) error {
+	if m.countSuperadmins() > 0 {
+		return domain.ErrAdminAlreadyExists
+	}
+	return m.SetRole(ctx, userID, domain.RoleSuperadmin)
+}
+
+func (m *MockUserRepository) FindUsers(ctx context.Context, filter *domain.UserFilter) ([]*domain.User, error) {
+	var result []*domain.User
+	for _, user := range m.users {
+		if !strings.Contains(user.Username, filter.Query) {
+			continue
+		}
+		if filter.After != nil && !isOlderUser(user, filter.After) {
+			continue
+		}
+		result = append(result, user)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return isOlderUser(result[j], domain.NewUserCursor(result[i]))
+	})
+	if len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+	return result, nil
+}
+
+func isOlderUser(user *domain.User, cursor *domain.UserCursor) bool {
+	if !user.CreatedAt.Equal(cursor.CreatedAt) {
+		return user.CreatedAt.Before(cursor.CreatedAt)
+	}
+	return user.UserID < cursor.UserID
+}
+
+func (m *MockUserRepository) SetBanned(ctx context.Context, userID string, bannedAt time.Time) error {
+	user, exists := m.users[userID]
+	if !exists {
+		return domain.ErrUserNotFound
+	}
+	user.BannedAt = bannedAt
+	return nil
+}
+
 type MockSessionRepository struct {
 	sessions map[string]*domain.Session
 }
@@ -108,6 +213,24 @@ func (m *MockSessionRepository) DeleteByUserID(ctx context.Context, userID strin
 	return nil
 }
 
+func (m *MockSessionRepository) DeleteOthersByUserID(ctx context.Context, userID, sessionID string) error {
+	for token, session := range m.sessions {
+		if session.UserID == userID && session.SessionID != sessionID {
+			delete(m.sessions, token)
+		}
+	}
+	return nil
+}
+
+func (m *MockSessionRepository) RevokeAdminByUserID(ctx context.Context, userID string) error {
+	for _, session := range m.sessions {
+		if session.UserID == userID {
+			session.IsAdmin = false
+		}
+	}
+	return nil
+}
+
 func TestUserAuthUsecase_Register(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -133,6 +256,12 @@ func TestUserAuthUsecase_Register(t *testing.T) {
 			password: "",
 			wantErr:  true,
 		},
+		{
+			name:     "short password",
+			username: "testuser",
+			password: "pass",
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -173,6 +302,12 @@ func TestUserAuthUsecase_Login(t *testing.T) {
 		t.Fatalf("Failed to register test user: %v", err)
 	}
 
+	bannedID, err := uc.Register(ctx, "banneduser", password)
+	if err != nil {
+		t.Fatalf("Failed to register banned user: %v", err)
+	}
+	userRepo.SetBanned(ctx, bannedID, time.Now())
+
 	tests := []struct {
 		name     string
 		username string
@@ -197,6 +332,12 @@ func TestUserAuthUsecase_Login(t *testing.T) {
 			password: password,
 			wantErr:  true,
 		},
+		{
+			name:     "banned user",
+			username: "banneduser",
+			password: password,
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
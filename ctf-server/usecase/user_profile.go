@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (u *UserAuthUsecase) GetProfile(ctx context.Context, userID string) (*domain.User, error) {
+	return u.userRepo.FindByID(ctx, userID)
+}
+
+// UpdateProfile はプロフィールを検証して置き換える。メールアドレスは他のユーザーと重複できない
+func (u *UserAuthUsecase) UpdateProfile(ctx context.Context, userID string, profile *domain.UserProfile) (*domain.User, error) {
+	profile.Normalize()
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.Email != "" {
+		other, err := u.userRepo.FindByEmail(ctx, profile.Email)
+		if err == nil && other.UserID != userID {
+			return nil, domain.ErrEmailAlreadyExists
+		}
+		if err != nil && err != domain.ErrUserNotFound {
+			return nil, err
+		}
+	}
+
+	user.SetProfile(profile)
+	if err := u.userRepo.UpdateProfile(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ChangePassword は現在のパスワードを確認してから変更し、session 以外のセッションをすべて削除する
+func (u *UserAuthUsecase) ChangePassword(ctx context.Context, session *domain.Session, currentPassword, newPassword string) error {
+	if err := domain.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+	if currentPassword == newPassword {
+		return domain.ErrPasswordUnchanged
+	}
+
+	user, err := u.userRepo.FindByID(ctx, session.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(currentPassword)); err != nil {
+		return domain.ErrInvalidPassword
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	if err := u.userRepo.UpdatePassword(ctx, user.UserID, string(hashedPassword)); err != nil {
+		return err
+	}
+
+	return u.sessionRepo.DeleteOthersByUserID(ctx, user.UserID, session.SessionID)
+}
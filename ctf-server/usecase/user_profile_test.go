@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kavos113/quickctf/ctf-server/domain"
+)
+
+func TestUserAuthUsecase_UpdateProfile(t *testing.T) {
+	ctx := context.Background()
+	userRepo := NewMockUserRepository()
+	uc := NewUserAuthUsecase(userRepo, NewMockSessionRepository())
+
+	aliceID, _ := uc.Register(ctx, "alice", "password123")
+	bobID, _ := uc.Register(ctx, "bob", "password123")
+
+	user, err := uc.UpdateProfile(ctx, aliceID, &domain.UserProfile{
+		Email:       "alice@example.com",
+		DisplayName: " Alice ",
+		Country:     "jp",
+	})
+	if err != nil {
+		t.Fatalf("UpdateProfile() error = %v", err)
+	}
+	if user.DisplayName != "Alice" || user.Country != "JP" {
+		t.Errorf("UpdateProfile() = %+v, want normalized profile", user)
+	}
+
+	// 自分のメールアドレスはそのまま保存できる
+	if _, err := uc.UpdateProfile(ctx, aliceID, &domain.UserProfile{Email: "alice@example.com", Affiliation: "team"}); err != nil {
+		t.Errorf("UpdateProfile() keeping own email error = %v", err)
+	}
+	if _, err := uc.UpdateProfile(ctx, bobID, &domain.UserProfile{Email: "Alice@Example.com"}); err != domain.ErrEmailAlreadyExists {
+		t.Errorf("UpdateProfile() duplicate email error = %v, want %v", err, domain.ErrEmailAlreadyExists)
+	}
+	if _, err := uc.UpdateProfile(ctx, bobID, &domain.UserProfile{Country: "Japan"}); err != domain.ErrInvalidCountry {
+		t.Errorf("UpdateProfile() invalid country error = %v, want %v", err, domain.ErrInvalidCountry)
+	}
+
+	profile, err := uc.GetProfile(ctx, aliceID)
+	if err != nil {
+		t.Fatalf("GetProfile() error = %v", err)
+	}
+	want := domain.UserProfile{Email: "alice@example.com", Affiliation: "team"}
+	if *profile.Profile() != want {
+		t.Errorf("GetProfile() = %+v, want %+v", *profile.Profile(), want)
+	}
+}
+
+func TestUserAuthUsecase_ChangePassword(t *testing.T) {
+	ctx := context.Background()
+	userRepo := NewMockUserRepository()
+	sessionRepo := NewMockSessionRepository()
+	uc := NewUserAuthUsecase(userRepo, sessionRepo)
+
+	uc.Register(ctx, "alice", "password123")
+	current, _ := uc.Login(ctx, "alice", "password123")
+	other, _ := uc.Login(ctx, "alice", "password123")
+	session, _ := sessionRepo.FindByToken(ctx, current)
+
+	tests := []struct {
+		name            string
+		currentPassword string
+		newPassword     string
+		wantErr         error
+	}{
+		{
+			name:            "wrong current password",
+			currentPassword: "wrongpassword",
+			newPassword:     "newpassword",
+			wantErr:         domain.ErrInvalidPassword,
+		},
+		{
+			name:            "too short",
+			currentPassword: "password123",
+			newPassword:     "short",
+			wantErr:         domain.ErrPasswordTooShort,
+		},
+		{
+			name:            "unchanged",
+			currentPassword: "password123",
+			newPassword:     "password123",
+			wantErr:         domain.ErrPasswordUnchanged,
+		},
+		{
+			name:            "changed",
+			currentPassword: "password123",
+			newPassword:     "newpassword",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := uc.ChangePassword(ctx, session, tt.currentPassword, tt.newPassword); err != tt.wantErr {
+				t.Errorf("ChangePassword() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := uc.ValidateToken(ctx, current); err != nil {
+		t.Errorf("ValidateToken() current session error = %v", err)
+	}
+	if _, err := uc.ValidateToken(ctx, other); err != domain.ErrSessionNotFound {
+		t.Errorf("ValidateToken() other session error = %v, want %v", err, domain.ErrSessionNotFound)
+	}
+	if _, err := uc.Login(ctx, "alice", "newpassword"); err != nil {
+		t.Errorf("Login() with new password error = %v", err)
+	}
+}
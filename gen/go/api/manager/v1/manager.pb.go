@@ -77,6 +77,7 @@ type StartInstanceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ImageTag      string                 `protobuf:"bytes,1,opt,name=image_tag,json=imageTag,proto3" json:"image_tag,omitempty"`
 	TtlSeconds    int64                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	Flag          string                 `protobuf:"bytes,4,opt,name=flag,proto3" json:"flag,omitempty"` // optional, injected into the container when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -125,6 +126,13 @@ func (x *StartInstanceRequest) GetTtlSeconds() int64 {
 	return 0
 }
 
+func (x *StartInstanceRequest) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
 type StartInstanceResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Status         string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -625,11 +633,12 @@ var File_api_manager_v1_manager_proto protoreflect.FileDescriptor
 
 const file_api_manager_v1_manager_proto_rawDesc = "" +
 	"\n" +
-	"\x1capi/manager/v1/manager.proto\x12\x0eapi.manager.v1\"T\n" +
+	"\x1capi/manager/v1/manager.proto\x12\x0eapi.manager.v1\"h\n" +
 	"\x14StartInstanceRequest\x12\x1b\n" +
 	"\timage_tag\x18\x01 \x01(\tR\bimageTag\x12\x1f\n" +
 	"\vttl_seconds\x18\x03 \x01(\x03R\n" +
-	"ttlSeconds\"\xbe\x01\n" +
+	"ttlSeconds\x12\x12\n" +
+	"\x04flag\x18\x04 \x01(\tR\x04flag\"\xbe\x01\n" +
 	"\x15StartInstanceResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12#\n" +
 	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12\x1f\n" +
@@ -77,6 +77,7 @@ type StartInstanceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ImageTag      string                 `protobuf:"bytes,1,opt,name=image_tag,json=imageTag,proto3" json:"image_tag,omitempty"`
 	ContainerName string                 `protobuf:"bytes,2,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	Flag          string                 `protobuf:"bytes,3,opt,name=flag,proto3" json:"flag,omitempty"` // optional, injected into the container when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -125,6 +126,13 @@ func (x *StartInstanceRequest) GetContainerName() string {
 	return ""
 }
 
+func (x *StartInstanceRequest) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
 type StartInstanceResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Status         string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -625,10 +633,11 @@ var File_api_runner_v1_runner_proto protoreflect.FileDescriptor
 
 const file_api_runner_v1_runner_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/runner/v1/runner.proto\x12\rapi.runner.v1\"Z\n" +
+	"\x1aapi/runner/v1/runner.proto\x12\rapi.runner.v1\"n\n" +
 	"\x14StartInstanceRequest\x12\x1b\n" +
 	"\timage_tag\x18\x01 \x01(\tR\bimageTag\x12%\n" +
-	"\x0econtainer_name\x18\x02 \x01(\tR\rcontainerName\"\xbf\x01\n" +
+	"\x0econtainer_name\x18\x02 \x01(\tR\rcontainerName\x12\x12\n" +
+	"\x04flag\x18\x03 \x01(\tR\x04flag\"\xbf\x01\n" +
 	"\x15StartInstanceResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12#\n" +
 	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12!\n" +
@@ -76,6 +76,266 @@ func (BuildStatus) EnumDescriptor() ([]byte, []int) {
 	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{0}
 }
 
+type FlagSharingReason int32
+
+const (
+	FlagSharingReason_FLAG_SHARING_REASON_UNSPECIFIED       FlagSharingReason = 0
+	FlagSharingReason_FLAG_SHARING_REASON_SAME_WRONG_ANSWER FlagSharingReason = 1
+	FlagSharingReason_FLAG_SHARING_REASON_CLOSE_SOLVES      FlagSharingReason = 2
+)
+
+// Enum value maps for FlagSharingReason.
+var (
+	FlagSharingReason_name = map[int32]string{
+		0: "FLAG_SHARING_REASON_UNSPECIFIED",
+		1: "FLAG_SHARING_REASON_SAME_WRONG_ANSWER",
+		2: "FLAG_SHARING_REASON_CLOSE_SOLVES",
+	}
+	FlagSharingReason_value = map[string]int32{
+		"FLAG_SHARING_REASON_UNSPECIFIED":       0,
+		"FLAG_SHARING_REASON_SAME_WRONG_ANSWER": 1,
+		"FLAG_SHARING_REASON_CLOSE_SOLVES":      2,
+	}
+)
+
+func (x FlagSharingReason) Enum() *FlagSharingReason {
+	p := new(FlagSharingReason)
+	*p = x
+	return p
+}
+
+func (x FlagSharingReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlagSharingReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_v1_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (FlagSharingReason) Type() protoreflect.EnumType {
+	return &file_api_server_v1_admin_proto_enumTypes[1]
+}
+
+func (x FlagSharingReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlagSharingReason.Descriptor instead.
+func (FlagSharingReason) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type StaffRole int32
+
+const (
+	StaffRole_STAFF_ROLE_UNSPECIFIED StaffRole = 0
+	StaffRole_STAFF_ROLE_SUPERADMIN  StaffRole = 1 // full access
+	StaffRole_STAFF_ROLE_AUTHOR      StaffRole = 2 // manages only the challenges they created
+	StaffRole_STAFF_ROLE_SUPPORT     StaffRole = 3 // views users and submissions
+)
+
+// Enum value maps for StaffRole.
+var (
+	StaffRole_name = map[int32]string{
+		0: "STAFF_ROLE_UNSPECIFIED",
+		1: "STAFF_ROLE_SUPERADMIN",
+		2: "STAFF_ROLE_AUTHOR",
+		3: "STAFF_ROLE_SUPPORT",
+	}
+	StaffRole_value = map[string]int32{
+		"STAFF_ROLE_UNSPECIFIED": 0,
+		"STAFF_ROLE_SUPERADMIN":  1,
+		"STAFF_ROLE_AUTHOR":      2,
+		"STAFF_ROLE_SUPPORT":     3,
+	}
+)
+
+func (x StaffRole) Enum() *StaffRole {
+	p := new(StaffRole)
+	*p = x
+	return p
+}
+
+func (x StaffRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StaffRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_v1_admin_proto_enumTypes[2].Descriptor()
+}
+
+func (StaffRole) Type() protoreflect.EnumType {
+	return &file_api_server_v1_admin_proto_enumTypes[2]
+}
+
+func (x StaffRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StaffRole.Descriptor instead.
+func (StaffRole) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+type AuditTargetType int32
+
+const (
+	AuditTargetType_AUDIT_TARGET_TYPE_UNSPECIFIED  AuditTargetType = 0
+	AuditTargetType_AUDIT_TARGET_TYPE_CHALLENGE    AuditTargetType = 1
+	AuditTargetType_AUDIT_TARGET_TYPE_HINT         AuditTargetType = 2
+	AuditTargetType_AUDIT_TARGET_TYPE_ANNOUNCEMENT AuditTargetType = 3
+	AuditTargetType_AUDIT_TARGET_TYPE_EVENT_CONFIG AuditTargetType = 4
+	AuditTargetType_AUDIT_TARGET_TYPE_SUBMISSION   AuditTargetType = 5
+	AuditTargetType_AUDIT_TARGET_TYPE_USER         AuditTargetType = 6
+)
+
+// Enum value maps for AuditTargetType.
+var (
+	AuditTargetType_name = map[int32]string{
+		0: "AUDIT_TARGET_TYPE_UNSPECIFIED",
+		1: "AUDIT_TARGET_TYPE_CHALLENGE",
+		2: "AUDIT_TARGET_TYPE_HINT",
+		3: "AUDIT_TARGET_TYPE_ANNOUNCEMENT",
+		4: "AUDIT_TARGET_TYPE_EVENT_CONFIG",
+		5: "AUDIT_TARGET_TYPE_SUBMISSION",
+		6: "AUDIT_TARGET_TYPE_USER",
+	}
+	AuditTargetType_value = map[string]int32{
+		"AUDIT_TARGET_TYPE_UNSPECIFIED":  0,
+		"AUDIT_TARGET_TYPE_CHALLENGE":    1,
+		"AUDIT_TARGET_TYPE_HINT":         2,
+		"AUDIT_TARGET_TYPE_ANNOUNCEMENT": 3,
+		"AUDIT_TARGET_TYPE_EVENT_CONFIG": 4,
+		"AUDIT_TARGET_TYPE_SUBMISSION":   5,
+		"AUDIT_TARGET_TYPE_USER":         6,
+	}
+)
+
+func (x AuditTargetType) Enum() *AuditTargetType {
+	p := new(AuditTargetType)
+	*p = x
+	return p
+}
+
+func (x AuditTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_v1_admin_proto_enumTypes[3].Descriptor()
+}
+
+func (AuditTargetType) Type() protoreflect.EnumType {
+	return &file_api_server_v1_admin_proto_enumTypes[3]
+}
+
+func (x AuditTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditTargetType.Descriptor instead.
+func (AuditTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+type ListSubmissionsRequest_Result int32
+
+const (
+	ListSubmissionsRequest_RESULT_UNSPECIFIED ListSubmissionsRequest_Result = 0 // both correct and incorrect
+	ListSubmissionsRequest_RESULT_CORRECT     ListSubmissionsRequest_Result = 1
+	ListSubmissionsRequest_RESULT_INCORRECT   ListSubmissionsRequest_Result = 2
+)
+
+// Enum value maps for ListSubmissionsRequest_Result.
+var (
+	ListSubmissionsRequest_Result_name = map[int32]string{
+		0: "RESULT_UNSPECIFIED",
+		1: "RESULT_CORRECT",
+		2: "RESULT_INCORRECT",
+	}
+	ListSubmissionsRequest_Result_value = map[string]int32{
+		"RESULT_UNSPECIFIED": 0,
+		"RESULT_CORRECT":     1,
+		"RESULT_INCORRECT":   2,
+	}
+)
+
+func (x ListSubmissionsRequest_Result) Enum() *ListSubmissionsRequest_Result {
+	p := new(ListSubmissionsRequest_Result)
+	*p = x
+	return p
+}
+
+func (x ListSubmissionsRequest_Result) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListSubmissionsRequest_Result) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_v1_admin_proto_enumTypes[4].Descriptor()
+}
+
+func (ListSubmissionsRequest_Result) Type() protoreflect.EnumType {
+	return &file_api_server_v1_admin_proto_enumTypes[4]
+}
+
+func (x ListSubmissionsRequest_Result) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListSubmissionsRequest_Result.Descriptor instead.
+func (ListSubmissionsRequest_Result) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type ListSubmissionsRequest_Order int32
+
+const (
+	ListSubmissionsRequest_ORDER_UNSPECIFIED  ListSubmissionsRequest_Order = 0 // newest first
+	ListSubmissionsRequest_ORDER_NEWEST_FIRST ListSubmissionsRequest_Order = 1
+	ListSubmissionsRequest_ORDER_OLDEST_FIRST ListSubmissionsRequest_Order = 2
+)
+
+// Enum value maps for ListSubmissionsRequest_Order.
+var (
+	ListSubmissionsRequest_Order_name = map[int32]string{
+		0: "ORDER_UNSPECIFIED",
+		1: "ORDER_NEWEST_FIRST",
+		2: "ORDER_OLDEST_FIRST",
+	}
+	ListSubmissionsRequest_Order_value = map[string]int32{
+		"ORDER_UNSPECIFIED":  0,
+		"ORDER_NEWEST_FIRST": 1,
+		"ORDER_OLDEST_FIRST": 2,
+	}
+)
+
+func (x ListSubmissionsRequest_Order) Enum() *ListSubmissionsRequest_Order {
+	p := new(ListSubmissionsRequest_Order)
+	*p = x
+	return p
+}
+
+func (x ListSubmissionsRequest_Order) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListSubmissionsRequest_Order) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_v1_admin_proto_enumTypes[5].Descriptor()
+}
+
+func (ListSubmissionsRequest_Order) Type() protoreflect.EnumType {
+	return &file_api_server_v1_admin_proto_enumTypes[5]
+}
+
+func (x ListSubmissionsRequest_Order) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListSubmissionsRequest_Order.Descriptor instead.
+func (ListSubmissionsRequest_Order) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{31, 1}
+}
+
 type CreateChallengeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Challenge     *ChallengeRequest      `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
@@ -1232,27 +1492,26 @@ func (x *DeleteAttachmentResponse) GetErrorMessage() string {
 	return ""
 }
 
-type AdminLoginRequest struct {
+type GetEventConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AdminLoginRequest) Reset() {
-	*x = AdminLoginRequest{}
+func (x *GetEventConfigRequest) Reset() {
+	*x = GetEventConfigRequest{}
 	mi := &file_api_server_v1_admin_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminLoginRequest) String() string {
+func (x *GetEventConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLoginRequest) ProtoMessage() {}
+func (*GetEventConfigRequest) ProtoMessage() {}
 
-func (x *AdminLoginRequest) ProtoReflect() protoreflect.Message {
+func (x *GetEventConfigRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_server_v1_admin_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1264,38 +1523,33 @@ func (x *AdminLoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLoginRequest.ProtoReflect.Descriptor instead.
-func (*AdminLoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEventConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetEventConfigRequest) Descriptor() ([]byte, []int) {
 	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AdminLoginRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
-}
-
-type AdminLoginResponse struct {
+type GetEventConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventConfig   *EventConfig           `protobuf:"bytes,1,opt,name=event_config,json=eventConfig,proto3" json:"event_config,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AdminLoginResponse) Reset() {
-	*x = AdminLoginResponse{}
+func (x *GetEventConfigResponse) Reset() {
+	*x = GetEventConfigResponse{}
 	mi := &file_api_server_v1_admin_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminLoginResponse) String() string {
+func (x *GetEventConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLoginResponse) ProtoMessage() {}
+func (*GetEventConfigResponse) ProtoMessage() {}
 
-func (x *AdminLoginResponse) ProtoReflect() protoreflect.Message {
+func (x *GetEventConfigResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_server_v1_admin_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1307,31 +1561,46 @@ func (x *AdminLoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLoginResponse.ProtoReflect.Descriptor instead.
-func (*AdminLoginResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEventConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetEventConfigResponse) Descriptor() ([]byte, []int) {
 	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{24}
 }
 
-type AdminLogoutRequest struct {
+func (x *GetEventConfigResponse) GetEventConfig() *EventConfig {
+	if x != nil {
+		return x.EventConfig
+	}
+	return nil
+}
+
+func (x *GetEventConfigResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type UpdateEventConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventConfig   *EventConfig           `protobuf:"bytes,1,opt,name=event_config,json=eventConfig,proto3" json:"event_config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AdminLogoutRequest) Reset() {
-	*x = AdminLogoutRequest{}
+func (x *UpdateEventConfigRequest) Reset() {
+	*x = UpdateEventConfigRequest{}
 	mi := &file_api_server_v1_admin_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminLogoutRequest) String() string {
+func (x *UpdateEventConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLogoutRequest) ProtoMessage() {}
+func (*UpdateEventConfigRequest) ProtoMessage() {}
 
-func (x *AdminLogoutRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateEventConfigRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_server_v1_admin_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1343,31 +1612,39 @@ func (x *AdminLogoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLogoutRequest.ProtoReflect.Descriptor instead.
-func (*AdminLogoutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateEventConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventConfigRequest) Descriptor() ([]byte, []int) {
 	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{25}
 }
 
-type AdminLogoutResponse struct {
+func (x *UpdateEventConfigRequest) GetEventConfig() *EventConfig {
+	if x != nil {
+		return x.EventConfig
+	}
+	return nil
+}
+
+type UpdateEventConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AdminLogoutResponse) Reset() {
-	*x = AdminLogoutResponse{}
+func (x *UpdateEventConfigResponse) Reset() {
+	*x = UpdateEventConfigResponse{}
 	mi := &file_api_server_v1_admin_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminLogoutResponse) String() string {
+func (x *UpdateEventConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLogoutResponse) ProtoMessage() {}
+func (*UpdateEventConfigResponse) ProtoMessage() {}
 
-func (x *AdminLogoutResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateEventConfigResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_server_v1_admin_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1379,78 +1656,3042 @@ func (x *AdminLogoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLogoutResponse.ProtoReflect.Descriptor instead.
-func (*AdminLogoutResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateEventConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEventConfigResponse) Descriptor() ([]byte, []int) {
 	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{26}
 }
 
-var File_api_server_v1_admin_proto protoreflect.FileDescriptor
+func (x *UpdateEventConfigResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
 
-const file_api_server_v1_admin_proto_rawDesc = "" +
-	"\n" +
-	"\x19api/server/v1/admin.proto\x12\rapi.server.v1\x1a\x19api/server/v1/model.proto\"W\n" +
-	"\x16CreateChallengeRequest\x12=\n" +
-	"\tchallenge\x18\x01 \x01(\v2\x1f.api.server.v1.ChallengeRequestR\tchallenge\"a\n" +
-	"\x17CreateChallengeResponse\x12!\n" +
-	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"P\n" +
-	"\x16UpdateChallengeRequest\x126\n" +
-	"\tchallenge\x18\x01 \x01(\v2\x18.api.server.v1.ChallengeR\tchallenge\">\n" +
-	"\x17UpdateChallengeResponse\x12#\n" +
-	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"_\n" +
-	"\x1bUploadChallengeImageRequest\x12!\n" +
-	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x1d\n" +
-	"\n" +
-	"image_data\x18\x02 \x01(\fR\timageData\"Z\n" +
-	"\x1cUploadChallengeImageResponse\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\";\n" +
-	"\x16DeleteChallengeRequest\x12!\n" +
-	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\">\n" +
-	"\x17DeleteChallengeResponse\x12#\n" +
-	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"\x17\n" +
-	"\x15ListChallengesRequest\"w\n" +
-	"\x16ListChallengesResponse\x128\n" +
-	"\n" +
-	"challenges\x18\x01 \x03(\v2\x18.api.server.v1.ChallengeR\n" +
-	"challenges\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"8\n" +
-	"\x13GetChallengeRequest\x12!\n" +
-	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\"s\n" +
-	"\x14GetChallengeResponse\x126\n" +
-	"\tchallenge\x18\x01 \x01(\v2\x18.api.server.v1.ChallengeR\tchallenge\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\xc1\x01\n" +
-	"\x0fBuildLogSummary\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12!\n" +
-	"\fchallenge_id\x18\x02 \x01(\tR\vchallengeId\x122\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x1a.api.server.v1.BuildStatusR\x06status\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12!\n" +
-	"\fcompleted_at\x18\x05 \x01(\tR\vcompletedAt\"9\n" +
-	"\x14ListBuildLogsRequest\x12!\n" +
-	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\"p\n" +
-	"\x15ListBuildLogsResponse\x122\n" +
-	"\x04logs\x18\x01 \x03(\v2\x1e.api.server.v1.BuildLogSummaryR\x04logs\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"+\n" +
-	"\x12GetBuildLogRequest\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xa6\x01\n" +
-	"\x13GetBuildLogResponse\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1f\n" +
-	"\vlog_content\x18\x02 \x01(\tR\n" +
-	"logContent\x122\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x1a.api.server.v1.BuildStatusR\x06status\x12#\n" +
-	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\".\n" +
-	"\x15StreamBuildLogRequest\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x88\x01\n" +
-	"\x16StreamBuildLogResponse\x12\x19\n" +
-	"\blog_line\x18\x01 \x01(\tR\alogLine\x122\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x1a.api.server.v1.BuildStatusR\x06status\x12\x1f\n" +
-	"\vis_complete\x18\x03 \x01(\bR\n" +
-	"isComplete\"l\n" +
-	"\x17UploadAttachmentRequest\x12!\n" +
-	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x1a\n" +
-	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
-	"\x04data\x18\x03 \x01(\fR\x04data\"z\n" +
+type GetFlagSharingReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowSeconds int64                  `protobuf:"varint,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"` // optional, solves within this many seconds are grouped (default 10)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlagSharingReportRequest) Reset() {
+	*x = GetFlagSharingReportRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlagSharingReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlagSharingReportRequest) ProtoMessage() {}
+
+func (x *GetFlagSharingReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlagSharingReportRequest.ProtoReflect.Descriptor instead.
+func (*GetFlagSharingReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetFlagSharingReportRequest) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+type GetFlagSharingReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clusters      []*FlagSharingCluster  `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlagSharingReportResponse) Reset() {
+	*x = GetFlagSharingReportResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlagSharingReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlagSharingReportResponse) ProtoMessage() {}
+
+func (x *GetFlagSharingReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlagSharingReportResponse.ProtoReflect.Descriptor instead.
+func (*GetFlagSharingReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetFlagSharingReportResponse) GetClusters() []*FlagSharingCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *GetFlagSharingReportResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type FlagSharingCluster struct {
+	state            protoimpl.MessageState   `protogen:"open.v1"`
+	Reason           FlagSharingReason        `protobuf:"varint,1,opt,name=reason,proto3,enum=api.server.v1.FlagSharingReason" json:"reason,omitempty"`
+	ChallengeId      string                   `protobuf:"bytes,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	ChallengeName    string                   `protobuf:"bytes,3,opt,name=challenge_name,json=challengeName,proto3" json:"challenge_name,omitempty"`
+	SubmittedFlag    string                   `protobuf:"bytes,4,opt,name=submitted_flag,json=submittedFlag,proto3" json:"submitted_flag,omitempty"` // set for FLAG_SHARING_REASON_SAME_WRONG_ANSWER
+	FirstSubmittedAt int64                    `protobuf:"varint,5,opt,name=first_submitted_at,json=firstSubmittedAt,proto3" json:"first_submitted_at,omitempty"`
+	LastSubmittedAt  int64                    `protobuf:"varint,6,opt,name=last_submitted_at,json=lastSubmittedAt,proto3" json:"last_submitted_at,omitempty"`
+	Submissions      []*FlagSharingSubmission `protobuf:"bytes,7,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FlagSharingCluster) Reset() {
+	*x = FlagSharingCluster{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagSharingCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagSharingCluster) ProtoMessage() {}
+
+func (x *FlagSharingCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagSharingCluster.ProtoReflect.Descriptor instead.
+func (*FlagSharingCluster) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FlagSharingCluster) GetReason() FlagSharingReason {
+	if x != nil {
+		return x.Reason
+	}
+	return FlagSharingReason_FLAG_SHARING_REASON_UNSPECIFIED
+}
+
+func (x *FlagSharingCluster) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *FlagSharingCluster) GetChallengeName() string {
+	if x != nil {
+		return x.ChallengeName
+	}
+	return ""
+}
+
+func (x *FlagSharingCluster) GetSubmittedFlag() string {
+	if x != nil {
+		return x.SubmittedFlag
+	}
+	return ""
+}
+
+func (x *FlagSharingCluster) GetFirstSubmittedAt() int64 {
+	if x != nil {
+		return x.FirstSubmittedAt
+	}
+	return 0
+}
+
+func (x *FlagSharingCluster) GetLastSubmittedAt() int64 {
+	if x != nil {
+		return x.LastSubmittedAt
+	}
+	return 0
+}
+
+func (x *FlagSharingCluster) GetSubmissions() []*FlagSharingSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+type FlagSharingSubmission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	TeamId        string                 `protobuf:"bytes,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	SubmittedFlag string                 `protobuf:"bytes,5,opt,name=submitted_flag,json=submittedFlag,proto3" json:"submitted_flag,omitempty"`
+	SubmittedAt   int64                  `protobuf:"varint,6,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagSharingSubmission) Reset() {
+	*x = FlagSharingSubmission{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagSharingSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagSharingSubmission) ProtoMessage() {}
+
+func (x *FlagSharingSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagSharingSubmission.ProtoReflect.Descriptor instead.
+func (*FlagSharingSubmission) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FlagSharingSubmission) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *FlagSharingSubmission) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FlagSharingSubmission) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *FlagSharingSubmission) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *FlagSharingSubmission) GetSubmittedFlag() string {
+	if x != nil {
+		return x.SubmittedFlag
+	}
+	return ""
+}
+
+func (x *FlagSharingSubmission) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+type ListSubmissionsRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	UserId        string                        `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChallengeId   string                        `protobuf:"bytes,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Result        ListSubmissionsRequest_Result `protobuf:"varint,3,opt,name=result,proto3,enum=api.server.v1.ListSubmissionsRequest_Result" json:"result,omitempty"`
+	Since         int64                         `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"` // unix seconds, inclusive
+	Until         int64                         `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"` // unix seconds, exclusive
+	Order         ListSubmissionsRequest_Order  `protobuf:"varint,6,opt,name=order,proto3,enum=api.server.v1.ListSubmissionsRequest_Order" json:"order,omitempty"`
+	Cursor        string                        `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`                      // next_cursor of the previous page
+	PageSize      int32                         `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to 50, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubmissionsRequest) Reset() {
+	*x = ListSubmissionsRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListSubmissionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListSubmissionsRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *ListSubmissionsRequest) GetResult() ListSubmissionsRequest_Result {
+	if x != nil {
+		return x.Result
+	}
+	return ListSubmissionsRequest_RESULT_UNSPECIFIED
+}
+
+func (x *ListSubmissionsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListSubmissionsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListSubmissionsRequest) GetOrder() ListSubmissionsRequest_Order {
+	if x != nil {
+		return x.Order
+	}
+	return ListSubmissionsRequest_ORDER_UNSPECIFIED
+}
+
+func (x *ListSubmissionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListSubmissionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListSubmissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submissions   []*AdminSubmission     `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubmissionsResponse) Reset() {
+	*x = ListSubmissionsResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListSubmissionsResponse) GetSubmissions() []*AdminSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *ListSubmissionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListSubmissionsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type AdminSubmission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	TeamId        string                 `protobuf:"bytes,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ChallengeId   string                 `protobuf:"bytes,5,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	ChallengeName string                 `protobuf:"bytes,6,opt,name=challenge_name,json=challengeName,proto3" json:"challenge_name,omitempty"`
+	SubmittedFlag string                 `protobuf:"bytes,7,opt,name=submitted_flag,json=submittedFlag,proto3" json:"submitted_flag,omitempty"`
+	Correct       bool                   `protobuf:"varint,8,opt,name=correct,proto3" json:"correct,omitempty"`
+	PartId        string                 `protobuf:"bytes,9,opt,name=part_id,json=partId,proto3" json:"part_id,omitempty"`
+	SolveRank     int32                  `protobuf:"varint,10,opt,name=solve_rank,json=solveRank,proto3" json:"solve_rank,omitempty"`
+	SubmittedAt   int64                  `protobuf:"varint,11,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	InvalidatedAt int64                  `protobuf:"varint,12,opt,name=invalidated_at,json=invalidatedAt,proto3" json:"invalidated_at,omitempty"` // 0 unless an admin invalidated the submission
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSubmission) Reset() {
+	*x = AdminSubmission{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSubmission) ProtoMessage() {}
+
+func (x *AdminSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSubmission.ProtoReflect.Descriptor instead.
+func (*AdminSubmission) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AdminSubmission) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *AdminSubmission) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminSubmission) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminSubmission) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *AdminSubmission) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *AdminSubmission) GetChallengeName() string {
+	if x != nil {
+		return x.ChallengeName
+	}
+	return ""
+}
+
+func (x *AdminSubmission) GetSubmittedFlag() string {
+	if x != nil {
+		return x.SubmittedFlag
+	}
+	return ""
+}
+
+func (x *AdminSubmission) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *AdminSubmission) GetPartId() string {
+	if x != nil {
+		return x.PartId
+	}
+	return ""
+}
+
+func (x *AdminSubmission) GetSolveRank() int32 {
+	if x != nil {
+		return x.SolveRank
+	}
+	return 0
+}
+
+func (x *AdminSubmission) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+func (x *AdminSubmission) GetInvalidatedAt() int64 {
+	if x != nil {
+		return x.InvalidatedAt
+	}
+	return 0
+}
+
+type InvalidateSubmissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateSubmissionRequest) Reset() {
+	*x = InvalidateSubmissionRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateSubmissionRequest) ProtoMessage() {}
+
+func (x *InvalidateSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InvalidateSubmissionRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+type InvalidateSubmissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateSubmissionResponse) Reset() {
+	*x = InvalidateSubmissionResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateSubmissionResponse) ProtoMessage() {}
+
+func (x *InvalidateSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InvalidateSubmissionResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type AdminUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          StaffRole              `protobuf:"varint,3,opt,name=role,proto3,enum=api.server.v1.StaffRole" json:"role,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix seconds
+	BannedAt      int64                  `protobuf:"varint,5,opt,name=banned_at,json=bannedAt,proto3" json:"banned_at,omitempty"`    // 0 unless the user is banned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUser) Reset() {
+	*x = AdminUser{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUser) ProtoMessage() {}
+
+func (x *AdminUser) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUser.ProtoReflect.Descriptor instead.
+func (*AdminUser) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AdminUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminUser) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func (x *AdminUser) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AdminUser) GetBannedAt() int64 {
+	if x != nil {
+		return x.BannedAt
+	}
+	return 0
+}
+
+type ListAdminsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminsRequest) Reset() {
+	*x = ListAdminsRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminsRequest) ProtoMessage() {}
+
+func (x *ListAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+type ListAdminsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admins        []*AdminUser           `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminsResponse) Reset() {
+	*x = ListAdminsResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminsResponse) ProtoMessage() {}
+
+func (x *ListAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListAdminsResponse) GetAdmins() []*AdminUser {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *ListAdminsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// GrantAdminRequest also changes the role of an existing staff member
+type GrantAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role          StaffRole              `protobuf:"varint,2,opt,name=role,proto3,enum=api.server.v1.StaffRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantAdminRequest) Reset() {
+	*x = GrantAdminRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantAdminRequest) ProtoMessage() {}
+
+func (x *GrantAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantAdminRequest.ProtoReflect.Descriptor instead.
+func (*GrantAdminRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GrantAdminRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GrantAdminRequest) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+type GrantAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admin         *AdminUser             `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantAdminResponse) Reset() {
+	*x = GrantAdminResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantAdminResponse) ProtoMessage() {}
+
+func (x *GrantAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantAdminResponse.ProtoReflect.Descriptor instead.
+func (*GrantAdminResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GrantAdminResponse) GetAdmin() *AdminUser {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+func (x *GrantAdminResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type RevokeAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAdminRequest) Reset() {
+	*x = RevokeAdminRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAdminRequest) ProtoMessage() {}
+
+func (x *RevokeAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAdminRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAdminRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RevokeAdminRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevokeAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAdminResponse) Reset() {
+	*x = RevokeAdminResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAdminResponse) ProtoMessage() {}
+
+func (x *RevokeAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAdminResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAdminResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RevokeAdminResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                        // matches part of the username
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                      // next_cursor of the previous page
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to 50, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*AdminUser           `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`                             // newest first
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListUsersResponse) GetUsers() []*AdminUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListUsersResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *AdminUser             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Solves        []*AdminSubmission     `protobuf:"bytes,2,rep,name=solves,proto3" json:"solves,omitempty"`
+	Instances     []*AdminInstance       `protobuf:"bytes,3,rep,name=instances,proto3" json:"instances,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetUserResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetSolves() []*AdminSubmission {
+	if x != nil {
+		return x.Solves
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetInstances() []*AdminInstance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type AdminInstance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	ChallengeId   string                 `protobuf:"bytes,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	ChallengeName string                 `protobuf:"bytes,3,opt,name=challenge_name,json=challengeName,proto3" json:"challenge_name,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // running or stopped
+	Host          string                 `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,6,opt,name=port,proto3" json:"port,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminInstance) Reset() {
+	*x = AdminInstance{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminInstance) ProtoMessage() {}
+
+func (x *AdminInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminInstance.ProtoReflect.Descriptor instead.
+func (*AdminInstance) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AdminInstance) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *AdminInstance) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *AdminInstance) GetChallengeName() string {
+	if x != nil {
+		return x.ChallengeName
+	}
+	return ""
+}
+
+func (x *AdminInstance) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminInstance) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *AdminInstance) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *AdminInstance) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *AdminInstance) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// BanUserRequest blocks login, signs the user out and hides the user from scoreboards
+// Staff must have their role revoked first
+type BanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserRequest) ProtoMessage() {}
+
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BanUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BanUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserResponse) Reset() {
+	*x = BanUserResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserResponse) ProtoMessage() {}
+
+func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
+func (*BanUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BanUserResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type UnbanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanUserRequest) Reset() {
+	*x = UnbanUserRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanUserRequest) ProtoMessage() {}
+
+func (x *UnbanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanUserRequest.ProtoReflect.Descriptor instead.
+func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UnbanUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnbanUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanUserResponse) Reset() {
+	*x = UnbanUserResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanUserResponse) ProtoMessage() {}
+
+func (x *UnbanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanUserResponse.ProtoReflect.Descriptor instead.
+func (*UnbanUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UnbanUserResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// ResetUserPasswordRequest replaces the password with a generated one and signs the user out
+type ResetUserPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserPasswordRequest) Reset() {
+	*x = ResetUserPasswordRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserPasswordRequest) ProtoMessage() {}
+
+func (x *ResetUserPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetUserPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ResetUserPasswordRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ResetUserPasswordResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TemporaryPassword string                 `protobuf:"bytes,1,opt,name=temporary_password,json=temporaryPassword,proto3" json:"temporary_password,omitempty"`
+	ErrorMessage      string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResetUserPasswordResponse) Reset() {
+	*x = ResetUserPasswordResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserPasswordResponse) ProtoMessage() {}
+
+func (x *ResetUserPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetUserPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ResetUserPasswordResponse) GetTemporaryPassword() string {
+	if x != nil {
+		return x.TemporaryPassword
+	}
+	return ""
+}
+
+func (x *ResetUserPasswordResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// DeleteUserRequest destroys the user's instances and revokes their solves before deleting the account
+// Staff must have their role revoked first
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteUserResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type CreateHintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Cost          int32                  `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHintRequest) Reset() {
+	*x = CreateHintRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHintRequest) ProtoMessage() {}
+
+func (x *CreateHintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHintRequest.ProtoReflect.Descriptor instead.
+func (*CreateHintRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateHintRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *CreateHintRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateHintRequest) GetCost() int32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type CreateHintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HintId        string                 `protobuf:"bytes,1,opt,name=hint_id,json=hintId,proto3" json:"hint_id,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHintResponse) Reset() {
+	*x = CreateHintResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHintResponse) ProtoMessage() {}
+
+func (x *CreateHintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHintResponse.ProtoReflect.Descriptor instead.
+func (*CreateHintResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateHintResponse) GetHintId() string {
+	if x != nil {
+		return x.HintId
+	}
+	return ""
+}
+
+func (x *CreateHintResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type UpdateHintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hint          *Hint                  `protobuf:"bytes,1,opt,name=hint,proto3" json:"hint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHintRequest) Reset() {
+	*x = UpdateHintRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHintRequest) ProtoMessage() {}
+
+func (x *UpdateHintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHintRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHintRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateHintRequest) GetHint() *Hint {
+	if x != nil {
+		return x.Hint
+	}
+	return nil
+}
+
+type UpdateHintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHintResponse) Reset() {
+	*x = UpdateHintResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHintResponse) ProtoMessage() {}
+
+func (x *UpdateHintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHintResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHintResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateHintResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type DeleteHintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HintId        string                 `protobuf:"bytes,1,opt,name=hint_id,json=hintId,proto3" json:"hint_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHintRequest) Reset() {
+	*x = DeleteHintRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHintRequest) ProtoMessage() {}
+
+func (x *DeleteHintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHintRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHintRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteHintRequest) GetHintId() string {
+	if x != nil {
+		return x.HintId
+	}
+	return ""
+}
+
+type DeleteHintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHintResponse) Reset() {
+	*x = DeleteHintResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHintResponse) ProtoMessage() {}
+
+func (x *DeleteHintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHintResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHintResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteHintResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ListHintsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHintsRequest) Reset() {
+	*x = ListHintsRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHintsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHintsRequest) ProtoMessage() {}
+
+func (x *ListHintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHintsRequest.ProtoReflect.Descriptor instead.
+func (*ListHintsRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListHintsRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+type ListHintsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hints         []*Hint                `protobuf:"bytes,1,rep,name=hints,proto3" json:"hints,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHintsResponse) Reset() {
+	*x = ListHintsResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHintsResponse) ProtoMessage() {}
+
+func (x *ListHintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHintsResponse.ProtoReflect.Descriptor instead.
+func (*ListHintsResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListHintsResponse) GetHints() []*Hint {
+	if x != nil {
+		return x.Hints
+	}
+	return nil
+}
+
+func (x *ListHintsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type CreateAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"` // empty for an event-wide announcement
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAnnouncementRequest) Reset() {
+	*x = CreateAnnouncementRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnnouncementRequest) ProtoMessage() {}
+
+func (x *CreateAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*CreateAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateAnnouncementRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *CreateAnnouncementRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAnnouncementRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CreateAnnouncementResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementId string                 `protobuf:"bytes,1,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	ErrorMessage   string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateAnnouncementResponse) Reset() {
+	*x = CreateAnnouncementResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnnouncementResponse) ProtoMessage() {}
+
+func (x *CreateAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*CreateAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateAnnouncementResponse) GetAnnouncementId() string {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return ""
+}
+
+func (x *CreateAnnouncementResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type UpdateAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcement  *Announcement          `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAnnouncementRequest) Reset() {
+	*x = UpdateAnnouncementRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAnnouncementRequest) ProtoMessage() {}
+
+func (x *UpdateAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateAnnouncementRequest) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+type UpdateAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAnnouncementResponse) Reset() {
+	*x = UpdateAnnouncementResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAnnouncementResponse) ProtoMessage() {}
+
+func (x *UpdateAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateAnnouncementResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type DeleteAnnouncementRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementId string                 `protobuf:"bytes,1,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteAnnouncementRequest) Reset() {
+	*x = DeleteAnnouncementRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementRequest) ProtoMessage() {}
+
+func (x *DeleteAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteAnnouncementRequest) GetAnnouncementId() string {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return ""
+}
+
+type DeleteAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAnnouncementResponse) Reset() {
+	*x = DeleteAnnouncementResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementResponse) ProtoMessage() {}
+
+func (x *DeleteAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteAnnouncementResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ListAnnouncementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsRequest) Reset() {
+	*x = ListAnnouncementsRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsRequest) ProtoMessage() {}
+
+func (x *ListAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{70}
+}
+
+type ListAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsResponse) Reset() {
+	*x = ListAnnouncementsResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsResponse) ProtoMessage() {}
+
+func (x *ListAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *ListAnnouncementsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                                                               // AdminService method name such as UpdateChallenge
+	TargetType    AuditTargetType        `protobuf:"varint,3,opt,name=target_type,json=targetType,proto3,enum=api.server.v1.AuditTargetType" json:"target_type,omitempty"` // unspecified matches every type
+	TargetId      string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Since         int64                  `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"`                       // unix seconds, inclusive
+	Until         int64                  `protobuf:"varint,6,opt,name=until,proto3" json:"until,omitempty"`                       // unix seconds, exclusive
+	Cursor        string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`                      // next_cursor of the previous page
+	PageSize      int32                  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to 50, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListAuditEventsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTargetType() AuditTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return AuditTargetType_AUDIT_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *ListAuditEventsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`                           // newest first
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditEventId  string                 `protobuf:"bytes,1,opt,name=audit_event_id,json=auditEventId,proto3" json:"audit_event_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorName     string                 `protobuf:"bytes,3,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType    AuditTargetType        `protobuf:"varint,5,opt,name=target_type,json=targetType,proto3,enum=api.server.v1.AuditTargetType" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,6,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Changes       []*AuditChange         `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // set when the call failed
+	ClientIp      string                 `protobuf:"bytes,9,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,10,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AuditEvent) GetAuditEventId() string {
+	if x != nil {
+		return x.AuditEventId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorName() string {
+	if x != nil {
+		return x.ActorName
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetType() AuditTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return AuditTargetType_AUDIT_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetChanges() []*AuditChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+// AuditChange holds the values of a field before and after the call
+// Flag values and password hashes are replaced with "[REDACTED]"
+type AuditChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditChange) Reset() {
+	*x = AuditChange{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditChange) ProtoMessage() {}
+
+func (x *AuditChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditChange.ProtoReflect.Descriptor instead.
+func (*AuditChange) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AuditChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AuditChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type AdminLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// activation code, only needed to make the first admin while no admin account exists
+	Password      string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLoginRequest) Reset() {
+	*x = AdminLoginRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLoginRequest) ProtoMessage() {}
+
+func (x *AdminLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLoginRequest.ProtoReflect.Descriptor instead.
+func (*AdminLoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AdminLoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AdminLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLoginResponse) Reset() {
+	*x = AdminLoginResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLoginResponse) ProtoMessage() {}
+
+func (x *AdminLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLoginResponse.ProtoReflect.Descriptor instead.
+func (*AdminLoginResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{77}
+}
+
+type AdminLogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLogoutRequest) Reset() {
+	*x = AdminLogoutRequest{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLogoutRequest) ProtoMessage() {}
+
+func (x *AdminLogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLogoutRequest.ProtoReflect.Descriptor instead.
+func (*AdminLogoutRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{78}
+}
+
+type AdminLogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLogoutResponse) Reset() {
+	*x = AdminLogoutResponse{}
+	mi := &file_api_server_v1_admin_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLogoutResponse) ProtoMessage() {}
+
+func (x *AdminLogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_admin_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLogoutResponse.ProtoReflect.Descriptor instead.
+func (*AdminLogoutResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_admin_proto_rawDescGZIP(), []int{79}
+}
+
+var File_api_server_v1_admin_proto protoreflect.FileDescriptor
+
+const file_api_server_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x19api/server/v1/admin.proto\x12\rapi.server.v1\x1a\x19api/server/v1/model.proto\"W\n" +
+	"\x16CreateChallengeRequest\x12=\n" +
+	"\tchallenge\x18\x01 \x01(\v2\x1f.api.server.v1.ChallengeRequestR\tchallenge\"a\n" +
+	"\x17CreateChallengeResponse\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"P\n" +
+	"\x16UpdateChallengeRequest\x126\n" +
+	"\tchallenge\x18\x01 \x01(\v2\x18.api.server.v1.ChallengeR\tchallenge\">\n" +
+	"\x17UpdateChallengeResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"_\n" +
+	"\x1bUploadChallengeImageRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x1d\n" +
+	"\n" +
+	"image_data\x18\x02 \x01(\fR\timageData\"Z\n" +
+	"\x1cUploadChallengeImageResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\";\n" +
+	"\x16DeleteChallengeRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\">\n" +
+	"\x17DeleteChallengeResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"\x17\n" +
+	"\x15ListChallengesRequest\"w\n" +
+	"\x16ListChallengesResponse\x128\n" +
+	"\n" +
+	"challenges\x18\x01 \x03(\v2\x18.api.server.v1.ChallengeR\n" +
+	"challenges\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"8\n" +
+	"\x13GetChallengeRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\"s\n" +
+	"\x14GetChallengeResponse\x126\n" +
+	"\tchallenge\x18\x01 \x01(\v2\x18.api.server.v1.ChallengeR\tchallenge\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\xc1\x01\n" +
+	"\x0fBuildLogSummary\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12!\n" +
+	"\fchallenge_id\x18\x02 \x01(\tR\vchallengeId\x122\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1a.api.server.v1.BuildStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12!\n" +
+	"\fcompleted_at\x18\x05 \x01(\tR\vcompletedAt\"9\n" +
+	"\x14ListBuildLogsRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\"p\n" +
+	"\x15ListBuildLogsResponse\x122\n" +
+	"\x04logs\x18\x01 \x03(\v2\x1e.api.server.v1.BuildLogSummaryR\x04logs\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"+\n" +
+	"\x12GetBuildLogRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xa6\x01\n" +
+	"\x13GetBuildLogResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1f\n" +
+	"\vlog_content\x18\x02 \x01(\tR\n" +
+	"logContent\x122\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1a.api.server.v1.BuildStatusR\x06status\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\".\n" +
+	"\x15StreamBuildLogRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x88\x01\n" +
+	"\x16StreamBuildLogResponse\x12\x19\n" +
+	"\blog_line\x18\x01 \x01(\tR\alogLine\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.api.server.v1.BuildStatusR\x06status\x12\x1f\n" +
+	"\vis_complete\x18\x03 \x01(\bR\n" +
+	"isComplete\"l\n" +
+	"\x17UploadAttachmentRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"z\n" +
 	"\x18UploadAttachmentResponse\x129\n" +
 	"\n" +
 	"attachment\x18\x01 \x01(\v2\x19.api.server.v1.AttachmentR\n" +
@@ -1460,7 +4701,216 @@ const file_api_server_v1_admin_proto_rawDesc = "" +
 	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12#\n" +
 	"\rattachment_id\x18\x02 \x01(\tR\fattachmentId\"?\n" +
 	"\x18DeleteAttachmentResponse\x12#\n" +
-	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"/\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"\x17\n" +
+	"\x15GetEventConfigRequest\"|\n" +
+	"\x16GetEventConfigResponse\x12=\n" +
+	"\fevent_config\x18\x01 \x01(\v2\x1a.api.server.v1.EventConfigR\veventConfig\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"Y\n" +
+	"\x18UpdateEventConfigRequest\x12=\n" +
+	"\fevent_config\x18\x01 \x01(\v2\x1a.api.server.v1.EventConfigR\veventConfig\"@\n" +
+	"\x19UpdateEventConfigResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"D\n" +
+	"\x1bGetFlagSharingReportRequest\x12%\n" +
+	"\x0ewindow_seconds\x18\x01 \x01(\x03R\rwindowSeconds\"\x82\x01\n" +
+	"\x1cGetFlagSharingReportResponse\x12=\n" +
+	"\bclusters\x18\x01 \x03(\v2!.api.server.v1.FlagSharingClusterR\bclusters\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\xe1\x02\n" +
+	"\x12FlagSharingCluster\x128\n" +
+	"\x06reason\x18\x01 \x01(\x0e2 .api.server.v1.FlagSharingReasonR\x06reason\x12!\n" +
+	"\fchallenge_id\x18\x02 \x01(\tR\vchallengeId\x12%\n" +
+	"\x0echallenge_name\x18\x03 \x01(\tR\rchallengeName\x12%\n" +
+	"\x0esubmitted_flag\x18\x04 \x01(\tR\rsubmittedFlag\x12,\n" +
+	"\x12first_submitted_at\x18\x05 \x01(\x03R\x10firstSubmittedAt\x12*\n" +
+	"\x11last_submitted_at\x18\x06 \x01(\x03R\x0flastSubmittedAt\x12F\n" +
+	"\vsubmissions\x18\a \x03(\v2$.api.server.v1.FlagSharingSubmissionR\vsubmissions\"\xd4\x01\n" +
+	"\x15FlagSharingSubmission\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\tR\fsubmissionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x17\n" +
+	"\ateam_id\x18\x04 \x01(\tR\x06teamId\x12%\n" +
+	"\x0esubmitted_flag\x18\x05 \x01(\tR\rsubmittedFlag\x12!\n" +
+	"\fsubmitted_at\x18\x06 \x01(\x03R\vsubmittedAt\"\xda\x03\n" +
+	"\x16ListSubmissionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fchallenge_id\x18\x02 \x01(\tR\vchallengeId\x12D\n" +
+	"\x06result\x18\x03 \x01(\x0e2,.api.server.v1.ListSubmissionsRequest.ResultR\x06result\x12\x14\n" +
+	"\x05since\x18\x04 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x05 \x01(\x03R\x05until\x12A\n" +
+	"\x05order\x18\x06 \x01(\x0e2+.api.server.v1.ListSubmissionsRequest.OrderR\x05order\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\b \x01(\x05R\bpageSize\"J\n" +
+	"\x06Result\x12\x16\n" +
+	"\x12RESULT_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eRESULT_CORRECT\x10\x01\x12\x14\n" +
+	"\x10RESULT_INCORRECT\x10\x02\"N\n" +
+	"\x05Order\x12\x15\n" +
+	"\x11ORDER_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ORDER_NEWEST_FIRST\x10\x01\x12\x16\n" +
+	"\x12ORDER_OLDEST_FIRST\x10\x02\"\xa1\x01\n" +
+	"\x17ListSubmissionsResponse\x12@\n" +
+	"\vsubmissions\x18\x01 \x03(\v2\x1e.api.server.v1.AdminSubmissionR\vsubmissions\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\x91\x03\n" +
+	"\x0fAdminSubmission\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\tR\fsubmissionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x17\n" +
+	"\ateam_id\x18\x04 \x01(\tR\x06teamId\x12!\n" +
+	"\fchallenge_id\x18\x05 \x01(\tR\vchallengeId\x12%\n" +
+	"\x0echallenge_name\x18\x06 \x01(\tR\rchallengeName\x12%\n" +
+	"\x0esubmitted_flag\x18\a \x01(\tR\rsubmittedFlag\x12\x18\n" +
+	"\acorrect\x18\b \x01(\bR\acorrect\x12\x17\n" +
+	"\apart_id\x18\t \x01(\tR\x06partId\x12\x1d\n" +
+	"\n" +
+	"solve_rank\x18\n" +
+	" \x01(\x05R\tsolveRank\x12!\n" +
+	"\fsubmitted_at\x18\v \x01(\x03R\vsubmittedAt\x12%\n" +
+	"\x0einvalidated_at\x18\f \x01(\x03R\rinvalidatedAt\"B\n" +
+	"\x1bInvalidateSubmissionRequest\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\tR\fsubmissionId\"C\n" +
+	"\x1cInvalidateSubmissionResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"\xaa\x01\n" +
+	"\tAdminUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12,\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x18.api.server.v1.StaffRoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1b\n" +
+	"\tbanned_at\x18\x05 \x01(\x03R\bbannedAt\"\x13\n" +
+	"\x11ListAdminsRequest\"k\n" +
+	"\x12ListAdminsResponse\x120\n" +
+	"\x06admins\x18\x01 \x03(\v2\x18.api.server.v1.AdminUserR\x06admins\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"]\n" +
+	"\x11GrantAdminRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12,\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x18.api.server.v1.StaffRoleR\x04role\"i\n" +
+	"\x12GrantAdminResponse\x12.\n" +
+	"\x05admin\x18\x01 \x01(\v2\x18.api.server.v1.AdminUserR\x05admin\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"-\n" +
+	"\x12RevokeAdminRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\":\n" +
+	"\x13RevokeAdminResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"]\n" +
+	"\x10ListUsersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x89\x01\n" +
+	"\x11ListUsersResponse\x12.\n" +
+	"\x05users\x18\x01 \x03(\v2\x18.api.server.v1.AdminUserR\x05users\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd8\x01\n" +
+	"\x0fGetUserResponse\x12,\n" +
+	"\x04user\x18\x01 \x01(\v2\x18.api.server.v1.AdminUserR\x04user\x126\n" +
+	"\x06solves\x18\x02 \x03(\v2\x1e.api.server.v1.AdminSubmissionR\x06solves\x12:\n" +
+	"\tinstances\x18\x03 \x03(\v2\x1c.api.server.v1.AdminInstanceR\tinstances\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"\xf8\x01\n" +
+	"\rAdminInstance\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12!\n" +
+	"\fchallenge_id\x18\x02 \x01(\tR\vchallengeId\x12%\n" +
+	"\x0echallenge_name\x18\x03 \x01(\tR\rchallengeName\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x12\n" +
+	"\x04host\x18\x05 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x06 \x01(\x05R\x04port\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\a \x01(\x03R\tstartedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\x03R\texpiresAt\")\n" +
+	"\x0eBanUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"6\n" +
+	"\x0fBanUserResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"+\n" +
+	"\x10UnbanUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"8\n" +
+	"\x11UnbanUserResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"3\n" +
+	"\x18ResetUserPasswordRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"o\n" +
+	"\x19ResetUserPasswordResponse\x12-\n" +
+	"\x12temporary_password\x18\x01 \x01(\tR\x11temporaryPassword\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\",\n" +
+	"\x11DeleteUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"9\n" +
+	"\x12DeleteUserResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"d\n" +
+	"\x11CreateHintRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x05R\x04cost\"R\n" +
+	"\x12CreateHintResponse\x12\x17\n" +
+	"\ahint_id\x18\x01 \x01(\tR\x06hintId\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"<\n" +
+	"\x11UpdateHintRequest\x12'\n" +
+	"\x04hint\x18\x01 \x01(\v2\x13.api.server.v1.HintR\x04hint\"9\n" +
+	"\x12UpdateHintResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\",\n" +
+	"\x11DeleteHintRequest\x12\x17\n" +
+	"\ahint_id\x18\x01 \x01(\tR\x06hintId\"9\n" +
+	"\x12DeleteHintResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"5\n" +
+	"\x10ListHintsRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\"c\n" +
+	"\x11ListHintsResponse\x12)\n" +
+	"\x05hints\x18\x01 \x03(\v2\x13.api.server.v1.HintR\x05hints\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"n\n" +
+	"\x19CreateAnnouncementRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"j\n" +
+	"\x1aCreateAnnouncementResponse\x12'\n" +
+	"\x0fannouncement_id\x18\x01 \x01(\tR\x0eannouncementId\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\\\n" +
+	"\x19UpdateAnnouncementRequest\x12?\n" +
+	"\fannouncement\x18\x01 \x01(\v2\x1b.api.server.v1.AnnouncementR\fannouncement\"A\n" +
+	"\x1aUpdateAnnouncementResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"D\n" +
+	"\x19DeleteAnnouncementRequest\x12'\n" +
+	"\x0fannouncement_id\x18\x01 \x01(\tR\x0eannouncementId\"A\n" +
+	"\x1aDeleteAnnouncementResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"\x1a\n" +
+	"\x18ListAnnouncementsRequest\"\x83\x01\n" +
+	"\x19ListAnnouncementsResponse\x12A\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x1b.api.server.v1.AnnouncementR\rannouncements\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\x8a\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x19\n" +
+	"\bactor_id\x18\x01 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12?\n" +
+	"\vtarget_type\x18\x03 \x01(\x0e2\x1e.api.server.v1.AuditTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\tR\btargetId\x12\x14\n" +
+	"\x05since\x18\x05 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x06 \x01(\x03R\x05until\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\b \x01(\x05R\bpageSize\"\x92\x01\n" +
+	"\x17ListAuditEventsResponse\x121\n" +
+	"\x06events\x18\x01 \x03(\v2\x19.api.server.v1.AuditEventR\x06events\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\xfb\x02\n" +
+	"\n" +
+	"AuditEvent\x12$\n" +
+	"\x0eaudit_event_id\x18\x01 \x01(\tR\fauditEventId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_name\x18\x03 \x01(\tR\tactorName\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12?\n" +
+	"\vtarget_type\x18\x05 \x01(\x0e2\x1e.api.server.v1.AuditTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x06 \x01(\tR\btargetId\x124\n" +
+	"\achanges\x18\a \x03(\v2\x1a.api.server.v1.AuditChangeR\achanges\x12#\n" +
+	"\rerror_message\x18\b \x01(\tR\ferrorMessage\x12\x1b\n" +
+	"\tclient_ip\x18\t \x01(\tR\bclientIp\x12\x1f\n" +
+	"\voccurred_at\x18\n" +
+	" \x01(\x03R\n" +
+	"occurredAt\"Q\n" +
+	"\vAuditChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"/\n" +
 	"\x11AdminLoginRequest\x12\x1a\n" +
 	"\bpassword\x18\x01 \x01(\tR\bpassword\"\x14\n" +
 	"\x12AdminLoginResponse\"\x14\n" +
@@ -1471,7 +4921,24 @@ const file_api_server_v1_admin_proto_rawDesc = "" +
 	"\x14BUILD_STATUS_PENDING\x10\x01\x12\x19\n" +
 	"\x15BUILD_STATUS_BUILDING\x10\x02\x12\x18\n" +
 	"\x14BUILD_STATUS_SUCCESS\x10\x03\x12\x17\n" +
-	"\x13BUILD_STATUS_FAILED\x10\x042\xba\b\n" +
+	"\x13BUILD_STATUS_FAILED\x10\x04*\x89\x01\n" +
+	"\x11FlagSharingReason\x12#\n" +
+	"\x1fFLAG_SHARING_REASON_UNSPECIFIED\x10\x00\x12)\n" +
+	"%FLAG_SHARING_REASON_SAME_WRONG_ANSWER\x10\x01\x12$\n" +
+	" FLAG_SHARING_REASON_CLOSE_SOLVES\x10\x02*q\n" +
+	"\tStaffRole\x12\x1a\n" +
+	"\x16STAFF_ROLE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15STAFF_ROLE_SUPERADMIN\x10\x01\x12\x15\n" +
+	"\x11STAFF_ROLE_AUTHOR\x10\x02\x12\x16\n" +
+	"\x12STAFF_ROLE_SUPPORT\x10\x03*\xf7\x01\n" +
+	"\x0fAuditTargetType\x12!\n" +
+	"\x1dAUDIT_TARGET_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bAUDIT_TARGET_TYPE_CHALLENGE\x10\x01\x12\x1a\n" +
+	"\x16AUDIT_TARGET_TYPE_HINT\x10\x02\x12\"\n" +
+	"\x1eAUDIT_TARGET_TYPE_ANNOUNCEMENT\x10\x03\x12\"\n" +
+	"\x1eAUDIT_TARGET_TYPE_EVENT_CONFIG\x10\x04\x12 \n" +
+	"\x1cAUDIT_TARGET_TYPE_SUBMISSION\x10\x05\x12\x1a\n" +
+	"\x16AUDIT_TARGET_TYPE_USER\x10\x062\x84\x19\n" +
 	"\fAdminService\x12`\n" +
 	"\x0fCreateChallenge\x12%.api.server.v1.CreateChallengeRequest\x1a&.api.server.v1.CreateChallengeResponse\x12`\n" +
 	"\x0fUpdateChallenge\x12%.api.server.v1.UpdateChallengeRequest\x1a&.api.server.v1.UpdateChallengeResponse\x12o\n" +
@@ -1483,7 +4950,36 @@ const file_api_server_v1_admin_proto_rawDesc = "" +
 	"\vGetBuildLog\x12!.api.server.v1.GetBuildLogRequest\x1a\".api.server.v1.GetBuildLogResponse\x12_\n" +
 	"\x0eStreamBuildLog\x12$.api.server.v1.StreamBuildLogRequest\x1a%.api.server.v1.StreamBuildLogResponse0\x01\x12c\n" +
 	"\x10UploadAttachment\x12&.api.server.v1.UploadAttachmentRequest\x1a'.api.server.v1.UploadAttachmentResponse\x12c\n" +
-	"\x10DeleteAttachment\x12&.api.server.v1.DeleteAttachmentRequest\x1a'.api.server.v1.DeleteAttachmentResponse2\xbb\x01\n" +
+	"\x10DeleteAttachment\x12&.api.server.v1.DeleteAttachmentRequest\x1a'.api.server.v1.DeleteAttachmentResponse\x12]\n" +
+	"\x0eGetEventConfig\x12$.api.server.v1.GetEventConfigRequest\x1a%.api.server.v1.GetEventConfigResponse\x12f\n" +
+	"\x11UpdateEventConfig\x12'.api.server.v1.UpdateEventConfigRequest\x1a(.api.server.v1.UpdateEventConfigResponse\x12o\n" +
+	"\x14GetFlagSharingReport\x12*.api.server.v1.GetFlagSharingReportRequest\x1a+.api.server.v1.GetFlagSharingReportResponse\x12`\n" +
+	"\x0fListSubmissions\x12%.api.server.v1.ListSubmissionsRequest\x1a&.api.server.v1.ListSubmissionsResponse\x12o\n" +
+	"\x14InvalidateSubmission\x12*.api.server.v1.InvalidateSubmissionRequest\x1a+.api.server.v1.InvalidateSubmissionResponse\x12Q\n" +
+	"\n" +
+	"ListAdmins\x12 .api.server.v1.ListAdminsRequest\x1a!.api.server.v1.ListAdminsResponse\x12Q\n" +
+	"\n" +
+	"GrantAdmin\x12 .api.server.v1.GrantAdminRequest\x1a!.api.server.v1.GrantAdminResponse\x12T\n" +
+	"\vRevokeAdmin\x12!.api.server.v1.RevokeAdminRequest\x1a\".api.server.v1.RevokeAdminResponse\x12N\n" +
+	"\tListUsers\x12\x1f.api.server.v1.ListUsersRequest\x1a .api.server.v1.ListUsersResponse\x12H\n" +
+	"\aGetUser\x12\x1d.api.server.v1.GetUserRequest\x1a\x1e.api.server.v1.GetUserResponse\x12H\n" +
+	"\aBanUser\x12\x1d.api.server.v1.BanUserRequest\x1a\x1e.api.server.v1.BanUserResponse\x12N\n" +
+	"\tUnbanUser\x12\x1f.api.server.v1.UnbanUserRequest\x1a .api.server.v1.UnbanUserResponse\x12f\n" +
+	"\x11ResetUserPassword\x12'.api.server.v1.ResetUserPasswordRequest\x1a(.api.server.v1.ResetUserPasswordResponse\x12Q\n" +
+	"\n" +
+	"DeleteUser\x12 .api.server.v1.DeleteUserRequest\x1a!.api.server.v1.DeleteUserResponse\x12Q\n" +
+	"\n" +
+	"CreateHint\x12 .api.server.v1.CreateHintRequest\x1a!.api.server.v1.CreateHintResponse\x12Q\n" +
+	"\n" +
+	"UpdateHint\x12 .api.server.v1.UpdateHintRequest\x1a!.api.server.v1.UpdateHintResponse\x12Q\n" +
+	"\n" +
+	"DeleteHint\x12 .api.server.v1.DeleteHintRequest\x1a!.api.server.v1.DeleteHintResponse\x12N\n" +
+	"\tListHints\x12\x1f.api.server.v1.ListHintsRequest\x1a .api.server.v1.ListHintsResponse\x12i\n" +
+	"\x12CreateAnnouncement\x12(.api.server.v1.CreateAnnouncementRequest\x1a).api.server.v1.CreateAnnouncementResponse\x12i\n" +
+	"\x12UpdateAnnouncement\x12(.api.server.v1.UpdateAnnouncementRequest\x1a).api.server.v1.UpdateAnnouncementResponse\x12i\n" +
+	"\x12DeleteAnnouncement\x12(.api.server.v1.DeleteAnnouncementRequest\x1a).api.server.v1.DeleteAnnouncementResponse\x12f\n" +
+	"\x11ListAnnouncements\x12'.api.server.v1.ListAnnouncementsRequest\x1a(.api.server.v1.ListAnnouncementsResponse\x12`\n" +
+	"\x0fListAuditEvents\x12%.api.server.v1.ListAuditEventsRequest\x1a&.api.server.v1.ListAuditEventsResponse2\xbb\x01\n" +
 	"\x10AdminAuthService\x12Q\n" +
 	"\n" +
 	"AdminLogin\x12 .api.server.v1.AdminLoginRequest\x1a!.api.server.v1.AdminLoginResponse\x12T\n" +
@@ -1503,82 +4999,213 @@ func file_api_server_v1_admin_proto_rawDescGZIP() []byte {
 	return file_api_server_v1_admin_proto_rawDescData
 }
 
-var file_api_server_v1_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_server_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_api_server_v1_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_api_server_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
 var file_api_server_v1_admin_proto_goTypes = []any{
 	(BuildStatus)(0),                     // 0: api.server.v1.BuildStatus
-	(*CreateChallengeRequest)(nil),       // 1: api.server.v1.CreateChallengeRequest
-	(*CreateChallengeResponse)(nil),      // 2: api.server.v1.CreateChallengeResponse
-	(*UpdateChallengeRequest)(nil),       // 3: api.server.v1.UpdateChallengeRequest
-	(*UpdateChallengeResponse)(nil),      // 4: api.server.v1.UpdateChallengeResponse
-	(*UploadChallengeImageRequest)(nil),  // 5: api.server.v1.UploadChallengeImageRequest
-	(*UploadChallengeImageResponse)(nil), // 6: api.server.v1.UploadChallengeImageResponse
-	(*DeleteChallengeRequest)(nil),       // 7: api.server.v1.DeleteChallengeRequest
-	(*DeleteChallengeResponse)(nil),      // 8: api.server.v1.DeleteChallengeResponse
-	(*ListChallengesRequest)(nil),        // 9: api.server.v1.ListChallengesRequest
-	(*ListChallengesResponse)(nil),       // 10: api.server.v1.ListChallengesResponse
-	(*GetChallengeRequest)(nil),          // 11: api.server.v1.GetChallengeRequest
-	(*GetChallengeResponse)(nil),         // 12: api.server.v1.GetChallengeResponse
-	(*BuildLogSummary)(nil),              // 13: api.server.v1.BuildLogSummary
-	(*ListBuildLogsRequest)(nil),         // 14: api.server.v1.ListBuildLogsRequest
-	(*ListBuildLogsResponse)(nil),        // 15: api.server.v1.ListBuildLogsResponse
-	(*GetBuildLogRequest)(nil),           // 16: api.server.v1.GetBuildLogRequest
-	(*GetBuildLogResponse)(nil),          // 17: api.server.v1.GetBuildLogResponse
-	(*StreamBuildLogRequest)(nil),        // 18: api.server.v1.StreamBuildLogRequest
-	(*StreamBuildLogResponse)(nil),       // 19: api.server.v1.StreamBuildLogResponse
-	(*UploadAttachmentRequest)(nil),      // 20: api.server.v1.UploadAttachmentRequest
-	(*UploadAttachmentResponse)(nil),     // 21: api.server.v1.UploadAttachmentResponse
-	(*DeleteAttachmentRequest)(nil),      // 22: api.server.v1.DeleteAttachmentRequest
-	(*DeleteAttachmentResponse)(nil),     // 23: api.server.v1.DeleteAttachmentResponse
-	(*AdminLoginRequest)(nil),            // 24: api.server.v1.AdminLoginRequest
-	(*AdminLoginResponse)(nil),           // 25: api.server.v1.AdminLoginResponse
-	(*AdminLogoutRequest)(nil),           // 26: api.server.v1.AdminLogoutRequest
-	(*AdminLogoutResponse)(nil),          // 27: api.server.v1.AdminLogoutResponse
-	(*ChallengeRequest)(nil),             // 28: api.server.v1.ChallengeRequest
-	(*Challenge)(nil),                    // 29: api.server.v1.Challenge
-	(*Attachment)(nil),                   // 30: api.server.v1.Attachment
+	(FlagSharingReason)(0),               // 1: api.server.v1.FlagSharingReason
+	(StaffRole)(0),                       // 2: api.server.v1.StaffRole
+	(AuditTargetType)(0),                 // 3: api.server.v1.AuditTargetType
+	(ListSubmissionsRequest_Result)(0),   // 4: api.server.v1.ListSubmissionsRequest.Result
+	(ListSubmissionsRequest_Order)(0),    // 5: api.server.v1.ListSubmissionsRequest.Order
+	(*CreateChallengeRequest)(nil),       // 6: api.server.v1.CreateChallengeRequest
+	(*CreateChallengeResponse)(nil),      // 7: api.server.v1.CreateChallengeResponse
+	(*UpdateChallengeRequest)(nil),       // 8: api.server.v1.UpdateChallengeRequest
+	(*UpdateChallengeResponse)(nil),      // 9: api.server.v1.UpdateChallengeResponse
+	(*UploadChallengeImageRequest)(nil),  // 10: api.server.v1.UploadChallengeImageRequest
+	(*UploadChallengeImageResponse)(nil), // 11: api.server.v1.UploadChallengeImageResponse
+	(*DeleteChallengeRequest)(nil),       // 12: api.server.v1.DeleteChallengeRequest
+	(*DeleteChallengeResponse)(nil),      // 13: api.server.v1.DeleteChallengeResponse
+	(*ListChallengesRequest)(nil),        // 14: api.server.v1.ListChallengesRequest
+	(*ListChallengesResponse)(nil),       // 15: api.server.v1.ListChallengesResponse
+	(*GetChallengeRequest)(nil),          // 16: api.server.v1.GetChallengeRequest
+	(*GetChallengeResponse)(nil),         // 17: api.server.v1.GetChallengeResponse
+	(*BuildLogSummary)(nil),              // 18: api.server.v1.BuildLogSummary
+	(*ListBuildLogsRequest)(nil),         // 19: api.server.v1.ListBuildLogsRequest
+	(*ListBuildLogsResponse)(nil),        // 20: api.server.v1.ListBuildLogsResponse
+	(*GetBuildLogRequest)(nil),           // 21: api.server.v1.GetBuildLogRequest
+	(*GetBuildLogResponse)(nil),          // 22: api.server.v1.GetBuildLogResponse
+	(*StreamBuildLogRequest)(nil),        // 23: api.server.v1.StreamBuildLogRequest
+	(*StreamBuildLogResponse)(nil),       // 24: api.server.v1.StreamBuildLogResponse
+	(*UploadAttachmentRequest)(nil),      // 25: api.server.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),     // 26: api.server.v1.UploadAttachmentResponse
+	(*DeleteAttachmentRequest)(nil),      // 27: api.server.v1.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),     // 28: api.server.v1.DeleteAttachmentResponse
+	(*GetEventConfigRequest)(nil),        // 29: api.server.v1.GetEventConfigRequest
+	(*GetEventConfigResponse)(nil),       // 30: api.server.v1.GetEventConfigResponse
+	(*UpdateEventConfigRequest)(nil),     // 31: api.server.v1.UpdateEventConfigRequest
+	(*UpdateEventConfigResponse)(nil),    // 32: api.server.v1.UpdateEventConfigResponse
+	(*GetFlagSharingReportRequest)(nil),  // 33: api.server.v1.GetFlagSharingReportRequest
+	(*GetFlagSharingReportResponse)(nil), // 34: api.server.v1.GetFlagSharingReportResponse
+	(*FlagSharingCluster)(nil),           // 35: api.server.v1.FlagSharingCluster
+	(*FlagSharingSubmission)(nil),        // 36: api.server.v1.FlagSharingSubmission
+	(*ListSubmissionsRequest)(nil),       // 37: api.server.v1.ListSubmissionsRequest
+	(*ListSubmissionsResponse)(nil),      // 38: api.server.v1.ListSubmissionsResponse
+	(*AdminSubmission)(nil),              // 39: api.server.v1.AdminSubmission
+	(*InvalidateSubmissionRequest)(nil),  // 40: api.server.v1.InvalidateSubmissionRequest
+	(*InvalidateSubmissionResponse)(nil), // 41: api.server.v1.InvalidateSubmissionResponse
+	(*AdminUser)(nil),                    // 42: api.server.v1.AdminUser
+	(*ListAdminsRequest)(nil),            // 43: api.server.v1.ListAdminsRequest
+	(*ListAdminsResponse)(nil),           // 44: api.server.v1.ListAdminsResponse
+	(*GrantAdminRequest)(nil),            // 45: api.server.v1.GrantAdminRequest
+	(*GrantAdminResponse)(nil),           // 46: api.server.v1.GrantAdminResponse
+	(*RevokeAdminRequest)(nil),           // 47: api.server.v1.RevokeAdminRequest
+	(*RevokeAdminResponse)(nil),          // 48: api.server.v1.RevokeAdminResponse
+	(*ListUsersRequest)(nil),             // 49: api.server.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),            // 50: api.server.v1.ListUsersResponse
+	(*GetUserRequest)(nil),               // 51: api.server.v1.GetUserRequest
+	(*GetUserResponse)(nil),              // 52: api.server.v1.GetUserResponse
+	(*AdminInstance)(nil),                // 53: api.server.v1.AdminInstance
+	(*BanUserRequest)(nil),               // 54: api.server.v1.BanUserRequest
+	(*BanUserResponse)(nil),              // 55: api.server.v1.BanUserResponse
+	(*UnbanUserRequest)(nil),             // 56: api.server.v1.UnbanUserRequest
+	(*UnbanUserResponse)(nil),            // 57: api.server.v1.UnbanUserResponse
+	(*ResetUserPasswordRequest)(nil),     // 58: api.server.v1.ResetUserPasswordRequest
+	(*ResetUserPasswordResponse)(nil),    // 59: api.server.v1.ResetUserPasswordResponse
+	(*DeleteUserRequest)(nil),            // 60: api.server.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),           // 61: api.server.v1.DeleteUserResponse
+	(*CreateHintRequest)(nil),            // 62: api.server.v1.CreateHintRequest
+	(*CreateHintResponse)(nil),           // 63: api.server.v1.CreateHintResponse
+	(*UpdateHintRequest)(nil),            // 64: api.server.v1.UpdateHintRequest
+	(*UpdateHintResponse)(nil),           // 65: api.server.v1.UpdateHintResponse
+	(*DeleteHintRequest)(nil),            // 66: api.server.v1.DeleteHintRequest
+	(*DeleteHintResponse)(nil),           // 67: api.server.v1.DeleteHintResponse
+	(*ListHintsRequest)(nil),             // 68: api.server.v1.ListHintsRequest
+	(*ListHintsResponse)(nil),            // 69: api.server.v1.ListHintsResponse
+	(*CreateAnnouncementRequest)(nil),    // 70: api.server.v1.CreateAnnouncementRequest
+	(*CreateAnnouncementResponse)(nil),   // 71: api.server.v1.CreateAnnouncementResponse
+	(*UpdateAnnouncementRequest)(nil),    // 72: api.server.v1.UpdateAnnouncementRequest
+	(*UpdateAnnouncementResponse)(nil),   // 73: api.server.v1.UpdateAnnouncementResponse
+	(*DeleteAnnouncementRequest)(nil),    // 74: api.server.v1.DeleteAnnouncementRequest
+	(*DeleteAnnouncementResponse)(nil),   // 75: api.server.v1.DeleteAnnouncementResponse
+	(*ListAnnouncementsRequest)(nil),     // 76: api.server.v1.ListAnnouncementsRequest
+	(*ListAnnouncementsResponse)(nil),    // 77: api.server.v1.ListAnnouncementsResponse
+	(*ListAuditEventsRequest)(nil),       // 78: api.server.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),      // 79: api.server.v1.ListAuditEventsResponse
+	(*AuditEvent)(nil),                   // 80: api.server.v1.AuditEvent
+	(*AuditChange)(nil),                  // 81: api.server.v1.AuditChange
+	(*AdminLoginRequest)(nil),            // 82: api.server.v1.AdminLoginRequest
+	(*AdminLoginResponse)(nil),           // 83: api.server.v1.AdminLoginResponse
+	(*AdminLogoutRequest)(nil),           // 84: api.server.v1.AdminLogoutRequest
+	(*AdminLogoutResponse)(nil),          // 85: api.server.v1.AdminLogoutResponse
+	(*ChallengeRequest)(nil),             // 86: api.server.v1.ChallengeRequest
+	(*Challenge)(nil),                    // 87: api.server.v1.Challenge
+	(*Attachment)(nil),                   // 88: api.server.v1.Attachment
+	(*EventConfig)(nil),                  // 89: api.server.v1.EventConfig
+	(*Hint)(nil),                         // 90: api.server.v1.Hint
+	(*Announcement)(nil),                 // 91: api.server.v1.Announcement
 }
 var file_api_server_v1_admin_proto_depIdxs = []int32{
-	28, // 0: api.server.v1.CreateChallengeRequest.challenge:type_name -> api.server.v1.ChallengeRequest
-	29, // 1: api.server.v1.UpdateChallengeRequest.challenge:type_name -> api.server.v1.Challenge
-	29, // 2: api.server.v1.ListChallengesResponse.challenges:type_name -> api.server.v1.Challenge
-	29, // 3: api.server.v1.GetChallengeResponse.challenge:type_name -> api.server.v1.Challenge
+	86, // 0: api.server.v1.CreateChallengeRequest.challenge:type_name -> api.server.v1.ChallengeRequest
+	87, // 1: api.server.v1.UpdateChallengeRequest.challenge:type_name -> api.server.v1.Challenge
+	87, // 2: api.server.v1.ListChallengesResponse.challenges:type_name -> api.server.v1.Challenge
+	87, // 3: api.server.v1.GetChallengeResponse.challenge:type_name -> api.server.v1.Challenge
 	0,  // 4: api.server.v1.BuildLogSummary.status:type_name -> api.server.v1.BuildStatus
-	13, // 5: api.server.v1.ListBuildLogsResponse.logs:type_name -> api.server.v1.BuildLogSummary
+	18, // 5: api.server.v1.ListBuildLogsResponse.logs:type_name -> api.server.v1.BuildLogSummary
 	0,  // 6: api.server.v1.GetBuildLogResponse.status:type_name -> api.server.v1.BuildStatus
 	0,  // 7: api.server.v1.StreamBuildLogResponse.status:type_name -> api.server.v1.BuildStatus
-	30, // 8: api.server.v1.UploadAttachmentResponse.attachment:type_name -> api.server.v1.Attachment
-	1,  // 9: api.server.v1.AdminService.CreateChallenge:input_type -> api.server.v1.CreateChallengeRequest
-	3,  // 10: api.server.v1.AdminService.UpdateChallenge:input_type -> api.server.v1.UpdateChallengeRequest
-	5,  // 11: api.server.v1.AdminService.UploadChallengeImage:input_type -> api.server.v1.UploadChallengeImageRequest
-	7,  // 12: api.server.v1.AdminService.DeleteChallenge:input_type -> api.server.v1.DeleteChallengeRequest
-	9,  // 13: api.server.v1.AdminService.ListChallenges:input_type -> api.server.v1.ListChallengesRequest
-	11, // 14: api.server.v1.AdminService.GetChallenge:input_type -> api.server.v1.GetChallengeRequest
-	14, // 15: api.server.v1.AdminService.ListBuildLogs:input_type -> api.server.v1.ListBuildLogsRequest
-	16, // 16: api.server.v1.AdminService.GetBuildLog:input_type -> api.server.v1.GetBuildLogRequest
-	18, // 17: api.server.v1.AdminService.StreamBuildLog:input_type -> api.server.v1.StreamBuildLogRequest
-	20, // 18: api.server.v1.AdminService.UploadAttachment:input_type -> api.server.v1.UploadAttachmentRequest
-	22, // 19: api.server.v1.AdminService.DeleteAttachment:input_type -> api.server.v1.DeleteAttachmentRequest
-	24, // 20: api.server.v1.AdminAuthService.AdminLogin:input_type -> api.server.v1.AdminLoginRequest
-	26, // 21: api.server.v1.AdminAuthService.AdminLogout:input_type -> api.server.v1.AdminLogoutRequest
-	2,  // 22: api.server.v1.AdminService.CreateChallenge:output_type -> api.server.v1.CreateChallengeResponse
-	4,  // 23: api.server.v1.AdminService.UpdateChallenge:output_type -> api.server.v1.UpdateChallengeResponse
-	6,  // 24: api.server.v1.AdminService.UploadChallengeImage:output_type -> api.server.v1.UploadChallengeImageResponse
-	8,  // 25: api.server.v1.AdminService.DeleteChallenge:output_type -> api.server.v1.DeleteChallengeResponse
-	10, // 26: api.server.v1.AdminService.ListChallenges:output_type -> api.server.v1.ListChallengesResponse
-	12, // 27: api.server.v1.AdminService.GetChallenge:output_type -> api.server.v1.GetChallengeResponse
-	15, // 28: api.server.v1.AdminService.ListBuildLogs:output_type -> api.server.v1.ListBuildLogsResponse
-	17, // 29: api.server.v1.AdminService.GetBuildLog:output_type -> api.server.v1.GetBuildLogResponse
-	19, // 30: api.server.v1.AdminService.StreamBuildLog:output_type -> api.server.v1.StreamBuildLogResponse
-	21, // 31: api.server.v1.AdminService.UploadAttachment:output_type -> api.server.v1.UploadAttachmentResponse
-	23, // 32: api.server.v1.AdminService.DeleteAttachment:output_type -> api.server.v1.DeleteAttachmentResponse
-	25, // 33: api.server.v1.AdminAuthService.AdminLogin:output_type -> api.server.v1.AdminLoginResponse
-	27, // 34: api.server.v1.AdminAuthService.AdminLogout:output_type -> api.server.v1.AdminLogoutResponse
-	22, // [22:35] is the sub-list for method output_type
-	9,  // [9:22] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	88, // 8: api.server.v1.UploadAttachmentResponse.attachment:type_name -> api.server.v1.Attachment
+	89, // 9: api.server.v1.GetEventConfigResponse.event_config:type_name -> api.server.v1.EventConfig
+	89, // 10: api.server.v1.UpdateEventConfigRequest.event_config:type_name -> api.server.v1.EventConfig
+	35, // 11: api.server.v1.GetFlagSharingReportResponse.clusters:type_name -> api.server.v1.FlagSharingCluster
+	1,  // 12: api.server.v1.FlagSharingCluster.reason:type_name -> api.server.v1.FlagSharingReason
+	36, // 13: api.server.v1.FlagSharingCluster.submissions:type_name -> api.server.v1.FlagSharingSubmission
+	4,  // 14: api.server.v1.ListSubmissionsRequest.result:type_name -> api.server.v1.ListSubmissionsRequest.Result
+	5,  // 15: api.server.v1.ListSubmissionsRequest.order:type_name -> api.server.v1.ListSubmissionsRequest.Order
+	39, // 16: api.server.v1.ListSubmissionsResponse.submissions:type_name -> api.server.v1.AdminSubmission
+	2,  // 17: api.server.v1.AdminUser.role:type_name -> api.server.v1.StaffRole
+	42, // 18: api.server.v1.ListAdminsResponse.admins:type_name -> api.server.v1.AdminUser
+	2,  // 19: api.server.v1.GrantAdminRequest.role:type_name -> api.server.v1.StaffRole
+	42, // 20: api.server.v1.GrantAdminResponse.admin:type_name -> api.server.v1.AdminUser
+	42, // 21: api.server.v1.ListUsersResponse.users:type_name -> api.server.v1.AdminUser
+	42, // 22: api.server.v1.GetUserResponse.user:type_name -> api.server.v1.AdminUser
+	39, // 23: api.server.v1.GetUserResponse.solves:type_name -> api.server.v1.AdminSubmission
+	53, // 24: api.server.v1.GetUserResponse.instances:type_name -> api.server.v1.AdminInstance
+	90, // 25: api.server.v1.UpdateHintRequest.hint:type_name -> api.server.v1.Hint
+	90, // 26: api.server.v1.ListHintsResponse.hints:type_name -> api.server.v1.Hint
+	91, // 27: api.server.v1.UpdateAnnouncementRequest.announcement:type_name -> api.server.v1.Announcement
+	91, // 28: api.server.v1.ListAnnouncementsResponse.announcements:type_name -> api.server.v1.Announcement
+	3,  // 29: api.server.v1.ListAuditEventsRequest.target_type:type_name -> api.server.v1.AuditTargetType
+	80, // 30: api.server.v1.ListAuditEventsResponse.events:type_name -> api.server.v1.AuditEvent
+	3,  // 31: api.server.v1.AuditEvent.target_type:type_name -> api.server.v1.AuditTargetType
+	81, // 32: api.server.v1.AuditEvent.changes:type_name -> api.server.v1.AuditChange
+	6,  // 33: api.server.v1.AdminService.CreateChallenge:input_type -> api.server.v1.CreateChallengeRequest
+	8,  // 34: api.server.v1.AdminService.UpdateChallenge:input_type -> api.server.v1.UpdateChallengeRequest
+	10, // 35: api.server.v1.AdminService.UploadChallengeImage:input_type -> api.server.v1.UploadChallengeImageRequest
+	12, // 36: api.server.v1.AdminService.DeleteChallenge:input_type -> api.server.v1.DeleteChallengeRequest
+	14, // 37: api.server.v1.AdminService.ListChallenges:input_type -> api.server.v1.ListChallengesRequest
+	16, // 38: api.server.v1.AdminService.GetChallenge:input_type -> api.server.v1.GetChallengeRequest
+	19, // 39: api.server.v1.AdminService.ListBuildLogs:input_type -> api.server.v1.ListBuildLogsRequest
+	21, // 40: api.server.v1.AdminService.GetBuildLog:input_type -> api.server.v1.GetBuildLogRequest
+	23, // 41: api.server.v1.AdminService.StreamBuildLog:input_type -> api.server.v1.StreamBuildLogRequest
+	25, // 42: api.server.v1.AdminService.UploadAttachment:input_type -> api.server.v1.UploadAttachmentRequest
+	27, // 43: api.server.v1.AdminService.DeleteAttachment:input_type -> api.server.v1.DeleteAttachmentRequest
+	29, // 44: api.server.v1.AdminService.GetEventConfig:input_type -> api.server.v1.GetEventConfigRequest
+	31, // 45: api.server.v1.AdminService.UpdateEventConfig:input_type -> api.server.v1.UpdateEventConfigRequest
+	33, // 46: api.server.v1.AdminService.GetFlagSharingReport:input_type -> api.server.v1.GetFlagSharingReportRequest
+	37, // 47: api.server.v1.AdminService.ListSubmissions:input_type -> api.server.v1.ListSubmissionsRequest
+	40, // 48: api.server.v1.AdminService.InvalidateSubmission:input_type -> api.server.v1.InvalidateSubmissionRequest
+	43, // 49: api.server.v1.AdminService.ListAdmins:input_type -> api.server.v1.ListAdminsRequest
+	45, // 50: api.server.v1.AdminService.GrantAdmin:input_type -> api.server.v1.GrantAdminRequest
+	47, // 51: api.server.v1.AdminService.RevokeAdmin:input_type -> api.server.v1.RevokeAdminRequest
+	49, // 52: api.server.v1.AdminService.ListUsers:input_type -> api.server.v1.ListUsersRequest
+	51, // 53: api.server.v1.AdminService.GetUser:input_type -> api.server.v1.GetUserRequest
+	54, // 54: api.server.v1.AdminService.BanUser:input_type -> api.server.v1.BanUserRequest
+	56, // 55: api.server.v1.AdminService.UnbanUser:input_type -> api.server.v1.UnbanUserRequest
+	58, // 56: api.server.v1.AdminService.ResetUserPassword:input_type -> api.server.v1.ResetUserPasswordRequest
+	60, // 57: api.server.v1.AdminService.DeleteUser:input_type -> api.server.v1.DeleteUserRequest
+	62, // 58: api.server.v1.AdminService.CreateHint:input_type -> api.server.v1.CreateHintRequest
+	64, // 59: api.server.v1.AdminService.UpdateHint:input_type -> api.server.v1.UpdateHintRequest
+	66, // 60: api.server.v1.AdminService.DeleteHint:input_type -> api.server.v1.DeleteHintRequest
+	68, // 61: api.server.v1.AdminService.ListHints:input_type -> api.server.v1.ListHintsRequest
+	70, // 62: api.server.v1.AdminService.CreateAnnouncement:input_type -> api.server.v1.CreateAnnouncementRequest
+	72, // 63: api.server.v1.AdminService.UpdateAnnouncement:input_type -> api.server.v1.UpdateAnnouncementRequest
+	74, // 64: api.server.v1.AdminService.DeleteAnnouncement:input_type -> api.server.v1.DeleteAnnouncementRequest
+	76, // 65: api.server.v1.AdminService.ListAnnouncements:input_type -> api.server.v1.ListAnnouncementsRequest
+	78, // 66: api.server.v1.AdminService.ListAuditEvents:input_type -> api.server.v1.ListAuditEventsRequest
+	82, // 67: api.server.v1.AdminAuthService.AdminLogin:input_type -> api.server.v1.AdminLoginRequest
+	84, // 68: api.server.v1.AdminAuthService.AdminLogout:input_type -> api.server.v1.AdminLogoutRequest
+	7,  // 69: api.server.v1.AdminService.CreateChallenge:output_type -> api.server.v1.CreateChallengeResponse
+	9,  // 70: api.server.v1.AdminService.UpdateChallenge:output_type -> api.server.v1.UpdateChallengeResponse
+	11, // 71: api.server.v1.AdminService.UploadChallengeImage:output_type -> api.server.v1.UploadChallengeImageResponse
+	13, // 72: api.server.v1.AdminService.DeleteChallenge:output_type -> api.server.v1.DeleteChallengeResponse
+	15, // 73: api.server.v1.AdminService.ListChallenges:output_type -> api.server.v1.ListChallengesResponse
+	17, // 74: api.server.v1.AdminService.GetChallenge:output_type -> api.server.v1.GetChallengeResponse
+	20, // 75: api.server.v1.AdminService.ListBuildLogs:output_type -> api.server.v1.ListBuildLogsResponse
+	22, // 76: api.server.v1.AdminService.GetBuildLog:output_type -> api.server.v1.GetBuildLogResponse
+	24, // 77: api.server.v1.AdminService.StreamBuildLog:output_type -> api.server.v1.StreamBuildLogResponse
+	26, // 78: api.server.v1.AdminService.UploadAttachment:output_type -> api.server.v1.UploadAttachmentResponse
+	28, // 79: api.server.v1.AdminService.DeleteAttachment:output_type -> api.server.v1.DeleteAttachmentResponse
+	30, // 80: api.server.v1.AdminService.GetEventConfig:output_type -> api.server.v1.GetEventConfigResponse
+	32, // 81: api.server.v1.AdminService.UpdateEventConfig:output_type -> api.server.v1.UpdateEventConfigResponse
+	34, // 82: api.server.v1.AdminService.GetFlagSharingReport:output_type -> api.server.v1.GetFlagSharingReportResponse
+	38, // 83: api.server.v1.AdminService.ListSubmissions:output_type -> api.server.v1.ListSubmissionsResponse
+	41, // 84: api.server.v1.AdminService.InvalidateSubmission:output_type -> api.server.v1.InvalidateSubmissionResponse
+	44, // 85: api.server.v1.AdminService.ListAdmins:output_type -> api.server.v1.ListAdminsResponse
+	46, // 86: api.server.v1.AdminService.GrantAdmin:output_type -> api.server.v1.GrantAdminResponse
+	48, // 87: api.server.v1.AdminService.RevokeAdmin:output_type -> api.server.v1.RevokeAdminResponse
+	50, // 88: api.server.v1.AdminService.ListUsers:output_type -> api.server.v1.ListUsersResponse
+	52, // 89: api.server.v1.AdminService.GetUser:output_type -> api.server.v1.GetUserResponse
+	55, // 90: api.server.v1.AdminService.BanUser:output_type -> api.server.v1.BanUserResponse
+	57, // 91: api.server.v1.AdminService.UnbanUser:output_type -> api.server.v1.UnbanUserResponse
+	59, // 92: api.server.v1.AdminService.ResetUserPassword:output_type -> api.server.v1.ResetUserPasswordResponse
+	61, // 93: api.server.v1.AdminService.DeleteUser:output_type -> api.server.v1.DeleteUserResponse
+	63, // 94: api.server.v1.AdminService.CreateHint:output_type -> api.server.v1.CreateHintResponse
+	65, // 95: api.server.v1.AdminService.UpdateHint:output_type -> api.server.v1.UpdateHintResponse
+	67, // 96: api.server.v1.AdminService.DeleteHint:output_type -> api.server.v1.DeleteHintResponse
+	69, // 97: api.server.v1.AdminService.ListHints:output_type -> api.server.v1.ListHintsResponse
+	71, // 98: api.server.v1.AdminService.CreateAnnouncement:output_type -> api.server.v1.CreateAnnouncementResponse
+	73, // 99: api.server.v1.AdminService.UpdateAnnouncement:output_type -> api.server.v1.UpdateAnnouncementResponse
+	75, // 100: api.server.v1.AdminService.DeleteAnnouncement:output_type -> api.server.v1.DeleteAnnouncementResponse
+	77, // 101: api.server.v1.AdminService.ListAnnouncements:output_type -> api.server.v1.ListAnnouncementsResponse
+	79, // 102: api.server.v1.AdminService.ListAuditEvents:output_type -> api.server.v1.ListAuditEventsResponse
+	83, // 103: api.server.v1.AdminAuthService.AdminLogin:output_type -> api.server.v1.AdminLoginResponse
+	85, // 104: api.server.v1.AdminAuthService.AdminLogout:output_type -> api.server.v1.AdminLogoutResponse
+	69, // [69:105] is the sub-list for method output_type
+	33, // [33:69] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_api_server_v1_admin_proto_init() }
@@ -1592,8 +5219,8 @@ func file_api_server_v1_admin_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_server_v1_admin_proto_rawDesc), len(file_api_server_v1_admin_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   27,
+			NumEnums:      6,
+			NumMessages:   80,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
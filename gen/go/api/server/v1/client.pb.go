@@ -70,7 +70,7 @@ func (x GetInstanceStatusResponse_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetInstanceStatusResponse_Status.Descriptor instead.
 func (GetInstanceStatusResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{9, 0}
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{13, 0}
 }
 
 type GetChallengesRequest struct {
@@ -206,12 +206,13 @@ func (x *SubmitFlagRequest) GetSubmission() *Submission {
 }
 
 type SubmitFlagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Correct       bool                   `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
-	PointsAwarded int32                  `protobuf:"varint,2,opt,name=points_awarded,json=pointsAwarded,proto3" json:"points_awarded,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Correct           bool                   `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+	PointsAwarded     int32                  `protobuf:"varint,2,opt,name=points_awarded,json=pointsAwarded,proto3" json:"points_awarded,omitempty"`
+	ErrorMessage      string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	RetryAfterSeconds int32                  `protobuf:"varint,4,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"` // set when the submission was rate limited
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SubmitFlagResponse) Reset() {
@@ -265,6 +266,213 @@ func (x *SubmitFlagResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *SubmitFlagResponse) GetRetryAfterSeconds() int32 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+type GetScoreboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScoreboardRequest) Reset() {
+	*x = GetScoreboardRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScoreboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreboardRequest) ProtoMessage() {}
+
+func (x *GetScoreboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreboardRequest.ProtoReflect.Descriptor instead.
+func (*GetScoreboardRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{4}
+}
+
+type GetScoreboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*ScoreboardEntry     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Frozen        bool                   `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScoreboardResponse) Reset() {
+	*x = GetScoreboardResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScoreboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreboardResponse) ProtoMessage() {}
+
+func (x *GetScoreboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreboardResponse.ProtoReflect.Descriptor instead.
+func (*GetScoreboardResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetScoreboardResponse) GetEntries() []*ScoreboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetScoreboardResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *GetScoreboardResponse) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+type GetScoreHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Top           int32                  `protobuf:"varint,1,opt,name=top,proto3" json:"top,omitempty"` // number of top competitors, defaults to 10 when 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScoreHistoryRequest) Reset() {
+	*x = GetScoreHistoryRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScoreHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreHistoryRequest) ProtoMessage() {}
+
+func (x *GetScoreHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetScoreHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetScoreHistoryRequest) GetTop() int32 {
+	if x != nil {
+		return x.Top
+	}
+	return 0
+}
+
+type GetScoreHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Histories     []*ScoreHistory        `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Frozen        bool                   `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScoreHistoryResponse) Reset() {
+	*x = GetScoreHistoryResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScoreHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreHistoryResponse) ProtoMessage() {}
+
+func (x *GetScoreHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetScoreHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetScoreHistoryResponse) GetHistories() []*ScoreHistory {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
+}
+
+func (x *GetScoreHistoryResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *GetScoreHistoryResponse) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
 type StartInstanceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
@@ -274,7 +482,7 @@ type StartInstanceRequest struct {
 
 func (x *StartInstanceRequest) Reset() {
 	*x = StartInstanceRequest{}
-	mi := &file_api_server_v1_client_proto_msgTypes[4]
+	mi := &file_api_server_v1_client_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +494,7 @@ func (x *StartInstanceRequest) String() string {
 func (*StartInstanceRequest) ProtoMessage() {}
 
 func (x *StartInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[4]
+	mi := &file_api_server_v1_client_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +507,7 @@ func (x *StartInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartInstanceRequest.ProtoReflect.Descriptor instead.
 func (*StartInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{4}
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *StartInstanceRequest) GetChallengeId() string {
@@ -320,7 +528,7 @@ type StartInstanceResponse struct {
 
 func (x *StartInstanceResponse) Reset() {
 	*x = StartInstanceResponse{}
-	mi := &file_api_server_v1_client_proto_msgTypes[5]
+	mi := &file_api_server_v1_client_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -332,7 +540,7 @@ func (x *StartInstanceResponse) String() string {
 func (*StartInstanceResponse) ProtoMessage() {}
 
 func (x *StartInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[5]
+	mi := &file_api_server_v1_client_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,7 +553,7 @@ func (x *StartInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartInstanceResponse.ProtoReflect.Descriptor instead.
 func (*StartInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{5}
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *StartInstanceResponse) GetHost() string {
@@ -378,7 +586,7 @@ type StopInstanceRequest struct {
 
 func (x *StopInstanceRequest) Reset() {
 	*x = StopInstanceRequest{}
-	mi := &file_api_server_v1_client_proto_msgTypes[6]
+	mi := &file_api_server_v1_client_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -390,7 +598,7 @@ func (x *StopInstanceRequest) String() string {
 func (*StopInstanceRequest) ProtoMessage() {}
 
 func (x *StopInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[6]
+	mi := &file_api_server_v1_client_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +611,7 @@ func (x *StopInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopInstanceRequest.ProtoReflect.Descriptor instead.
 func (*StopInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{6}
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StopInstanceRequest) GetChallengeId() string {
@@ -422,7 +630,7 @@ type StopInstanceResponse struct {
 
 func (x *StopInstanceResponse) Reset() {
 	*x = StopInstanceResponse{}
-	mi := &file_api_server_v1_client_proto_msgTypes[7]
+	mi := &file_api_server_v1_client_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -434,7 +642,7 @@ func (x *StopInstanceResponse) String() string {
 func (*StopInstanceResponse) ProtoMessage() {}
 
 func (x *StopInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[7]
+	mi := &file_api_server_v1_client_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +655,7 @@ func (x *StopInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopInstanceResponse.ProtoReflect.Descriptor instead.
 func (*StopInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{7}
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StopInstanceResponse) GetErrorMessage() string {
@@ -466,7 +674,7 @@ type GetInstanceStatusRequest struct {
 
 func (x *GetInstanceStatusRequest) Reset() {
 	*x = GetInstanceStatusRequest{}
-	mi := &file_api_server_v1_client_proto_msgTypes[8]
+	mi := &file_api_server_v1_client_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -478,7 +686,7 @@ func (x *GetInstanceStatusRequest) String() string {
 func (*GetInstanceStatusRequest) ProtoMessage() {}
 
 func (x *GetInstanceStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[8]
+	mi := &file_api_server_v1_client_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -491,7 +699,7 @@ func (x *GetInstanceStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInstanceStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetInstanceStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{8}
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetInstanceStatusRequest) GetChallengeId() string {
@@ -513,7 +721,7 @@ type GetInstanceStatusResponse struct {
 
 func (x *GetInstanceStatusResponse) Reset() {
 	*x = GetInstanceStatusResponse{}
-	mi := &file_api_server_v1_client_proto_msgTypes[9]
+	mi := &file_api_server_v1_client_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -525,7 +733,7 @@ func (x *GetInstanceStatusResponse) String() string {
 func (*GetInstanceStatusResponse) ProtoMessage() {}
 
 func (x *GetInstanceStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[9]
+	mi := &file_api_server_v1_client_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +746,7 @@ func (x *GetInstanceStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInstanceStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetInstanceStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{9}
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetInstanceStatusResponse) GetStatus() GetInstanceStatusResponse_Status {
@@ -569,29 +777,28 @@ func (x *GetInstanceStatusResponse) GetErrorMessage() string {
 	return ""
 }
 
-type LoginRequest struct {
+type GetHintsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
-	mi := &file_api_server_v1_client_proto_msgTypes[10]
+func (x *GetHintsRequest) Reset() {
+	*x = GetHintsRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginRequest) String() string {
+func (x *GetHintsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*GetHintsRequest) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[10]
+func (x *GetHintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,48 +809,41 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *LoginRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+// Deprecated: Use GetHintsRequest.ProtoReflect.Descriptor instead.
+func (*GetHintsRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *LoginRequest) GetPassword() string {
+func (x *GetHintsRequest) GetChallengeId() string {
 	if x != nil {
-		return x.Password
+		return x.ChallengeId
 	}
 	return ""
 }
 
-type LoginResponse struct {
+type GetHintsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Hints         []*Hint                `protobuf:"bytes,1,rep,name=hints,proto3" json:"hints,omitempty"`
 	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
-	mi := &file_api_server_v1_client_proto_msgTypes[11]
+func (x *GetHintsResponse) Reset() {
+	*x = GetHintsResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginResponse) String() string {
+func (x *GetHintsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*GetHintsResponse) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[11]
+func (x *GetHintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,48 +854,47 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetHintsResponse.ProtoReflect.Descriptor instead.
+func (*GetHintsResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LoginResponse) GetToken() string {
+func (x *GetHintsResponse) GetHints() []*Hint {
 	if x != nil {
-		return x.Token
+		return x.Hints
 	}
-	return ""
+	return nil
 }
 
-func (x *LoginResponse) GetErrorMessage() string {
+func (x *GetHintsResponse) GetErrorMessage() string {
 	if x != nil {
 		return x.ErrorMessage
 	}
 	return ""
 }
 
-type RegisterRequest struct {
+type UnlockHintRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	HintId        string                 `protobuf:"bytes,1,opt,name=hint_id,json=hintId,proto3" json:"hint_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterRequest) Reset() {
-	*x = RegisterRequest{}
-	mi := &file_api_server_v1_client_proto_msgTypes[12]
+func (x *UnlockHintRequest) Reset() {
+	*x = UnlockHintRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterRequest) String() string {
+func (x *UnlockHintRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterRequest) ProtoMessage() {}
+func (*UnlockHintRequest) ProtoMessage() {}
 
-func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[12]
+func (x *UnlockHintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,48 +905,921 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
-func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UnlockHintRequest.ProtoReflect.Descriptor instead.
+func (*UnlockHintRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnlockHintRequest) GetHintId() string {
+	if x != nil {
+		return x.HintId
+	}
+	return ""
+}
+
+type UnlockHintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hint          *Hint                  `protobuf:"bytes,1,opt,name=hint,proto3" json:"hint,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockHintResponse) Reset() {
+	*x = UnlockHintResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockHintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockHintResponse) ProtoMessage() {}
+
+func (x *UnlockHintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockHintResponse.ProtoReflect.Descriptor instead.
+func (*UnlockHintResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnlockHintResponse) GetHint() *Hint {
+	if x != nil {
+		return x.Hint
+	}
+	return nil
+}
+
+func (x *UnlockHintResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetAnnouncementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnnouncementsRequest) Reset() {
+	*x = GetAnnouncementsRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnouncementsRequest) ProtoMessage() {}
+
+func (x *GetAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{18}
+}
+
+type GetAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnnouncementsResponse) Reset() {
+	*x = GetAnnouncementsResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnouncementsResponse) ProtoMessage() {}
+
+func (x *GetAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *GetAnnouncementsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{20}
+}
+
+type StreamEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *LiveEvent             `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsResponse) Reset() {
+	*x = StreamEventsResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsResponse) ProtoMessage() {}
+
+func (x *StreamEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsResponse.ProtoReflect.Descriptor instead.
+func (*StreamEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StreamEventsResponse) GetEvent() *LiveEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamResponse) Reset() {
+	*x = CreateTeamResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamResponse) ProtoMessage() {}
+
+func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamResponse.ProtoReflect.Descriptor instead.
+func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *CreateTeamResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type JoinTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTeamRequest) Reset() {
+	*x = JoinTeamRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTeamRequest) ProtoMessage() {}
+
+func (x *JoinTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTeamRequest.ProtoReflect.Descriptor instead.
+func (*JoinTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *JoinTeamRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type JoinTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinTeamResponse) Reset() {
+	*x = JoinTeamResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTeamResponse) ProtoMessage() {}
+
+func (x *JoinTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTeamResponse.ProtoReflect.Descriptor instead.
+func (*JoinTeamResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *JoinTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *JoinTeamResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type LeaveTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveTeamRequest) Reset() {
+	*x = LeaveTeamRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveTeamRequest) ProtoMessage() {}
+
+func (x *LeaveTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveTeamRequest.ProtoReflect.Descriptor instead.
+func (*LeaveTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{26}
+}
+
+type LeaveTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveTeamResponse) Reset() {
+	*x = LeaveTeamResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveTeamResponse) ProtoMessage() {}
+
+func (x *LeaveTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveTeamResponse.ProtoReflect.Descriptor instead.
+func (*LeaveTeamResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LeaveTeamResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetMyTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyTeamRequest) Reset() {
+	*x = GetMyTeamRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyTeamRequest) ProtoMessage() {}
+
+func (x *GetMyTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetMyTeamRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{28}
+}
+
+type GetMyTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyTeamResponse) Reset() {
+	*x = GetMyTeamResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyTeamResponse) ProtoMessage() {}
+
+func (x *GetMyTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetMyTeamResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetMyTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *GetMyTeamResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // at least 8 characters and at most 72 bytes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *RegisterRequest) GetUsername() string {
 	if x != nil {
-		return x.Username
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RegisterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RegisterResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RegisterResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LogoutRequest) GetToken() string {
+	if x != nil {
+		return x.Token
 	}
 	return ""
 }
 
-func (x *RegisterRequest) GetPassword() string {
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[35]
 	if x != nil {
-		return x.Password
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LogoutResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-type RegisterResponse struct {
+type UserProfile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Affiliation   string                 `protobuf:"bytes,5,opt,name=affiliation,proto3" json:"affiliation,omitempty"`
+	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"` // ISO 3166-1 alpha-2 code such as JP
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterResponse) Reset() {
-	*x = RegisterResponse{}
-	mi := &file_api_server_v1_client_proto_msgTypes[13]
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_api_server_v1_client_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterResponse) String() string {
+func (x *UserProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterResponse) ProtoMessage() {}
+func (*UserProfile) ProtoMessage() {}
 
-func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[13]
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,47 +1830,167 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
-func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *RegisterResponse) GetUserId() string {
+func (x *UserProfile) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *RegisterResponse) GetErrorMessage() string {
+func (x *UserProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserProfile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserProfile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetAffiliation() string {
+	if x != nil {
+		return x.Affiliation
+	}
+	return ""
+}
+
+func (x *UserProfile) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{37}
+}
+
+type GetProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetProfileResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GetProfileResponse) GetErrorMessage() string {
 	if x != nil {
 		return x.ErrorMessage
 	}
 	return ""
 }
 
-type LogoutRequest struct {
+// UpdateProfileRequest replaces every profile field. Empty values clear the field
+type UpdateProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // at most 64 characters
+	Affiliation   string                 `protobuf:"bytes,3,opt,name=affiliation,proto3" json:"affiliation,omitempty"`                    // at most 128 characters
+	Country       string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutRequest) Reset() {
-	*x = LogoutRequest{}
-	mi := &file_api_server_v1_client_proto_msgTypes[14]
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutRequest) String() string {
+func (x *UpdateProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutRequest) ProtoMessage() {}
+func (*UpdateProfileRequest) ProtoMessage() {}
 
-func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[14]
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,40 +2001,166 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
-func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *LogoutRequest) GetToken() string {
+func (x *UpdateProfileRequest) GetEmail() string {
 	if x != nil {
-		return x.Token
+		return x.Email
 	}
 	return ""
 }
 
-type LogoutResponse struct {
+func (x *UpdateProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetAffiliation() string {
+	if x != nil {
+		return x.Affiliation
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UpdateProfileResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// ChangePasswordRequest signs out every other session of the user
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"` // at least 8 characters and at most 72 bytes
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_api_server_v1_client_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutResponse) Reset() {
-	*x = LogoutResponse{}
-	mi := &file_api_server_v1_client_proto_msgTypes[15]
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_api_server_v1_client_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutResponse) String() string {
+func (x *ChangePasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutResponse) ProtoMessage() {}
+func (*ChangePasswordResponse) ProtoMessage() {}
 
-func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_client_proto_msgTypes[15]
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_client_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,12 +2171,12 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
-func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_client_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_client_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *LogoutResponse) GetErrorMessage() string {
+func (x *ChangePasswordResponse) GetErrorMessage() string {
 	if x != nil {
 		return x.ErrorMessage
 	}
@@ -879,11 +2197,23 @@ const file_api_server_v1_client_proto_rawDesc = "" +
 	"\x11SubmitFlagRequest\x129\n" +
 	"\n" +
 	"submission\x18\x01 \x01(\v2\x19.api.server.v1.SubmissionR\n" +
-	"submission\"z\n" +
+	"submission\"\xaa\x01\n" +
 	"\x12SubmitFlagResponse\x12\x18\n" +
 	"\acorrect\x18\x01 \x01(\bR\acorrect\x12%\n" +
 	"\x0epoints_awarded\x18\x02 \x01(\x05R\rpointsAwarded\x12#\n" +
-	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"9\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12.\n" +
+	"\x13retry_after_seconds\x18\x04 \x01(\x05R\x11retryAfterSeconds\"\x16\n" +
+	"\x14GetScoreboardRequest\"\x8e\x01\n" +
+	"\x15GetScoreboardResponse\x128\n" +
+	"\aentries\x18\x01 \x03(\v2\x1e.api.server.v1.ScoreboardEntryR\aentries\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12\x16\n" +
+	"\x06frozen\x18\x03 \x01(\bR\x06frozen\"*\n" +
+	"\x16GetScoreHistoryRequest\x12\x10\n" +
+	"\x03top\x18\x01 \x01(\x05R\x03top\"\x91\x01\n" +
+	"\x17GetScoreHistoryResponse\x129\n" +
+	"\thistories\x18\x01 \x03(\v2\x1b.api.server.v1.ScoreHistoryR\thistories\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12\x16\n" +
+	"\x06frozen\x18\x03 \x01(\bR\x06frozen\"9\n" +
 	"\x14StartInstanceRequest\x12!\n" +
 	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\"d\n" +
 	"\x15StartInstanceResponse\x12\x12\n" +
@@ -905,7 +2235,42 @@ const file_api_server_v1_client_proto_rawDesc = "" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSTATUS_RUNNING\x10\x01\x12\x12\n" +
 	"\x0eSTATUS_STOPPED\x10\x02\x12\x14\n" +
-	"\x10STATUS_DESTROYED\x10\x03\"F\n" +
+	"\x10STATUS_DESTROYED\x10\x03\"4\n" +
+	"\x0fGetHintsRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\"b\n" +
+	"\x10GetHintsResponse\x12)\n" +
+	"\x05hints\x18\x01 \x03(\v2\x13.api.server.v1.HintR\x05hints\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\",\n" +
+	"\x11UnlockHintRequest\x12\x17\n" +
+	"\ahint_id\x18\x01 \x01(\tR\x06hintId\"b\n" +
+	"\x12UnlockHintResponse\x12'\n" +
+	"\x04hint\x18\x01 \x01(\v2\x13.api.server.v1.HintR\x04hint\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\x19\n" +
+	"\x17GetAnnouncementsRequest\"\x82\x01\n" +
+	"\x18GetAnnouncementsResponse\x12A\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x1b.api.server.v1.AnnouncementR\rannouncements\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\x15\n" +
+	"\x13StreamEventsRequest\"F\n" +
+	"\x14StreamEventsResponse\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.api.server.v1.LiveEventR\x05event\"'\n" +
+	"\x11CreateTeamRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"b\n" +
+	"\x12CreateTeamResponse\x12'\n" +
+	"\x04team\x18\x01 \x01(\v2\x13.api.server.v1.TeamR\x04team\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"2\n" +
+	"\x0fJoinTeamRequest\x12\x1f\n" +
+	"\vinvite_code\x18\x01 \x01(\tR\n" +
+	"inviteCode\"`\n" +
+	"\x10JoinTeamResponse\x12'\n" +
+	"\x04team\x18\x01 \x01(\v2\x13.api.server.v1.TeamR\x04team\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\x12\n" +
+	"\x10LeaveTeamRequest\"8\n" +
+	"\x11LeaveTeamResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"\x12\n" +
+	"\x10GetMyTeamRequest\"a\n" +
+	"\x11GetMyTeamResponse\x12'\n" +
+	"\x04team\x18\x01 \x01(\v2\x13.api.server.v1.TeamR\x04team\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"F\n" +
 	"\fLoginRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"J\n" +
@@ -921,18 +2286,59 @@ const file_api_server_v1_client_proto_rawDesc = "" +
 	"\rLogoutRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"5\n" +
 	"\x0eLogoutResponse\x12#\n" +
-	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage2\xe4\x03\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"\xb7\x01\n" +
+	"\vUserProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12!\n" +
+	"\fdisplay_name\x18\x04 \x01(\tR\vdisplayName\x12 \n" +
+	"\vaffiliation\x18\x05 \x01(\tR\vaffiliation\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\"\x13\n" +
+	"\x11GetProfileRequest\"o\n" +
+	"\x12GetProfileResponse\x124\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1a.api.server.v1.UserProfileR\aprofile\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\x8b\x01\n" +
+	"\x14UpdateProfileRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
+	"\vaffiliation\x18\x03 \x01(\tR\vaffiliation\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\"r\n" +
+	"\x15UpdateProfileResponse\x124\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1a.api.server.v1.UserProfileR\aprofile\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"e\n" +
+	"\x15ChangePasswordRequest\x12)\n" +
+	"\x10current_password\x18\x01 \x01(\tR\x0fcurrentPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"=\n" +
+	"\x16ChangePasswordResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage2\x82\b\n" +
 	"\x16ClientChallengeService\x12Z\n" +
 	"\rGetChallenges\x12#.api.server.v1.GetChallengesRequest\x1a$.api.server.v1.GetChallengesResponse\x12Q\n" +
 	"\n" +
 	"SubmitFlag\x12 .api.server.v1.SubmitFlagRequest\x1a!.api.server.v1.SubmitFlagResponse\x12Z\n" +
+	"\rGetScoreboard\x12#.api.server.v1.GetScoreboardRequest\x1a$.api.server.v1.GetScoreboardResponse\x12`\n" +
+	"\x0fGetScoreHistory\x12%.api.server.v1.GetScoreHistoryRequest\x1a&.api.server.v1.GetScoreHistoryResponse\x12K\n" +
+	"\bGetHints\x12\x1e.api.server.v1.GetHintsRequest\x1a\x1f.api.server.v1.GetHintsResponse\x12Q\n" +
+	"\n" +
+	"UnlockHint\x12 .api.server.v1.UnlockHintRequest\x1a!.api.server.v1.UnlockHintResponse\x12c\n" +
+	"\x10GetAnnouncements\x12&.api.server.v1.GetAnnouncementsRequest\x1a'.api.server.v1.GetAnnouncementsResponse\x12Y\n" +
+	"\fStreamEvents\x12\".api.server.v1.StreamEventsRequest\x1a#.api.server.v1.StreamEventsResponse0\x01\x12Z\n" +
 	"\rStartInstance\x12#.api.server.v1.StartInstanceRequest\x1a$.api.server.v1.StartInstanceResponse\x12W\n" +
 	"\fStopInstance\x12\".api.server.v1.StopInstanceRequest\x1a#.api.server.v1.StopInstanceResponse\x12f\n" +
-	"\x11GetInstanceStatus\x12'.api.server.v1.GetInstanceStatusRequest\x1a(.api.server.v1.GetInstanceStatusResponse2\xe9\x01\n" +
+	"\x11GetInstanceStatus\x12'.api.server.v1.GetInstanceStatusRequest\x1a(.api.server.v1.GetInstanceStatusResponse2\xcd\x02\n" +
+	"\vTeamService\x12Q\n" +
+	"\n" +
+	"CreateTeam\x12 .api.server.v1.CreateTeamRequest\x1a!.api.server.v1.CreateTeamResponse\x12K\n" +
+	"\bJoinTeam\x12\x1e.api.server.v1.JoinTeamRequest\x1a\x1f.api.server.v1.JoinTeamResponse\x12N\n" +
+	"\tLeaveTeam\x12\x1f.api.server.v1.LeaveTeamRequest\x1a .api.server.v1.LeaveTeamResponse\x12N\n" +
+	"\tGetMyTeam\x12\x1f.api.server.v1.GetMyTeamRequest\x1a .api.server.v1.GetMyTeamResponse2\xf7\x03\n" +
 	"\x0fUserAuthService\x12B\n" +
 	"\x05Login\x12\x1b.api.server.v1.LoginRequest\x1a\x1c.api.server.v1.LoginResponse\x12K\n" +
 	"\bRegister\x12\x1e.api.server.v1.RegisterRequest\x1a\x1f.api.server.v1.RegisterResponse\x12E\n" +
-	"\x06Logout\x12\x1c.api.server.v1.LogoutRequest\x1a\x1d.api.server.v1.LogoutResponseB\xb2\x01\n" +
+	"\x06Logout\x12\x1c.api.server.v1.LogoutRequest\x1a\x1d.api.server.v1.LogoutResponse\x12Q\n" +
+	"\n" +
+	"GetProfile\x12 .api.server.v1.GetProfileRequest\x1a!.api.server.v1.GetProfileResponse\x12Z\n" +
+	"\rUpdateProfile\x12#.api.server.v1.UpdateProfileRequest\x1a$.api.server.v1.UpdateProfileResponse\x12]\n" +
+	"\x0eChangePassword\x12$.api.server.v1.ChangePasswordRequest\x1a%.api.server.v1.ChangePasswordResponseB\xb2\x01\n" +
 	"\x11com.api.server.v1B\vClientProtoP\x01Z:github.com/kavos113/quickctf/gen/go/api/server/v1;serverv1\xa2\x02\x03ASX\xaa\x02\rApi.Server.V1\xca\x02\rApi\\Server\\V1\xe2\x02\x19Api\\Server\\V1\\GPBMetadata\xea\x02\x0fApi::Server::V1b\x06proto3"
 
 var (
@@ -948,53 +2354,123 @@ func file_api_server_v1_client_proto_rawDescGZIP() []byte {
 }
 
 var file_api_server_v1_client_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_server_v1_client_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_api_server_v1_client_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_api_server_v1_client_proto_goTypes = []any{
 	(GetInstanceStatusResponse_Status)(0), // 0: api.server.v1.GetInstanceStatusResponse.Status
 	(*GetChallengesRequest)(nil),          // 1: api.server.v1.GetChallengesRequest
 	(*GetChallengesResponse)(nil),         // 2: api.server.v1.GetChallengesResponse
 	(*SubmitFlagRequest)(nil),             // 3: api.server.v1.SubmitFlagRequest
 	(*SubmitFlagResponse)(nil),            // 4: api.server.v1.SubmitFlagResponse
-	(*StartInstanceRequest)(nil),          // 5: api.server.v1.StartInstanceRequest
-	(*StartInstanceResponse)(nil),         // 6: api.server.v1.StartInstanceResponse
-	(*StopInstanceRequest)(nil),           // 7: api.server.v1.StopInstanceRequest
-	(*StopInstanceResponse)(nil),          // 8: api.server.v1.StopInstanceResponse
-	(*GetInstanceStatusRequest)(nil),      // 9: api.server.v1.GetInstanceStatusRequest
-	(*GetInstanceStatusResponse)(nil),     // 10: api.server.v1.GetInstanceStatusResponse
-	(*LoginRequest)(nil),                  // 11: api.server.v1.LoginRequest
-	(*LoginResponse)(nil),                 // 12: api.server.v1.LoginResponse
-	(*RegisterRequest)(nil),               // 13: api.server.v1.RegisterRequest
-	(*RegisterResponse)(nil),              // 14: api.server.v1.RegisterResponse
-	(*LogoutRequest)(nil),                 // 15: api.server.v1.LogoutRequest
-	(*LogoutResponse)(nil),                // 16: api.server.v1.LogoutResponse
-	(*Challenge)(nil),                     // 17: api.server.v1.Challenge
-	(*Submission)(nil),                    // 18: api.server.v1.Submission
+	(*GetScoreboardRequest)(nil),          // 5: api.server.v1.GetScoreboardRequest
+	(*GetScoreboardResponse)(nil),         // 6: api.server.v1.GetScoreboardResponse
+	(*GetScoreHistoryRequest)(nil),        // 7: api.server.v1.GetScoreHistoryRequest
+	(*GetScoreHistoryResponse)(nil),       // 8: api.server.v1.GetScoreHistoryResponse
+	(*StartInstanceRequest)(nil),          // 9: api.server.v1.StartInstanceRequest
+	(*StartInstanceResponse)(nil),         // 10: api.server.v1.StartInstanceResponse
+	(*StopInstanceRequest)(nil),           // 11: api.server.v1.StopInstanceRequest
+	(*StopInstanceResponse)(nil),          // 12: api.server.v1.StopInstanceResponse
+	(*GetInstanceStatusRequest)(nil),      // 13: api.server.v1.GetInstanceStatusRequest
+	(*GetInstanceStatusResponse)(nil),     // 14: api.server.v1.GetInstanceStatusResponse
+	(*GetHintsRequest)(nil),               // 15: api.server.v1.GetHintsRequest
+	(*GetHintsResponse)(nil),              // 16: api.server.v1.GetHintsResponse
+	(*UnlockHintRequest)(nil),             // 17: api.server.v1.UnlockHintRequest
+	(*UnlockHintResponse)(nil),            // 18: api.server.v1.UnlockHintResponse
+	(*GetAnnouncementsRequest)(nil),       // 19: api.server.v1.GetAnnouncementsRequest
+	(*GetAnnouncementsResponse)(nil),      // 20: api.server.v1.GetAnnouncementsResponse
+	(*StreamEventsRequest)(nil),           // 21: api.server.v1.StreamEventsRequest
+	(*StreamEventsResponse)(nil),          // 22: api.server.v1.StreamEventsResponse
+	(*CreateTeamRequest)(nil),             // 23: api.server.v1.CreateTeamRequest
+	(*CreateTeamResponse)(nil),            // 24: api.server.v1.CreateTeamResponse
+	(*JoinTeamRequest)(nil),               // 25: api.server.v1.JoinTeamRequest
+	(*JoinTeamResponse)(nil),              // 26: api.server.v1.JoinTeamResponse
+	(*LeaveTeamRequest)(nil),              // 27: api.server.v1.LeaveTeamRequest
+	(*LeaveTeamResponse)(nil),             // 28: api.server.v1.LeaveTeamResponse
+	(*GetMyTeamRequest)(nil),              // 29: api.server.v1.GetMyTeamRequest
+	(*GetMyTeamResponse)(nil),             // 30: api.server.v1.GetMyTeamResponse
+	(*LoginRequest)(nil),                  // 31: api.server.v1.LoginRequest
+	(*LoginResponse)(nil),                 // 32: api.server.v1.LoginResponse
+	(*RegisterRequest)(nil),               // 33: api.server.v1.RegisterRequest
+	(*RegisterResponse)(nil),              // 34: api.server.v1.RegisterResponse
+	(*LogoutRequest)(nil),                 // 35: api.server.v1.LogoutRequest
+	(*LogoutResponse)(nil),                // 36: api.server.v1.LogoutResponse
+	(*UserProfile)(nil),                   // 37: api.server.v1.UserProfile
+	(*GetProfileRequest)(nil),             // 38: api.server.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),            // 39: api.server.v1.GetProfileResponse
+	(*UpdateProfileRequest)(nil),          // 40: api.server.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),         // 41: api.server.v1.UpdateProfileResponse
+	(*ChangePasswordRequest)(nil),         // 42: api.server.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),        // 43: api.server.v1.ChangePasswordResponse
+	(*Challenge)(nil),                     // 44: api.server.v1.Challenge
+	(*Submission)(nil),                    // 45: api.server.v1.Submission
+	(*ScoreboardEntry)(nil),               // 46: api.server.v1.ScoreboardEntry
+	(*ScoreHistory)(nil),                  // 47: api.server.v1.ScoreHistory
+	(*Hint)(nil),                          // 48: api.server.v1.Hint
+	(*Announcement)(nil),                  // 49: api.server.v1.Announcement
+	(*LiveEvent)(nil),                     // 50: api.server.v1.LiveEvent
+	(*Team)(nil),                          // 51: api.server.v1.Team
 }
 var file_api_server_v1_client_proto_depIdxs = []int32{
-	17, // 0: api.server.v1.GetChallengesResponse.challenges:type_name -> api.server.v1.Challenge
-	18, // 1: api.server.v1.SubmitFlagRequest.submission:type_name -> api.server.v1.Submission
-	0,  // 2: api.server.v1.GetInstanceStatusResponse.status:type_name -> api.server.v1.GetInstanceStatusResponse.Status
-	1,  // 3: api.server.v1.ClientChallengeService.GetChallenges:input_type -> api.server.v1.GetChallengesRequest
-	3,  // 4: api.server.v1.ClientChallengeService.SubmitFlag:input_type -> api.server.v1.SubmitFlagRequest
-	5,  // 5: api.server.v1.ClientChallengeService.StartInstance:input_type -> api.server.v1.StartInstanceRequest
-	7,  // 6: api.server.v1.ClientChallengeService.StopInstance:input_type -> api.server.v1.StopInstanceRequest
-	9,  // 7: api.server.v1.ClientChallengeService.GetInstanceStatus:input_type -> api.server.v1.GetInstanceStatusRequest
-	11, // 8: api.server.v1.UserAuthService.Login:input_type -> api.server.v1.LoginRequest
-	13, // 9: api.server.v1.UserAuthService.Register:input_type -> api.server.v1.RegisterRequest
-	15, // 10: api.server.v1.UserAuthService.Logout:input_type -> api.server.v1.LogoutRequest
-	2,  // 11: api.server.v1.ClientChallengeService.GetChallenges:output_type -> api.server.v1.GetChallengesResponse
-	4,  // 12: api.server.v1.ClientChallengeService.SubmitFlag:output_type -> api.server.v1.SubmitFlagResponse
-	6,  // 13: api.server.v1.ClientChallengeService.StartInstance:output_type -> api.server.v1.StartInstanceResponse
-	8,  // 14: api.server.v1.ClientChallengeService.StopInstance:output_type -> api.server.v1.StopInstanceResponse
-	10, // 15: api.server.v1.ClientChallengeService.GetInstanceStatus:output_type -> api.server.v1.GetInstanceStatusResponse
-	12, // 16: api.server.v1.UserAuthService.Login:output_type -> api.server.v1.LoginResponse
-	14, // 17: api.server.v1.UserAuthService.Register:output_type -> api.server.v1.RegisterResponse
-	16, // 18: api.server.v1.UserAuthService.Logout:output_type -> api.server.v1.LogoutResponse
-	11, // [11:19] is the sub-list for method output_type
-	3,  // [3:11] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	44, // 0: api.server.v1.GetChallengesResponse.challenges:type_name -> api.server.v1.Challenge
+	45, // 1: api.server.v1.SubmitFlagRequest.submission:type_name -> api.server.v1.Submission
+	46, // 2: api.server.v1.GetScoreboardResponse.entries:type_name -> api.server.v1.ScoreboardEntry
+	47, // 3: api.server.v1.GetScoreHistoryResponse.histories:type_name -> api.server.v1.ScoreHistory
+	0,  // 4: api.server.v1.GetInstanceStatusResponse.status:type_name -> api.server.v1.GetInstanceStatusResponse.Status
+	48, // 5: api.server.v1.GetHintsResponse.hints:type_name -> api.server.v1.Hint
+	48, // 6: api.server.v1.UnlockHintResponse.hint:type_name -> api.server.v1.Hint
+	49, // 7: api.server.v1.GetAnnouncementsResponse.announcements:type_name -> api.server.v1.Announcement
+	50, // 8: api.server.v1.StreamEventsResponse.event:type_name -> api.server.v1.LiveEvent
+	51, // 9: api.server.v1.CreateTeamResponse.team:type_name -> api.server.v1.Team
+	51, // 10: api.server.v1.JoinTeamResponse.team:type_name -> api.server.v1.Team
+	51, // 11: api.server.v1.GetMyTeamResponse.team:type_name -> api.server.v1.Team
+	37, // 12: api.server.v1.GetProfileResponse.profile:type_name -> api.server.v1.UserProfile
+	37, // 13: api.server.v1.UpdateProfileResponse.profile:type_name -> api.server.v1.UserProfile
+	1,  // 14: api.server.v1.ClientChallengeService.GetChallenges:input_type -> api.server.v1.GetChallengesRequest
+	3,  // 15: api.server.v1.ClientChallengeService.SubmitFlag:input_type -> api.server.v1.SubmitFlagRequest
+	5,  // 16: api.server.v1.ClientChallengeService.GetScoreboard:input_type -> api.server.v1.GetScoreboardRequest
+	7,  // 17: api.server.v1.ClientChallengeService.GetScoreHistory:input_type -> api.server.v1.GetScoreHistoryRequest
+	15, // 18: api.server.v1.ClientChallengeService.GetHints:input_type -> api.server.v1.GetHintsRequest
+	17, // 19: api.server.v1.ClientChallengeService.UnlockHint:input_type -> api.server.v1.UnlockHintRequest
+	19, // 20: api.server.v1.ClientChallengeService.GetAnnouncements:input_type -> api.server.v1.GetAnnouncementsRequest
+	21, // 21: api.server.v1.ClientChallengeService.StreamEvents:input_type -> api.server.v1.StreamEventsRequest
+	9,  // 22: api.server.v1.ClientChallengeService.StartInstance:input_type -> api.server.v1.StartInstanceRequest
+	11, // 23: api.server.v1.ClientChallengeService.StopInstance:input_type -> api.server.v1.StopInstanceRequest
+	13, // 24: api.server.v1.ClientChallengeService.GetInstanceStatus:input_type -> api.server.v1.GetInstanceStatusRequest
+	23, // 25: api.server.v1.TeamService.CreateTeam:input_type -> api.server.v1.CreateTeamRequest
+	25, // 26: api.server.v1.TeamService.JoinTeam:input_type -> api.server.v1.JoinTeamRequest
+	27, // 27: api.server.v1.TeamService.LeaveTeam:input_type -> api.server.v1.LeaveTeamRequest
+	29, // 28: api.server.v1.TeamService.GetMyTeam:input_type -> api.server.v1.GetMyTeamRequest
+	31, // 29: api.server.v1.UserAuthService.Login:input_type -> api.server.v1.LoginRequest
+	33, // 30: api.server.v1.UserAuthService.Register:input_type -> api.server.v1.RegisterRequest
+	35, // 31: api.server.v1.UserAuthService.Logout:input_type -> api.server.v1.LogoutRequest
+	38, // 32: api.server.v1.UserAuthService.GetProfile:input_type -> api.server.v1.GetProfileRequest
+	40, // 33: api.server.v1.UserAuthService.UpdateProfile:input_type -> api.server.v1.UpdateProfileRequest
+	42, // 34: api.server.v1.UserAuthService.ChangePassword:input_type -> api.server.v1.ChangePasswordRequest
+	2,  // 35: api.server.v1.ClientChallengeService.GetChallenges:output_type -> api.server.v1.GetChallengesResponse
+	4,  // 36: api.server.v1.ClientChallengeService.SubmitFlag:output_type -> api.server.v1.SubmitFlagResponse
+	6,  // 37: api.server.v1.ClientChallengeService.GetScoreboard:output_type -> api.server.v1.GetScoreboardResponse
+	8,  // 38: api.server.v1.ClientChallengeService.GetScoreHistory:output_type -> api.server.v1.GetScoreHistoryResponse
+	16, // 39: api.server.v1.ClientChallengeService.GetHints:output_type -> api.server.v1.GetHintsResponse
+	18, // 40: api.server.v1.ClientChallengeService.UnlockHint:output_type -> api.server.v1.UnlockHintResponse
+	20, // 41: api.server.v1.ClientChallengeService.GetAnnouncements:output_type -> api.server.v1.GetAnnouncementsResponse
+	22, // 42: api.server.v1.ClientChallengeService.StreamEvents:output_type -> api.server.v1.StreamEventsResponse
+	10, // 43: api.server.v1.ClientChallengeService.StartInstance:output_type -> api.server.v1.StartInstanceResponse
+	12, // 44: api.server.v1.ClientChallengeService.StopInstance:output_type -> api.server.v1.StopInstanceResponse
+	14, // 45: api.server.v1.ClientChallengeService.GetInstanceStatus:output_type -> api.server.v1.GetInstanceStatusResponse
+	24, // 46: api.server.v1.TeamService.CreateTeam:output_type -> api.server.v1.CreateTeamResponse
+	26, // 47: api.server.v1.TeamService.JoinTeam:output_type -> api.server.v1.JoinTeamResponse
+	28, // 48: api.server.v1.TeamService.LeaveTeam:output_type -> api.server.v1.LeaveTeamResponse
+	30, // 49: api.server.v1.TeamService.GetMyTeam:output_type -> api.server.v1.GetMyTeamResponse
+	32, // 50: api.server.v1.UserAuthService.Login:output_type -> api.server.v1.LoginResponse
+	34, // 51: api.server.v1.UserAuthService.Register:output_type -> api.server.v1.RegisterResponse
+	36, // 52: api.server.v1.UserAuthService.Logout:output_type -> api.server.v1.LogoutResponse
+	39, // 53: api.server.v1.UserAuthService.GetProfile:output_type -> api.server.v1.GetProfileResponse
+	41, // 54: api.server.v1.UserAuthService.UpdateProfile:output_type -> api.server.v1.UpdateProfileResponse
+	43, // 55: api.server.v1.UserAuthService.ChangePassword:output_type -> api.server.v1.ChangePasswordResponse
+	35, // [35:56] is the sub-list for method output_type
+	14, // [14:35] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_api_server_v1_client_proto_init() }
@@ -1009,9 +2485,9 @@ func file_api_server_v1_client_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_server_v1_client_proto_rawDesc), len(file_api_server_v1_client_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   16,
+			NumMessages:   43,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_api_server_v1_client_proto_goTypes,
 		DependencyIndexes: file_api_server_v1_client_proto_depIdxs,
@@ -21,6 +21,266 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ScoringType int32
+
+const (
+	ScoringType_SCORING_TYPE_UNSPECIFIED ScoringType = 0
+	ScoringType_SCORING_TYPE_STATIC      ScoringType = 1
+	ScoringType_SCORING_TYPE_DYNAMIC     ScoringType = 2
+)
+
+// Enum value maps for ScoringType.
+var (
+	ScoringType_name = map[int32]string{
+		0: "SCORING_TYPE_UNSPECIFIED",
+		1: "SCORING_TYPE_STATIC",
+		2: "SCORING_TYPE_DYNAMIC",
+	}
+	ScoringType_value = map[string]int32{
+		"SCORING_TYPE_UNSPECIFIED": 0,
+		"SCORING_TYPE_STATIC":      1,
+		"SCORING_TYPE_DYNAMIC":     2,
+	}
+)
+
+func (x ScoringType) Enum() *ScoringType {
+	p := new(ScoringType)
+	*p = x
+	return p
+}
+
+func (x ScoringType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScoringType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_v1_model_proto_enumTypes[0].Descriptor()
+}
+
+func (ScoringType) Type() protoreflect.EnumType {
+	return &file_api_server_v1_model_proto_enumTypes[0]
+}
+
+func (x ScoringType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScoringType.Descriptor instead.
+func (ScoringType) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{0}
+}
+
+type FlagMatchMode int32
+
+const (
+	FlagMatchMode_FLAG_MATCH_MODE_UNSPECIFIED      FlagMatchMode = 0
+	FlagMatchMode_FLAG_MATCH_MODE_EXACT            FlagMatchMode = 1
+	FlagMatchMode_FLAG_MATCH_MODE_CASE_INSENSITIVE FlagMatchMode = 2
+	FlagMatchMode_FLAG_MATCH_MODE_REGEX            FlagMatchMode = 3
+)
+
+// Enum value maps for FlagMatchMode.
+var (
+	FlagMatchMode_name = map[int32]string{
+		0: "FLAG_MATCH_MODE_UNSPECIFIED",
+		1: "FLAG_MATCH_MODE_EXACT",
+		2: "FLAG_MATCH_MODE_CASE_INSENSITIVE",
+		3: "FLAG_MATCH_MODE_REGEX",
+	}
+	FlagMatchMode_value = map[string]int32{
+		"FLAG_MATCH_MODE_UNSPECIFIED":      0,
+		"FLAG_MATCH_MODE_EXACT":            1,
+		"FLAG_MATCH_MODE_CASE_INSENSITIVE": 2,
+		"FLAG_MATCH_MODE_REGEX":            3,
+	}
+)
+
+func (x FlagMatchMode) Enum() *FlagMatchMode {
+	p := new(FlagMatchMode)
+	*p = x
+	return p
+}
+
+func (x FlagMatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlagMatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_v1_model_proto_enumTypes[1].Descriptor()
+}
+
+func (FlagMatchMode) Type() protoreflect.EnumType {
+	return &file_api_server_v1_model_proto_enumTypes[1]
+}
+
+func (x FlagMatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlagMatchMode.Descriptor instead.
+func (FlagMatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{1}
+}
+
+type PrerequisiteMode int32
+
+const (
+	PrerequisiteMode_PREREQUISITE_MODE_UNSPECIFIED PrerequisiteMode = 0
+	PrerequisiteMode_PREREQUISITE_MODE_ALL         PrerequisiteMode = 1
+	PrerequisiteMode_PREREQUISITE_MODE_ANY         PrerequisiteMode = 2
+)
+
+// Enum value maps for PrerequisiteMode.
+var (
+	PrerequisiteMode_name = map[int32]string{
+		0: "PREREQUISITE_MODE_UNSPECIFIED",
+		1: "PREREQUISITE_MODE_ALL",
+		2: "PREREQUISITE_MODE_ANY",
+	}
+	PrerequisiteMode_value = map[string]int32{
+		"PREREQUISITE_MODE_UNSPECIFIED": 0,
+		"PREREQUISITE_MODE_ALL":         1,
+		"PREREQUISITE_MODE_ANY":         2,
+	}
+)
+
+func (x PrerequisiteMode) Enum() *PrerequisiteMode {
+	p := new(PrerequisiteMode)
+	*p = x
+	return p
+}
+
+func (x PrerequisiteMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrerequisiteMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_v1_model_proto_enumTypes[2].Descriptor()
+}
+
+func (PrerequisiteMode) Type() protoreflect.EnumType {
+	return &file_api_server_v1_model_proto_enumTypes[2]
+}
+
+func (x PrerequisiteMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrerequisiteMode.Descriptor instead.
+func (PrerequisiteMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{2}
+}
+
+type ChallengeVisibility int32
+
+const (
+	ChallengeVisibility_CHALLENGE_VISIBILITY_UNSPECIFIED ChallengeVisibility = 0
+	ChallengeVisibility_CHALLENGE_VISIBILITY_DRAFT       ChallengeVisibility = 1
+	ChallengeVisibility_CHALLENGE_VISIBILITY_HIDDEN      ChallengeVisibility = 2
+	ChallengeVisibility_CHALLENGE_VISIBILITY_VISIBLE     ChallengeVisibility = 3
+	ChallengeVisibility_CHALLENGE_VISIBILITY_SCHEDULED   ChallengeVisibility = 4 // becomes visible at release_at
+)
+
+// Enum value maps for ChallengeVisibility.
+var (
+	ChallengeVisibility_name = map[int32]string{
+		0: "CHALLENGE_VISIBILITY_UNSPECIFIED",
+		1: "CHALLENGE_VISIBILITY_DRAFT",
+		2: "CHALLENGE_VISIBILITY_HIDDEN",
+		3: "CHALLENGE_VISIBILITY_VISIBLE",
+		4: "CHALLENGE_VISIBILITY_SCHEDULED",
+	}
+	ChallengeVisibility_value = map[string]int32{
+		"CHALLENGE_VISIBILITY_UNSPECIFIED": 0,
+		"CHALLENGE_VISIBILITY_DRAFT":       1,
+		"CHALLENGE_VISIBILITY_HIDDEN":      2,
+		"CHALLENGE_VISIBILITY_VISIBLE":     3,
+		"CHALLENGE_VISIBILITY_SCHEDULED":   4,
+	}
+)
+
+func (x ChallengeVisibility) Enum() *ChallengeVisibility {
+	p := new(ChallengeVisibility)
+	*p = x
+	return p
+}
+
+func (x ChallengeVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChallengeVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_v1_model_proto_enumTypes[3].Descriptor()
+}
+
+func (ChallengeVisibility) Type() protoreflect.EnumType {
+	return &file_api_server_v1_model_proto_enumTypes[3]
+}
+
+func (x ChallengeVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChallengeVisibility.Descriptor instead.
+func (ChallengeVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{3}
+}
+
+type LiveEventType int32
+
+const (
+	LiveEventType_LIVE_EVENT_TYPE_UNSPECIFIED   LiveEventType = 0
+	LiveEventType_LIVE_EVENT_TYPE_SOLVE         LiveEventType = 1
+	LiveEventType_LIVE_EVENT_TYPE_FIRST_BLOOD   LiveEventType = 2
+	LiveEventType_LIVE_EVENT_TYPE_NEW_CHALLENGE LiveEventType = 3
+	LiveEventType_LIVE_EVENT_TYPE_ANNOUNCEMENT  LiveEventType = 4
+)
+
+// Enum value maps for LiveEventType.
+var (
+	LiveEventType_name = map[int32]string{
+		0: "LIVE_EVENT_TYPE_UNSPECIFIED",
+		1: "LIVE_EVENT_TYPE_SOLVE",
+		2: "LIVE_EVENT_TYPE_FIRST_BLOOD",
+		3: "LIVE_EVENT_TYPE_NEW_CHALLENGE",
+		4: "LIVE_EVENT_TYPE_ANNOUNCEMENT",
+	}
+	LiveEventType_value = map[string]int32{
+		"LIVE_EVENT_TYPE_UNSPECIFIED":   0,
+		"LIVE_EVENT_TYPE_SOLVE":         1,
+		"LIVE_EVENT_TYPE_FIRST_BLOOD":   2,
+		"LIVE_EVENT_TYPE_NEW_CHALLENGE": 3,
+		"LIVE_EVENT_TYPE_ANNOUNCEMENT":  4,
+	}
+)
+
+func (x LiveEventType) Enum() *LiveEventType {
+	p := new(LiveEventType)
+	*p = x
+	return p
+}
+
+func (x LiveEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LiveEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_v1_model_proto_enumTypes[4].Descriptor()
+}
+
+func (LiveEventType) Type() protoreflect.EnumType {
+	return &file_api_server_v1_model_proto_enumTypes[4]
+}
+
+func (x LiveEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LiveEventType.Descriptor instead.
+func (LiveEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{4}
+}
+
 type Challenge struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	ChallengeId      string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
@@ -31,25 +291,950 @@ type Challenge struct {
 	Genre            string                 `protobuf:"bytes,6,opt,name=genre,proto3" json:"genre,omitempty"`
 	Attachments      []*Attachment          `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	RequiresInstance bool                   `protobuf:"varint,8,opt,name=requires_instance,json=requiresInstance,proto3" json:"requires_instance,omitempty"`
+	ScoringType      ScoringType            `protobuf:"varint,9,opt,name=scoring_type,json=scoringType,proto3,enum=api.server.v1.ScoringType" json:"scoring_type,omitempty"`
+	InitialPoints    int32                  `protobuf:"varint,10,opt,name=initial_points,json=initialPoints,proto3" json:"initial_points,omitempty"`
+	MinimumPoints    int32                  `protobuf:"varint,11,opt,name=minimum_points,json=minimumPoints,proto3" json:"minimum_points,omitempty"`
+	Decay            int32                  `protobuf:"varint,12,opt,name=decay,proto3" json:"decay,omitempty"`
+	DynamicFlag      bool                   `protobuf:"varint,13,opt,name=dynamic_flag,json=dynamicFlag,proto3" json:"dynamic_flag,omitempty"`
+	FlagMatchMode    FlagMatchMode          `protobuf:"varint,14,opt,name=flag_match_mode,json=flagMatchMode,proto3,enum=api.server.v1.FlagMatchMode" json:"flag_match_mode,omitempty"`
+	AcceptedFlags    []string               `protobuf:"bytes,15,rep,name=accepted_flags,json=acceptedFlags,proto3" json:"accepted_flags,omitempty"` // accepted in addition to flag
+	PrerequisiteIds  []string               `protobuf:"bytes,16,rep,name=prerequisite_ids,json=prerequisiteIds,proto3" json:"prerequisite_ids,omitempty"`
+	PrerequisiteMode PrerequisiteMode       `protobuf:"varint,17,opt,name=prerequisite_mode,json=prerequisiteMode,proto3,enum=api.server.v1.PrerequisiteMode" json:"prerequisite_mode,omitempty"`
+	Locked           bool                   `protobuf:"varint,18,opt,name=locked,proto3" json:"locked,omitempty"` // true until the prerequisites are solved
+	Visibility       ChallengeVisibility    `protobuf:"varint,19,opt,name=visibility,proto3,enum=api.server.v1.ChallengeVisibility" json:"visibility,omitempty"`
+	ReleaseAt        int64                  `protobuf:"varint,20,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"` // unix seconds, 0 means not set
+	Parts            []*FlagPart            `protobuf:"bytes,21,rep,name=parts,proto3" json:"parts,omitempty"`
+	SolveCount       int32                  `protobuf:"varint,22,opt,name=solve_count,json=solveCount,proto3" json:"solve_count,omitempty"`
+	SolvedByMe       bool                   `protobuf:"varint,23,opt,name=solved_by_me,json=solvedByMe,proto3" json:"solved_by_me,omitempty"`
+	FirstBlood       *FirstBlood            `protobuf:"bytes,24,opt,name=first_blood,json=firstBlood,proto3" json:"first_blood,omitempty"`               // unset when nobody has solved it
+	BloodBonuses     []int32                `protobuf:"varint,25,rep,packed,name=blood_bonuses,json=bloodBonuses,proto3" json:"blood_bonuses,omitempty"` // bonus for the 1st, 2nd and 3rd solver
+	AuthorId         string                 `protobuf:"bytes,26,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`                     // staff who created the challenge, admin only
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Challenge) Reset() {
-	*x = Challenge{}
-	mi := &file_api_server_v1_model_proto_msgTypes[0]
+func (x *Challenge) Reset() {
+	*x = Challenge{}
+	mi := &file_api_server_v1_model_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Challenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Challenge) ProtoMessage() {}
+
+func (x *Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Challenge) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *Challenge) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Challenge) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Challenge) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
+func (x *Challenge) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Challenge) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *Challenge) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Challenge) GetRequiresInstance() bool {
+	if x != nil {
+		return x.RequiresInstance
+	}
+	return false
+}
+
+func (x *Challenge) GetScoringType() ScoringType {
+	if x != nil {
+		return x.ScoringType
+	}
+	return ScoringType_SCORING_TYPE_UNSPECIFIED
+}
+
+func (x *Challenge) GetInitialPoints() int32 {
+	if x != nil {
+		return x.InitialPoints
+	}
+	return 0
+}
+
+func (x *Challenge) GetMinimumPoints() int32 {
+	if x != nil {
+		return x.MinimumPoints
+	}
+	return 0
+}
+
+func (x *Challenge) GetDecay() int32 {
+	if x != nil {
+		return x.Decay
+	}
+	return 0
+}
+
+func (x *Challenge) GetDynamicFlag() bool {
+	if x != nil {
+		return x.DynamicFlag
+	}
+	return false
+}
+
+func (x *Challenge) GetFlagMatchMode() FlagMatchMode {
+	if x != nil {
+		return x.FlagMatchMode
+	}
+	return FlagMatchMode_FLAG_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *Challenge) GetAcceptedFlags() []string {
+	if x != nil {
+		return x.AcceptedFlags
+	}
+	return nil
+}
+
+func (x *Challenge) GetPrerequisiteIds() []string {
+	if x != nil {
+		return x.PrerequisiteIds
+	}
+	return nil
+}
+
+func (x *Challenge) GetPrerequisiteMode() PrerequisiteMode {
+	if x != nil {
+		return x.PrerequisiteMode
+	}
+	return PrerequisiteMode_PREREQUISITE_MODE_UNSPECIFIED
+}
+
+func (x *Challenge) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *Challenge) GetVisibility() ChallengeVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return ChallengeVisibility_CHALLENGE_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Challenge) GetReleaseAt() int64 {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return 0
+}
+
+func (x *Challenge) GetParts() []*FlagPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *Challenge) GetSolveCount() int32 {
+	if x != nil {
+		return x.SolveCount
+	}
+	return 0
+}
+
+func (x *Challenge) GetSolvedByMe() bool {
+	if x != nil {
+		return x.SolvedByMe
+	}
+	return false
+}
+
+func (x *Challenge) GetFirstBlood() *FirstBlood {
+	if x != nil {
+		return x.FirstBlood
+	}
+	return nil
+}
+
+func (x *Challenge) GetBloodBonuses() []int32 {
+	if x != nil {
+		return x.BloodBonuses
+	}
+	return nil
+}
+
+func (x *Challenge) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type FirstBlood struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamId        string                 `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,4,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	SolvedAt      int64                  `protobuf:"varint,5,opt,name=solved_at,json=solvedAt,proto3" json:"solved_at,omitempty"` // unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FirstBlood) Reset() {
+	*x = FirstBlood{}
+	mi := &file_api_server_v1_model_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FirstBlood) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirstBlood) ProtoMessage() {}
+
+func (x *FirstBlood) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirstBlood.ProtoReflect.Descriptor instead.
+func (*FirstBlood) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FirstBlood) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FirstBlood) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *FirstBlood) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *FirstBlood) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *FirstBlood) GetSolvedAt() int64 {
+	if x != nil {
+		return x.SolvedAt
+	}
+	return 0
+}
+
+// a named part of a multi-part challenge; flag is empty for players
+type FlagPart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartId        string                 `protobuf:"bytes,1,opt,name=part_id,json=partId,proto3" json:"part_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Flag          string                 `protobuf:"bytes,3,opt,name=flag,proto3" json:"flag,omitempty"`
+	Points        int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Solved        bool                   `protobuf:"varint,5,opt,name=solved,proto3" json:"solved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagPart) Reset() {
+	*x = FlagPart{}
+	mi := &file_api_server_v1_model_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagPart) ProtoMessage() {}
+
+func (x *FlagPart) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagPart.ProtoReflect.Descriptor instead.
+func (*FlagPart) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FlagPart) GetPartId() string {
+	if x != nil {
+		return x.PartId
+	}
+	return ""
+}
+
+func (x *FlagPart) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FlagPart) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
+func (x *FlagPart) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *FlagPart) GetSolved() bool {
+	if x != nil {
+		return x.Solved
+	}
+	return false
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_api_server_v1_model_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Attachment) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ChallengeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Flag             string                 `protobuf:"bytes,3,opt,name=flag,proto3" json:"flag,omitempty"`
+	Points           int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Genre            string                 `protobuf:"bytes,5,opt,name=genre,proto3" json:"genre,omitempty"`
+	RequiresInstance bool                   `protobuf:"varint,6,opt,name=requires_instance,json=requiresInstance,proto3" json:"requires_instance,omitempty"`
+	ScoringType      ScoringType            `protobuf:"varint,7,opt,name=scoring_type,json=scoringType,proto3,enum=api.server.v1.ScoringType" json:"scoring_type,omitempty"`
+	InitialPoints    int32                  `protobuf:"varint,8,opt,name=initial_points,json=initialPoints,proto3" json:"initial_points,omitempty"`
+	MinimumPoints    int32                  `protobuf:"varint,9,opt,name=minimum_points,json=minimumPoints,proto3" json:"minimum_points,omitempty"`
+	Decay            int32                  `protobuf:"varint,10,opt,name=decay,proto3" json:"decay,omitempty"`
+	DynamicFlag      bool                   `protobuf:"varint,11,opt,name=dynamic_flag,json=dynamicFlag,proto3" json:"dynamic_flag,omitempty"`
+	FlagMatchMode    FlagMatchMode          `protobuf:"varint,12,opt,name=flag_match_mode,json=flagMatchMode,proto3,enum=api.server.v1.FlagMatchMode" json:"flag_match_mode,omitempty"`
+	AcceptedFlags    []string               `protobuf:"bytes,13,rep,name=accepted_flags,json=acceptedFlags,proto3" json:"accepted_flags,omitempty"` // accepted in addition to flag
+	PrerequisiteIds  []string               `protobuf:"bytes,14,rep,name=prerequisite_ids,json=prerequisiteIds,proto3" json:"prerequisite_ids,omitempty"`
+	PrerequisiteMode PrerequisiteMode       `protobuf:"varint,15,opt,name=prerequisite_mode,json=prerequisiteMode,proto3,enum=api.server.v1.PrerequisiteMode" json:"prerequisite_mode,omitempty"`
+	Visibility       ChallengeVisibility    `protobuf:"varint,16,opt,name=visibility,proto3,enum=api.server.v1.ChallengeVisibility" json:"visibility,omitempty"`
+	ReleaseAt        int64                  `protobuf:"varint,17,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`                 // unix seconds, 0 means not set
+	Parts            []*FlagPart            `protobuf:"bytes,18,rep,name=parts,proto3" json:"parts,omitempty"`                                           // points of the challenge become a completion bonus
+	BloodBonuses     []int32                `protobuf:"varint,19,rep,packed,name=blood_bonuses,json=bloodBonuses,proto3" json:"blood_bonuses,omitempty"` // bonus for the 1st, 2nd and 3rd solver, at most 3 entries
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ChallengeRequest) Reset() {
+	*x = ChallengeRequest{}
+	mi := &file_api_server_v1_model_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeRequest) ProtoMessage() {}
+
+func (x *ChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeRequest.ProtoReflect.Descriptor instead.
+func (*ChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChallengeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChallengeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ChallengeRequest) GetFlag() string {
+	if x != nil {
+		return x.Flag
+	}
+	return ""
+}
+
+func (x *ChallengeRequest) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *ChallengeRequest) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *ChallengeRequest) GetRequiresInstance() bool {
+	if x != nil {
+		return x.RequiresInstance
+	}
+	return false
+}
+
+func (x *ChallengeRequest) GetScoringType() ScoringType {
+	if x != nil {
+		return x.ScoringType
+	}
+	return ScoringType_SCORING_TYPE_UNSPECIFIED
+}
+
+func (x *ChallengeRequest) GetInitialPoints() int32 {
+	if x != nil {
+		return x.InitialPoints
+	}
+	return 0
+}
+
+func (x *ChallengeRequest) GetMinimumPoints() int32 {
+	if x != nil {
+		return x.MinimumPoints
+	}
+	return 0
+}
+
+func (x *ChallengeRequest) GetDecay() int32 {
+	if x != nil {
+		return x.Decay
+	}
+	return 0
+}
+
+func (x *ChallengeRequest) GetDynamicFlag() bool {
+	if x != nil {
+		return x.DynamicFlag
+	}
+	return false
+}
+
+func (x *ChallengeRequest) GetFlagMatchMode() FlagMatchMode {
+	if x != nil {
+		return x.FlagMatchMode
+	}
+	return FlagMatchMode_FLAG_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *ChallengeRequest) GetAcceptedFlags() []string {
+	if x != nil {
+		return x.AcceptedFlags
+	}
+	return nil
+}
+
+func (x *ChallengeRequest) GetPrerequisiteIds() []string {
+	if x != nil {
+		return x.PrerequisiteIds
+	}
+	return nil
+}
+
+func (x *ChallengeRequest) GetPrerequisiteMode() PrerequisiteMode {
+	if x != nil {
+		return x.PrerequisiteMode
+	}
+	return PrerequisiteMode_PREREQUISITE_MODE_UNSPECIFIED
+}
+
+func (x *ChallengeRequest) GetVisibility() ChallengeVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return ChallengeVisibility_CHALLENGE_VISIBILITY_UNSPECIFIED
+}
+
+func (x *ChallengeRequest) GetReleaseAt() int64 {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return 0
+}
+
+func (x *ChallengeRequest) GetParts() []*FlagPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ChallengeRequest) GetBloodBonuses() []int32 {
+	if x != nil {
+		return x.BloodBonuses
+	}
+	return nil
+}
+
+type Submission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SubmittedFlag string                 `protobuf:"bytes,3,opt,name=submitted_flag,json=submittedFlag,proto3" json:"submitted_flag,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
+	mi := &file_api_server_v1_model_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submission) ProtoMessage() {}
+
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Submission) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *Submission) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Submission) GetSubmittedFlag() string {
+	if x != nil {
+		return x.SubmittedFlag
+	}
+	return ""
+}
+
+func (x *Submission) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ScoreboardEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	SolveCount    int32                  `protobuf:"varint,5,opt,name=solve_count,json=solveCount,proto3" json:"solve_count,omitempty"`
+	LastSolveAt   int64                  `protobuf:"varint,6,opt,name=last_solve_at,json=lastSolveAt,proto3" json:"last_solve_at,omitempty"`
+	TeamId        string                 `protobuf:"bytes,7,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,8,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreboardEntry) Reset() {
+	*x = ScoreboardEntry{}
+	mi := &file_api_server_v1_model_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreboardEntry) ProtoMessage() {}
+
+func (x *ScoreboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreboardEntry.ProtoReflect.Descriptor instead.
+func (*ScoreboardEntry) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScoreboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ScoreboardEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ScoreboardEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ScoreboardEntry) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScoreboardEntry) GetSolveCount() int32 {
+	if x != nil {
+		return x.SolveCount
+	}
+	return 0
+}
+
+func (x *ScoreboardEntry) GetLastSolveAt() int64 {
+	if x != nil {
+		return x.LastSolveAt
+	}
+	return 0
+}
+
+func (x *ScoreboardEntry) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *ScoreboardEntry) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+// cumulative score of a scoreboard entry over time
+type ScoreHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *ScoreboardEntry       `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Points        []*ScorePoint          `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreHistory) Reset() {
+	*x = ScoreHistory{}
+	mi := &file_api_server_v1_model_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreHistory) ProtoMessage() {}
+
+func (x *ScoreHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreHistory.ProtoReflect.Descriptor instead.
+func (*ScoreHistory) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScoreHistory) GetEntry() *ScoreboardEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *ScoreHistory) GetPoints() []*ScorePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type ScorePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	At            int64                  `protobuf:"varint,1,opt,name=at,proto3" json:"at,omitempty"` // unix seconds
+	Score         int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScorePoint) Reset() {
+	*x = ScorePoint{}
+	mi := &file_api_server_v1_model_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScorePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScorePoint) ProtoMessage() {}
+
+func (x *ScorePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScorePoint.ProtoReflect.Descriptor instead.
+func (*ScorePoint) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ScorePoint) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *ScorePoint) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// content is empty for players until the hint is unlocked
+type Hint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HintId        string                 `protobuf:"bytes,1,opt,name=hint_id,json=hintId,proto3" json:"hint_id,omitempty"`
+	ChallengeId   string                 `protobuf:"bytes,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Cost          int32                  `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	Unlocked      bool                   `protobuf:"varint,6,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hint) Reset() {
+	*x = Hint{}
+	mi := &file_api_server_v1_model_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Challenge) String() string {
+func (x *Hint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Challenge) ProtoMessage() {}
+func (*Hint) ProtoMessage() {}
 
-func (x *Challenge) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_model_proto_msgTypes[0]
+func (x *Hint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,92 +1245,139 @@ func (x *Challenge) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
-func (*Challenge) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_model_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use Hint.ProtoReflect.Descriptor instead.
+func (*Hint) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Challenge) GetChallengeId() string {
+func (x *Hint) GetHintId() string {
 	if x != nil {
-		return x.ChallengeId
+		return x.HintId
 	}
 	return ""
 }
 
-func (x *Challenge) GetName() string {
+func (x *Hint) GetChallengeId() string {
 	if x != nil {
-		return x.Name
+		return x.ChallengeId
 	}
 	return ""
 }
 
-func (x *Challenge) GetDescription() string {
+func (x *Hint) GetContent() string {
 	if x != nil {
-		return x.Description
+		return x.Content
 	}
 	return ""
 }
 
-func (x *Challenge) GetFlag() string {
+func (x *Hint) GetCost() int32 {
 	if x != nil {
-		return x.Flag
+		return x.Cost
 	}
-	return ""
+	return 0
 }
 
-func (x *Challenge) GetPoints() int32 {
+func (x *Hint) GetPosition() int32 {
 	if x != nil {
-		return x.Points
+		return x.Position
 	}
 	return 0
 }
 
-func (x *Challenge) GetGenre() string {
+func (x *Hint) GetUnlocked() bool {
 	if x != nil {
-		return x.Genre
+		return x.Unlocked
 	}
-	return ""
+	return false
 }
 
-func (x *Challenge) GetAttachments() []*Attachment {
+// unix seconds, 0 means not set
+type EventConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartAt       int64                  `protobuf:"varint,1,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         int64                  `protobuf:"varint,2,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	FreezeAt      int64                  `protobuf:"varint,3,opt,name=freeze_at,json=freezeAt,proto3" json:"freeze_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventConfig) Reset() {
+	*x = EventConfig{}
+	mi := &file_api_server_v1_model_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventConfig) ProtoMessage() {}
+
+func (x *EventConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[10]
 	if x != nil {
-		return x.Attachments
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Challenge) GetRequiresInstance() bool {
+// Deprecated: Use EventConfig.ProtoReflect.Descriptor instead.
+func (*EventConfig) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventConfig) GetStartAt() int64 {
 	if x != nil {
-		return x.RequiresInstance
+		return x.StartAt
 	}
-	return false
+	return 0
 }
 
-type Attachment struct {
+func (x *EventConfig) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *EventConfig) GetFreezeAt() int64 {
+	if x != nil {
+		return x.FreezeAt
+	}
+	return 0
+}
+
+type Team struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	InviteCode    string                 `protobuf:"bytes,3,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Attachment) Reset() {
-	*x = Attachment{}
-	mi := &file_api_server_v1_model_proto_msgTypes[1]
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_api_server_v1_model_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Attachment) String() string {
+func (x *Team) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attachment) ProtoMessage() {}
+func (*Team) ProtoMessage() {}
 
-func (x *Attachment) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_model_proto_msgTypes[1]
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -156,66 +1388,129 @@ func (x *Attachment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
-func (*Attachment) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_model_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Attachment) GetAttachmentId() string {
+func (x *Team) GetTeamId() string {
 	if x != nil {
-		return x.AttachmentId
+		return x.TeamId
 	}
 	return ""
 }
 
-func (x *Attachment) GetFilename() string {
+func (x *Team) GetName() string {
 	if x != nil {
-		return x.Filename
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Attachment) GetSize() int64 {
+func (x *Team) GetInviteCode() string {
 	if x != nil {
-		return x.Size
+		return x.InviteCode
 	}
-	return 0
+	return ""
 }
 
-func (x *Attachment) GetUrl() string {
+func (x *Team) GetMembers() []*TeamMember {
 	if x != nil {
-		return x.Url
+		return x.Members
+	}
+	return nil
+}
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	JoinedAt      int64                  `protobuf:"varint,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_api_server_v1_model_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-type ChallengeRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Flag             string                 `protobuf:"bytes,3,opt,name=flag,proto3" json:"flag,omitempty"`
-	Points           int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
-	Genre            string                 `protobuf:"bytes,5,opt,name=genre,proto3" json:"genre,omitempty"`
-	RequiresInstance bool                   `protobuf:"varint,6,opt,name=requires_instance,json=requiresInstance,proto3" json:"requires_instance,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (x *ChallengeRequest) Reset() {
-	*x = ChallengeRequest{}
-	mi := &file_api_server_v1_model_proto_msgTypes[2]
+func (x *TeamMember) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+type LiveEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          LiveEventType          `protobuf:"varint,1,opt,name=type,proto3,enum=api.server.v1.LiveEventType" json:"type,omitempty"`
+	ChallengeId   string                 `protobuf:"bytes,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	ChallengeName string                 `protobuf:"bytes,3,opt,name=challenge_name,json=challengeName,proto3" json:"challenge_name,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	TeamId        string                 `protobuf:"bytes,6,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,7,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"` // announcement title
+	OccurredAt    int64                  `protobuf:"varint,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveEvent) Reset() {
+	*x = LiveEvent{}
+	mi := &file_api_server_v1_model_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChallengeRequest) String() string {
+func (x *LiveEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChallengeRequest) ProtoMessage() {}
+func (*LiveEvent) ProtoMessage() {}
 
-func (x *ChallengeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_model_proto_msgTypes[2]
+func (x *LiveEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,78 +1521,102 @@ func (x *ChallengeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChallengeRequest.ProtoReflect.Descriptor instead.
-func (*ChallengeRequest) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_model_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use LiveEvent.ProtoReflect.Descriptor instead.
+func (*LiveEvent) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ChallengeRequest) GetName() string {
+func (x *LiveEvent) GetType() LiveEventType {
 	if x != nil {
-		return x.Name
+		return x.Type
+	}
+	return LiveEventType_LIVE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *LiveEvent) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
 	}
 	return ""
 }
 
-func (x *ChallengeRequest) GetDescription() string {
+func (x *LiveEvent) GetChallengeName() string {
 	if x != nil {
-		return x.Description
+		return x.ChallengeName
 	}
 	return ""
 }
 
-func (x *ChallengeRequest) GetFlag() string {
+func (x *LiveEvent) GetUserId() string {
 	if x != nil {
-		return x.Flag
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ChallengeRequest) GetPoints() int32 {
+func (x *LiveEvent) GetUsername() string {
 	if x != nil {
-		return x.Points
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *ChallengeRequest) GetGenre() string {
+func (x *LiveEvent) GetTeamId() string {
 	if x != nil {
-		return x.Genre
+		return x.TeamId
 	}
 	return ""
 }
 
-func (x *ChallengeRequest) GetRequiresInstance() bool {
+func (x *LiveEvent) GetTeamName() string {
 	if x != nil {
-		return x.RequiresInstance
+		return x.TeamName
 	}
-	return false
+	return ""
 }
 
-type Submission struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SubmittedFlag string                 `protobuf:"bytes,3,opt,name=submitted_flag,json=submittedFlag,proto3" json:"submitted_flag,omitempty"`
-	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LiveEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *Submission) Reset() {
-	*x = Submission{}
-	mi := &file_api_server_v1_model_proto_msgTypes[3]
+func (x *LiveEvent) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+// challenge_id is empty for an event-wide announcement
+type Announcement struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementId string                 `protobuf:"bytes,1,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	ChallengeId    string                 `protobuf:"bytes,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content        string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_api_server_v1_model_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Submission) String() string {
+func (x *Announcement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Submission) ProtoMessage() {}
+func (*Announcement) ProtoMessage() {}
 
-func (x *Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_v1_model_proto_msgTypes[3]
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_v1_model_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -308,35 +1627,49 @@ func (x *Submission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
-func (*Submission) Descriptor() ([]byte, []int) {
-	return file_api_server_v1_model_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_api_server_v1_model_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Submission) GetChallengeId() string {
+func (x *Announcement) GetAnnouncementId() string {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return ""
+}
+
+func (x *Announcement) GetChallengeId() string {
 	if x != nil {
 		return x.ChallengeId
 	}
 	return ""
 }
 
-func (x *Submission) GetUserId() string {
+func (x *Announcement) GetTitle() string {
 	if x != nil {
-		return x.UserId
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Submission) GetSubmittedFlag() string {
+func (x *Announcement) GetContent() string {
 	if x != nil {
-		return x.SubmittedFlag
+		return x.Content
 	}
 	return ""
 }
 
-func (x *Submission) GetTimestamp() int64 {
+func (x *Announcement) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Announcement) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return 0
 }
@@ -345,7 +1678,7 @@ var File_api_server_v1_model_proto protoreflect.FileDescriptor
 
 const file_api_server_v1_model_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/server/v1/model.proto\x12\rapi.server.v1\"\x90\x02\n" +
+	"\x19api/server/v1/model.proto\x12\rapi.server.v1\"\xa7\b\n" +
 	"\tChallenge\x12!\n" +
 	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -354,26 +1687,165 @@ const file_api_server_v1_model_proto_rawDesc = "" +
 	"\x06points\x18\x05 \x01(\x05R\x06points\x12\x14\n" +
 	"\x05genre\x18\x06 \x01(\tR\x05genre\x12;\n" +
 	"\vattachments\x18\a \x03(\v2\x19.api.server.v1.AttachmentR\vattachments\x12+\n" +
-	"\x11requires_instance\x18\b \x01(\bR\x10requiresInstance\"s\n" +
+	"\x11requires_instance\x18\b \x01(\bR\x10requiresInstance\x12=\n" +
+	"\fscoring_type\x18\t \x01(\x0e2\x1a.api.server.v1.ScoringTypeR\vscoringType\x12%\n" +
+	"\x0einitial_points\x18\n" +
+	" \x01(\x05R\rinitialPoints\x12%\n" +
+	"\x0eminimum_points\x18\v \x01(\x05R\rminimumPoints\x12\x14\n" +
+	"\x05decay\x18\f \x01(\x05R\x05decay\x12!\n" +
+	"\fdynamic_flag\x18\r \x01(\bR\vdynamicFlag\x12D\n" +
+	"\x0fflag_match_mode\x18\x0e \x01(\x0e2\x1c.api.server.v1.FlagMatchModeR\rflagMatchMode\x12%\n" +
+	"\x0eaccepted_flags\x18\x0f \x03(\tR\racceptedFlags\x12)\n" +
+	"\x10prerequisite_ids\x18\x10 \x03(\tR\x0fprerequisiteIds\x12L\n" +
+	"\x11prerequisite_mode\x18\x11 \x01(\x0e2\x1f.api.server.v1.PrerequisiteModeR\x10prerequisiteMode\x12\x16\n" +
+	"\x06locked\x18\x12 \x01(\bR\x06locked\x12B\n" +
+	"\n" +
+	"visibility\x18\x13 \x01(\x0e2\".api.server.v1.ChallengeVisibilityR\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"release_at\x18\x14 \x01(\x03R\treleaseAt\x12-\n" +
+	"\x05parts\x18\x15 \x03(\v2\x17.api.server.v1.FlagPartR\x05parts\x12\x1f\n" +
+	"\vsolve_count\x18\x16 \x01(\x05R\n" +
+	"solveCount\x12 \n" +
+	"\fsolved_by_me\x18\x17 \x01(\bR\n" +
+	"solvedByMe\x12:\n" +
+	"\vfirst_blood\x18\x18 \x01(\v2\x19.api.server.v1.FirstBloodR\n" +
+	"firstBlood\x12#\n" +
+	"\rblood_bonuses\x18\x19 \x03(\x05R\fbloodBonuses\x12\x1b\n" +
+	"\tauthor_id\x18\x1a \x01(\tR\bauthorId\"\x94\x01\n" +
+	"\n" +
+	"FirstBlood\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\tR\x06teamId\x12\x1b\n" +
+	"\tteam_name\x18\x04 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tsolved_at\x18\x05 \x01(\x03R\bsolvedAt\"{\n" +
+	"\bFlagPart\x12\x17\n" +
+	"\apart_id\x18\x01 \x01(\tR\x06partId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04flag\x18\x03 \x01(\tR\x04flag\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x05R\x06points\x12\x16\n" +
+	"\x06solved\x18\x05 \x01(\bR\x06solved\"s\n" +
 	"\n" +
 	"Attachment\x12#\n" +
 	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
 	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x10\n" +
-	"\x03url\x18\x04 \x01(\tR\x03url\"\xb7\x01\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\"\x9a\x06\n" +
 	"\x10ChallengeRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
 	"\x04flag\x18\x03 \x01(\tR\x04flag\x12\x16\n" +
 	"\x06points\x18\x04 \x01(\x05R\x06points\x12\x14\n" +
 	"\x05genre\x18\x05 \x01(\tR\x05genre\x12+\n" +
-	"\x11requires_instance\x18\x06 \x01(\bR\x10requiresInstance\"\x8d\x01\n" +
+	"\x11requires_instance\x18\x06 \x01(\bR\x10requiresInstance\x12=\n" +
+	"\fscoring_type\x18\a \x01(\x0e2\x1a.api.server.v1.ScoringTypeR\vscoringType\x12%\n" +
+	"\x0einitial_points\x18\b \x01(\x05R\rinitialPoints\x12%\n" +
+	"\x0eminimum_points\x18\t \x01(\x05R\rminimumPoints\x12\x14\n" +
+	"\x05decay\x18\n" +
+	" \x01(\x05R\x05decay\x12!\n" +
+	"\fdynamic_flag\x18\v \x01(\bR\vdynamicFlag\x12D\n" +
+	"\x0fflag_match_mode\x18\f \x01(\x0e2\x1c.api.server.v1.FlagMatchModeR\rflagMatchMode\x12%\n" +
+	"\x0eaccepted_flags\x18\r \x03(\tR\racceptedFlags\x12)\n" +
+	"\x10prerequisite_ids\x18\x0e \x03(\tR\x0fprerequisiteIds\x12L\n" +
+	"\x11prerequisite_mode\x18\x0f \x01(\x0e2\x1f.api.server.v1.PrerequisiteModeR\x10prerequisiteMode\x12B\n" +
+	"\n" +
+	"visibility\x18\x10 \x01(\x0e2\".api.server.v1.ChallengeVisibilityR\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"release_at\x18\x11 \x01(\x03R\treleaseAt\x12-\n" +
+	"\x05parts\x18\x12 \x03(\v2\x17.api.server.v1.FlagPartR\x05parts\x12#\n" +
+	"\rblood_bonuses\x18\x13 \x03(\x05R\fbloodBonuses\"\x8d\x01\n" +
 	"\n" +
 	"Submission\x12!\n" +
 	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12%\n" +
 	"\x0esubmitted_flag\x18\x03 \x01(\tR\rsubmittedFlag\x12\x1c\n" +
-	"\ttimestamp\x18\x04 \x01(\x03R\ttimestampB\xb1\x01\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\"\xeb\x01\n" +
+	"\x0fScoreboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\x12\x1f\n" +
+	"\vsolve_count\x18\x05 \x01(\x05R\n" +
+	"solveCount\x12\"\n" +
+	"\rlast_solve_at\x18\x06 \x01(\x03R\vlastSolveAt\x12\x17\n" +
+	"\ateam_id\x18\a \x01(\tR\x06teamId\x12\x1b\n" +
+	"\tteam_name\x18\b \x01(\tR\bteamName\"w\n" +
+	"\fScoreHistory\x124\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1e.api.server.v1.ScoreboardEntryR\x05entry\x121\n" +
+	"\x06points\x18\x02 \x03(\v2\x19.api.server.v1.ScorePointR\x06points\"2\n" +
+	"\n" +
+	"ScorePoint\x12\x0e\n" +
+	"\x02at\x18\x01 \x01(\x03R\x02at\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\"\xa8\x01\n" +
+	"\x04Hint\x12\x17\n" +
+	"\ahint_id\x18\x01 \x01(\tR\x06hintId\x12!\n" +
+	"\fchallenge_id\x18\x02 \x01(\tR\vchallengeId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x05R\x04cost\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x12\x1a\n" +
+	"\bunlocked\x18\x06 \x01(\bR\bunlocked\"\\\n" +
+	"\vEventConfig\x12\x19\n" +
+	"\bstart_at\x18\x01 \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x02 \x01(\x03R\x05endAt\x12\x1b\n" +
+	"\tfreeze_at\x18\x03 \x01(\x03R\bfreezeAt\"\x89\x01\n" +
+	"\x04Team\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vinvite_code\x18\x03 \x01(\tR\n" +
+	"inviteCode\x123\n" +
+	"\amembers\x18\x04 \x03(\v2\x19.api.server.v1.TeamMemberR\amembers\"^\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tjoined_at\x18\x03 \x01(\x03R\bjoinedAt\"\xad\x02\n" +
+	"\tLiveEvent\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.api.server.v1.LiveEventTypeR\x04type\x12!\n" +
+	"\fchallenge_id\x18\x02 \x01(\tR\vchallengeId\x12%\n" +
+	"\x0echallenge_name\x18\x03 \x01(\tR\rchallengeName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x17\n" +
+	"\ateam_id\x18\x06 \x01(\tR\x06teamId\x12\x1b\n" +
+	"\tteam_name\x18\a \x01(\tR\bteamName\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\x12\x1f\n" +
+	"\voccurred_at\x18\t \x01(\x03R\n" +
+	"occurredAt\"\xc8\x01\n" +
+	"\fAnnouncement\x12'\n" +
+	"\x0fannouncement_id\x18\x01 \x01(\tR\x0eannouncementId\x12!\n" +
+	"\fchallenge_id\x18\x02 \x01(\tR\vchallengeId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt*^\n" +
+	"\vScoringType\x12\x1c\n" +
+	"\x18SCORING_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SCORING_TYPE_STATIC\x10\x01\x12\x18\n" +
+	"\x14SCORING_TYPE_DYNAMIC\x10\x02*\x8c\x01\n" +
+	"\rFlagMatchMode\x12\x1f\n" +
+	"\x1bFLAG_MATCH_MODE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15FLAG_MATCH_MODE_EXACT\x10\x01\x12$\n" +
+	" FLAG_MATCH_MODE_CASE_INSENSITIVE\x10\x02\x12\x19\n" +
+	"\x15FLAG_MATCH_MODE_REGEX\x10\x03*k\n" +
+	"\x10PrerequisiteMode\x12!\n" +
+	"\x1dPREREQUISITE_MODE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PREREQUISITE_MODE_ALL\x10\x01\x12\x19\n" +
+	"\x15PREREQUISITE_MODE_ANY\x10\x02*\xc2\x01\n" +
+	"\x13ChallengeVisibility\x12$\n" +
+	" CHALLENGE_VISIBILITY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aCHALLENGE_VISIBILITY_DRAFT\x10\x01\x12\x1f\n" +
+	"\x1bCHALLENGE_VISIBILITY_HIDDEN\x10\x02\x12 \n" +
+	"\x1cCHALLENGE_VISIBILITY_VISIBLE\x10\x03\x12\"\n" +
+	"\x1eCHALLENGE_VISIBILITY_SCHEDULED\x10\x04*\xb1\x01\n" +
+	"\rLiveEventType\x12\x1f\n" +
+	"\x1bLIVE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15LIVE_EVENT_TYPE_SOLVE\x10\x01\x12\x1f\n" +
+	"\x1bLIVE_EVENT_TYPE_FIRST_BLOOD\x10\x02\x12!\n" +
+	"\x1dLIVE_EVENT_TYPE_NEW_CHALLENGE\x10\x03\x12 \n" +
+	"\x1cLIVE_EVENT_TYPE_ANNOUNCEMENT\x10\x04B\xb1\x01\n" +
 	"\x11com.api.server.v1B\n" +
 	"ModelProtoP\x01Z:github.com/kavos113/quickctf/gen/go/api/server/v1;serverv1\xa2\x02\x03ASX\xaa\x02\rApi.Server.V1\xca\x02\rApi\\Server\\V1\xe2\x02\x19Api\\Server\\V1\\GPBMetadata\xea\x02\x0fApi::Server::V1b\x06proto3"
 
@@ -389,20 +1861,52 @@ func file_api_server_v1_model_proto_rawDescGZIP() []byte {
 	return file_api_server_v1_model_proto_rawDescData
 }
 
-var file_api_server_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_server_v1_model_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_api_server_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_api_server_v1_model_proto_goTypes = []any{
-	(*Challenge)(nil),        // 0: api.server.v1.Challenge
-	(*Attachment)(nil),       // 1: api.server.v1.Attachment
-	(*ChallengeRequest)(nil), // 2: api.server.v1.ChallengeRequest
-	(*Submission)(nil),       // 3: api.server.v1.Submission
+	(ScoringType)(0),         // 0: api.server.v1.ScoringType
+	(FlagMatchMode)(0),       // 1: api.server.v1.FlagMatchMode
+	(PrerequisiteMode)(0),    // 2: api.server.v1.PrerequisiteMode
+	(ChallengeVisibility)(0), // 3: api.server.v1.ChallengeVisibility
+	(LiveEventType)(0),       // 4: api.server.v1.LiveEventType
+	(*Challenge)(nil),        // 5: api.server.v1.Challenge
+	(*FirstBlood)(nil),       // 6: api.server.v1.FirstBlood
+	(*FlagPart)(nil),         // 7: api.server.v1.FlagPart
+	(*Attachment)(nil),       // 8: api.server.v1.Attachment
+	(*ChallengeRequest)(nil), // 9: api.server.v1.ChallengeRequest
+	(*Submission)(nil),       // 10: api.server.v1.Submission
+	(*ScoreboardEntry)(nil),  // 11: api.server.v1.ScoreboardEntry
+	(*ScoreHistory)(nil),     // 12: api.server.v1.ScoreHistory
+	(*ScorePoint)(nil),       // 13: api.server.v1.ScorePoint
+	(*Hint)(nil),             // 14: api.server.v1.Hint
+	(*EventConfig)(nil),      // 15: api.server.v1.EventConfig
+	(*Team)(nil),             // 16: api.server.v1.Team
+	(*TeamMember)(nil),       // 17: api.server.v1.TeamMember
+	(*LiveEvent)(nil),        // 18: api.server.v1.LiveEvent
+	(*Announcement)(nil),     // 19: api.server.v1.Announcement
 }
 var file_api_server_v1_model_proto_depIdxs = []int32{
-	1, // 0: api.server.v1.Challenge.attachments:type_name -> api.server.v1.Attachment
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8,  // 0: api.server.v1.Challenge.attachments:type_name -> api.server.v1.Attachment
+	0,  // 1: api.server.v1.Challenge.scoring_type:type_name -> api.server.v1.ScoringType
+	1,  // 2: api.server.v1.Challenge.flag_match_mode:type_name -> api.server.v1.FlagMatchMode
+	2,  // 3: api.server.v1.Challenge.prerequisite_mode:type_name -> api.server.v1.PrerequisiteMode
+	3,  // 4: api.server.v1.Challenge.visibility:type_name -> api.server.v1.ChallengeVisibility
+	7,  // 5: api.server.v1.Challenge.parts:type_name -> api.server.v1.FlagPart
+	6,  // 6: api.server.v1.Challenge.first_blood:type_name -> api.server.v1.FirstBlood
+	0,  // 7: api.server.v1.ChallengeRequest.scoring_type:type_name -> api.server.v1.ScoringType
+	1,  // 8: api.server.v1.ChallengeRequest.flag_match_mode:type_name -> api.server.v1.FlagMatchMode
+	2,  // 9: api.server.v1.ChallengeRequest.prerequisite_mode:type_name -> api.server.v1.PrerequisiteMode
+	3,  // 10: api.server.v1.ChallengeRequest.visibility:type_name -> api.server.v1.ChallengeVisibility
+	7,  // 11: api.server.v1.ChallengeRequest.parts:type_name -> api.server.v1.FlagPart
+	11, // 12: api.server.v1.ScoreHistory.entry:type_name -> api.server.v1.ScoreboardEntry
+	13, // 13: api.server.v1.ScoreHistory.points:type_name -> api.server.v1.ScorePoint
+	17, // 14: api.server.v1.Team.members:type_name -> api.server.v1.TeamMember
+	4,  // 15: api.server.v1.LiveEvent.type:type_name -> api.server.v1.LiveEventType
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_api_server_v1_model_proto_init() }
@@ -415,13 +1919,14 @@ func file_api_server_v1_model_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_server_v1_model_proto_rawDesc), len(file_api_server_v1_model_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      5,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_api_server_v1_model_proto_goTypes,
 		DependencyIndexes: file_api_server_v1_model_proto_depIdxs,
+		EnumInfos:         file_api_server_v1_model_proto_enumTypes,
 		MessageInfos:      file_api_server_v1_model_proto_msgTypes,
 	}.Build()
 	File_api_server_v1_model_proto = out.File
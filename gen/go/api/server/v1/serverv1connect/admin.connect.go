@@ -68,6 +68,64 @@ const (
 	// AdminServiceDeleteAttachmentProcedure is the fully-qualified name of the AdminService's
 	// DeleteAttachment RPC.
 	AdminServiceDeleteAttachmentProcedure = "/api.server.v1.AdminService/DeleteAttachment"
+	// AdminServiceGetEventConfigProcedure is the fully-qualified name of the AdminService's
+	// GetEventConfig RPC.
+	AdminServiceGetEventConfigProcedure = "/api.server.v1.AdminService/GetEventConfig"
+	// AdminServiceUpdateEventConfigProcedure is the fully-qualified name of the AdminService's
+	// UpdateEventConfig RPC.
+	AdminServiceUpdateEventConfigProcedure = "/api.server.v1.AdminService/UpdateEventConfig"
+	// AdminServiceGetFlagSharingReportProcedure is the fully-qualified name of the AdminService's
+	// GetFlagSharingReport RPC.
+	AdminServiceGetFlagSharingReportProcedure = "/api.server.v1.AdminService/GetFlagSharingReport"
+	// AdminServiceListSubmissionsProcedure is the fully-qualified name of the AdminService's
+	// ListSubmissions RPC.
+	AdminServiceListSubmissionsProcedure = "/api.server.v1.AdminService/ListSubmissions"
+	// AdminServiceInvalidateSubmissionProcedure is the fully-qualified name of the AdminService's
+	// InvalidateSubmission RPC.
+	AdminServiceInvalidateSubmissionProcedure = "/api.server.v1.AdminService/InvalidateSubmission"
+	// AdminServiceListAdminsProcedure is the fully-qualified name of the AdminService's ListAdmins RPC.
+	AdminServiceListAdminsProcedure = "/api.server.v1.AdminService/ListAdmins"
+	// AdminServiceGrantAdminProcedure is the fully-qualified name of the AdminService's GrantAdmin RPC.
+	AdminServiceGrantAdminProcedure = "/api.server.v1.AdminService/GrantAdmin"
+	// AdminServiceRevokeAdminProcedure is the fully-qualified name of the AdminService's RevokeAdmin
+	// RPC.
+	AdminServiceRevokeAdminProcedure = "/api.server.v1.AdminService/RevokeAdmin"
+	// AdminServiceListUsersProcedure is the fully-qualified name of the AdminService's ListUsers RPC.
+	AdminServiceListUsersProcedure = "/api.server.v1.AdminService/ListUsers"
+	// AdminServiceGetUserProcedure is the fully-qualified name of the AdminService's GetUser RPC.
+	AdminServiceGetUserProcedure = "/api.server.v1.AdminService/GetUser"
+	// AdminServiceBanUserProcedure is the fully-qualified name of the AdminService's BanUser RPC.
+	AdminServiceBanUserProcedure = "/api.server.v1.AdminService/BanUser"
+	// AdminServiceUnbanUserProcedure is the fully-qualified name of the AdminService's UnbanUser RPC.
+	AdminServiceUnbanUserProcedure = "/api.server.v1.AdminService/UnbanUser"
+	// AdminServiceResetUserPasswordProcedure is the fully-qualified name of the AdminService's
+	// ResetUserPassword RPC.
+	AdminServiceResetUserPasswordProcedure = "/api.server.v1.AdminService/ResetUserPassword"
+	// AdminServiceDeleteUserProcedure is the fully-qualified name of the AdminService's DeleteUser RPC.
+	AdminServiceDeleteUserProcedure = "/api.server.v1.AdminService/DeleteUser"
+	// AdminServiceCreateHintProcedure is the fully-qualified name of the AdminService's CreateHint RPC.
+	AdminServiceCreateHintProcedure = "/api.server.v1.AdminService/CreateHint"
+	// AdminServiceUpdateHintProcedure is the fully-qualified name of the AdminService's UpdateHint RPC.
+	AdminServiceUpdateHintProcedure = "/api.server.v1.AdminService/UpdateHint"
+	// AdminServiceDeleteHintProcedure is the fully-qualified name of the AdminService's DeleteHint RPC.
+	AdminServiceDeleteHintProcedure = "/api.server.v1.AdminService/DeleteHint"
+	// AdminServiceListHintsProcedure is the fully-qualified name of the AdminService's ListHints RPC.
+	AdminServiceListHintsProcedure = "/api.server.v1.AdminService/ListHints"
+	// AdminServiceCreateAnnouncementProcedure is the fully-qualified name of the AdminService's
+	// CreateAnnouncement RPC.
+	AdminServiceCreateAnnouncementProcedure = "/api.server.v1.AdminService/CreateAnnouncement"
+	// AdminServiceUpdateAnnouncementProcedure is the fully-qualified name of the AdminService's
+	// UpdateAnnouncement RPC.
+	AdminServiceUpdateAnnouncementProcedure = "/api.server.v1.AdminService/UpdateAnnouncement"
+	// AdminServiceDeleteAnnouncementProcedure is the fully-qualified name of the AdminService's
+	// DeleteAnnouncement RPC.
+	AdminServiceDeleteAnnouncementProcedure = "/api.server.v1.AdminService/DeleteAnnouncement"
+	// AdminServiceListAnnouncementsProcedure is the fully-qualified name of the AdminService's
+	// ListAnnouncements RPC.
+	AdminServiceListAnnouncementsProcedure = "/api.server.v1.AdminService/ListAnnouncements"
+	// AdminServiceListAuditEventsProcedure is the fully-qualified name of the AdminService's
+	// ListAuditEvents RPC.
+	AdminServiceListAuditEventsProcedure = "/api.server.v1.AdminService/ListAuditEvents"
 	// AdminAuthServiceAdminLoginProcedure is the fully-qualified name of the AdminAuthService's
 	// AdminLogin RPC.
 	AdminAuthServiceAdminLoginProcedure = "/api.server.v1.AdminAuthService/AdminLogin"
@@ -89,6 +147,29 @@ type AdminServiceClient interface {
 	StreamBuildLog(context.Context, *connect.Request[v1.StreamBuildLogRequest]) (*connect.ServerStreamForClient[v1.StreamBuildLogResponse], error)
 	UploadAttachment(context.Context, *connect.Request[v1.UploadAttachmentRequest]) (*connect.Response[v1.UploadAttachmentResponse], error)
 	DeleteAttachment(context.Context, *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[v1.DeleteAttachmentResponse], error)
+	GetEventConfig(context.Context, *connect.Request[v1.GetEventConfigRequest]) (*connect.Response[v1.GetEventConfigResponse], error)
+	UpdateEventConfig(context.Context, *connect.Request[v1.UpdateEventConfigRequest]) (*connect.Response[v1.UpdateEventConfigResponse], error)
+	GetFlagSharingReport(context.Context, *connect.Request[v1.GetFlagSharingReportRequest]) (*connect.Response[v1.GetFlagSharingReportResponse], error)
+	ListSubmissions(context.Context, *connect.Request[v1.ListSubmissionsRequest]) (*connect.Response[v1.ListSubmissionsResponse], error)
+	InvalidateSubmission(context.Context, *connect.Request[v1.InvalidateSubmissionRequest]) (*connect.Response[v1.InvalidateSubmissionResponse], error)
+	ListAdmins(context.Context, *connect.Request[v1.ListAdminsRequest]) (*connect.Response[v1.ListAdminsResponse], error)
+	GrantAdmin(context.Context, *connect.Request[v1.GrantAdminRequest]) (*connect.Response[v1.GrantAdminResponse], error)
+	RevokeAdmin(context.Context, *connect.Request[v1.RevokeAdminRequest]) (*connect.Response[v1.RevokeAdminResponse], error)
+	ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error)
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	BanUser(context.Context, *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error)
+	UnbanUser(context.Context, *connect.Request[v1.UnbanUserRequest]) (*connect.Response[v1.UnbanUserResponse], error)
+	ResetUserPassword(context.Context, *connect.Request[v1.ResetUserPasswordRequest]) (*connect.Response[v1.ResetUserPasswordResponse], error)
+	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	CreateHint(context.Context, *connect.Request[v1.CreateHintRequest]) (*connect.Response[v1.CreateHintResponse], error)
+	UpdateHint(context.Context, *connect.Request[v1.UpdateHintRequest]) (*connect.Response[v1.UpdateHintResponse], error)
+	DeleteHint(context.Context, *connect.Request[v1.DeleteHintRequest]) (*connect.Response[v1.DeleteHintResponse], error)
+	ListHints(context.Context, *connect.Request[v1.ListHintsRequest]) (*connect.Response[v1.ListHintsResponse], error)
+	CreateAnnouncement(context.Context, *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error)
+	UpdateAnnouncement(context.Context, *connect.Request[v1.UpdateAnnouncementRequest]) (*connect.Response[v1.UpdateAnnouncementResponse], error)
+	DeleteAnnouncement(context.Context, *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error)
+	ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error)
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
 }
 
 // NewAdminServiceClient constructs a client for the api.server.v1.AdminService service. By default,
@@ -168,6 +249,144 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceMethods.ByName("DeleteAttachment")),
 			connect.WithClientOptions(opts...),
 		),
+		getEventConfig: connect.NewClient[v1.GetEventConfigRequest, v1.GetEventConfigResponse](
+			httpClient,
+			baseURL+AdminServiceGetEventConfigProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetEventConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		updateEventConfig: connect.NewClient[v1.UpdateEventConfigRequest, v1.UpdateEventConfigResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateEventConfigProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateEventConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		getFlagSharingReport: connect.NewClient[v1.GetFlagSharingReportRequest, v1.GetFlagSharingReportResponse](
+			httpClient,
+			baseURL+AdminServiceGetFlagSharingReportProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetFlagSharingReport")),
+			connect.WithClientOptions(opts...),
+		),
+		listSubmissions: connect.NewClient[v1.ListSubmissionsRequest, v1.ListSubmissionsResponse](
+			httpClient,
+			baseURL+AdminServiceListSubmissionsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListSubmissions")),
+			connect.WithClientOptions(opts...),
+		),
+		invalidateSubmission: connect.NewClient[v1.InvalidateSubmissionRequest, v1.InvalidateSubmissionResponse](
+			httpClient,
+			baseURL+AdminServiceInvalidateSubmissionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("InvalidateSubmission")),
+			connect.WithClientOptions(opts...),
+		),
+		listAdmins: connect.NewClient[v1.ListAdminsRequest, v1.ListAdminsResponse](
+			httpClient,
+			baseURL+AdminServiceListAdminsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListAdmins")),
+			connect.WithClientOptions(opts...),
+		),
+		grantAdmin: connect.NewClient[v1.GrantAdminRequest, v1.GrantAdminResponse](
+			httpClient,
+			baseURL+AdminServiceGrantAdminProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GrantAdmin")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAdmin: connect.NewClient[v1.RevokeAdminRequest, v1.RevokeAdminResponse](
+			httpClient,
+			baseURL+AdminServiceRevokeAdminProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("RevokeAdmin")),
+			connect.WithClientOptions(opts...),
+		),
+		listUsers: connect.NewClient[v1.ListUsersRequest, v1.ListUsersResponse](
+			httpClient,
+			baseURL+AdminServiceListUsersProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListUsers")),
+			connect.WithClientOptions(opts...),
+		),
+		getUser: connect.NewClient[v1.GetUserRequest, v1.GetUserResponse](
+			httpClient,
+			baseURL+AdminServiceGetUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetUser")),
+			connect.WithClientOptions(opts...),
+		),
+		banUser: connect.NewClient[v1.BanUserRequest, v1.BanUserResponse](
+			httpClient,
+			baseURL+AdminServiceBanUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("BanUser")),
+			connect.WithClientOptions(opts...),
+		),
+		unbanUser: connect.NewClient[v1.UnbanUserRequest, v1.UnbanUserResponse](
+			httpClient,
+			baseURL+AdminServiceUnbanUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UnbanUser")),
+			connect.WithClientOptions(opts...),
+		),
+		resetUserPassword: connect.NewClient[v1.ResetUserPasswordRequest, v1.ResetUserPasswordResponse](
+			httpClient,
+			baseURL+AdminServiceResetUserPasswordProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ResetUserPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUser: connect.NewClient[v1.DeleteUserRequest, v1.DeleteUserResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteUser")),
+			connect.WithClientOptions(opts...),
+		),
+		createHint: connect.NewClient[v1.CreateHintRequest, v1.CreateHintResponse](
+			httpClient,
+			baseURL+AdminServiceCreateHintProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CreateHint")),
+			connect.WithClientOptions(opts...),
+		),
+		updateHint: connect.NewClient[v1.UpdateHintRequest, v1.UpdateHintResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateHintProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateHint")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteHint: connect.NewClient[v1.DeleteHintRequest, v1.DeleteHintResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteHintProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteHint")),
+			connect.WithClientOptions(opts...),
+		),
+		listHints: connect.NewClient[v1.ListHintsRequest, v1.ListHintsResponse](
+			httpClient,
+			baseURL+AdminServiceListHintsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListHints")),
+			connect.WithClientOptions(opts...),
+		),
+		createAnnouncement: connect.NewClient[v1.CreateAnnouncementRequest, v1.CreateAnnouncementResponse](
+			httpClient,
+			baseURL+AdminServiceCreateAnnouncementProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CreateAnnouncement")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAnnouncement: connect.NewClient[v1.UpdateAnnouncementRequest, v1.UpdateAnnouncementResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateAnnouncementProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateAnnouncement")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAnnouncement: connect.NewClient[v1.DeleteAnnouncementRequest, v1.DeleteAnnouncementResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteAnnouncementProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteAnnouncement")),
+			connect.WithClientOptions(opts...),
+		),
+		listAnnouncements: connect.NewClient[v1.ListAnnouncementsRequest, v1.ListAnnouncementsResponse](
+			httpClient,
+			baseURL+AdminServiceListAnnouncementsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListAnnouncements")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditEvents: connect.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+AdminServiceListAuditEventsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListAuditEvents")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -184,6 +403,29 @@ type adminServiceClient struct {
 	streamBuildLog       *connect.Client[v1.StreamBuildLogRequest, v1.StreamBuildLogResponse]
 	uploadAttachment     *connect.Client[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse]
 	deleteAttachment     *connect.Client[v1.DeleteAttachmentRequest, v1.DeleteAttachmentResponse]
+	getEventConfig       *connect.Client[v1.GetEventConfigRequest, v1.GetEventConfigResponse]
+	updateEventConfig    *connect.Client[v1.UpdateEventConfigRequest, v1.UpdateEventConfigResponse]
+	getFlagSharingReport *connect.Client[v1.GetFlagSharingReportRequest, v1.GetFlagSharingReportResponse]
+	listSubmissions      *connect.Client[v1.ListSubmissionsRequest, v1.ListSubmissionsResponse]
+	invalidateSubmission *connect.Client[v1.InvalidateSubmissionRequest, v1.InvalidateSubmissionResponse]
+	listAdmins           *connect.Client[v1.ListAdminsRequest, v1.ListAdminsResponse]
+	grantAdmin           *connect.Client[v1.GrantAdminRequest, v1.GrantAdminResponse]
+	revokeAdmin          *connect.Client[v1.RevokeAdminRequest, v1.RevokeAdminResponse]
+	listUsers            *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	getUser              *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	banUser              *connect.Client[v1.BanUserRequest, v1.BanUserResponse]
+	unbanUser            *connect.Client[v1.UnbanUserRequest, v1.UnbanUserResponse]
+	resetUserPassword    *connect.Client[v1.ResetUserPasswordRequest, v1.ResetUserPasswordResponse]
+	deleteUser           *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	createHint           *connect.Client[v1.CreateHintRequest, v1.CreateHintResponse]
+	updateHint           *connect.Client[v1.UpdateHintRequest, v1.UpdateHintResponse]
+	deleteHint           *connect.Client[v1.DeleteHintRequest, v1.DeleteHintResponse]
+	listHints            *connect.Client[v1.ListHintsRequest, v1.ListHintsResponse]
+	createAnnouncement   *connect.Client[v1.CreateAnnouncementRequest, v1.CreateAnnouncementResponse]
+	updateAnnouncement   *connect.Client[v1.UpdateAnnouncementRequest, v1.UpdateAnnouncementResponse]
+	deleteAnnouncement   *connect.Client[v1.DeleteAnnouncementRequest, v1.DeleteAnnouncementResponse]
+	listAnnouncements    *connect.Client[v1.ListAnnouncementsRequest, v1.ListAnnouncementsResponse]
+	listAuditEvents      *connect.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
 }
 
 // CreateChallenge calls api.server.v1.AdminService.CreateChallenge.
@@ -241,6 +483,121 @@ func (c *adminServiceClient) DeleteAttachment(ctx context.Context, req *connect.
 	return c.deleteAttachment.CallUnary(ctx, req)
 }
 
+// GetEventConfig calls api.server.v1.AdminService.GetEventConfig.
+func (c *adminServiceClient) GetEventConfig(ctx context.Context, req *connect.Request[v1.GetEventConfigRequest]) (*connect.Response[v1.GetEventConfigResponse], error) {
+	return c.getEventConfig.CallUnary(ctx, req)
+}
+
+// UpdateEventConfig calls api.server.v1.AdminService.UpdateEventConfig.
+func (c *adminServiceClient) UpdateEventConfig(ctx context.Context, req *connect.Request[v1.UpdateEventConfigRequest]) (*connect.Response[v1.UpdateEventConfigResponse], error) {
+	return c.updateEventConfig.CallUnary(ctx, req)
+}
+
+// GetFlagSharingReport calls api.server.v1.AdminService.GetFlagSharingReport.
+func (c *adminServiceClient) GetFlagSharingReport(ctx context.Context, req *connect.Request[v1.GetFlagSharingReportRequest]) (*connect.Response[v1.GetFlagSharingReportResponse], error) {
+	return c.getFlagSharingReport.CallUnary(ctx, req)
+}
+
+// ListSubmissions calls api.server.v1.AdminService.ListSubmissions.
+func (c *adminServiceClient) ListSubmissions(ctx context.Context, req *connect.Request[v1.ListSubmissionsRequest]) (*connect.Response[v1.ListSubmissionsResponse], error) {
+	return c.listSubmissions.CallUnary(ctx, req)
+}
+
+// InvalidateSubmission calls api.server.v1.AdminService.InvalidateSubmission.
+func (c *adminServiceClient) InvalidateSubmission(ctx context.Context, req *connect.Request[v1.InvalidateSubmissionRequest]) (*connect.Response[v1.InvalidateSubmissionResponse], error) {
+	return c.invalidateSubmission.CallUnary(ctx, req)
+}
+
+// ListAdmins calls api.server.v1.AdminService.ListAdmins.
+func (c *adminServiceClient) ListAdmins(ctx context.Context, req *connect.Request[v1.ListAdminsRequest]) (*connect.Response[v1.ListAdminsResponse], error) {
+	return c.listAdmins.CallUnary(ctx, req)
+}
+
+// GrantAdmin calls api.server.v1.AdminService.GrantAdmin.
+func (c *adminServiceClient) GrantAdmin(ctx context.Context, req *connect.Request[v1.GrantAdminRequest]) (*connect.Response[v1.GrantAdminResponse], error) {
+	return c.grantAdmin.CallUnary(ctx, req)
+}
+
+// RevokeAdmin calls api.server.v1.AdminService.RevokeAdmin.
+func (c *adminServiceClient) RevokeAdmin(ctx context.Context, req *connect.Request[v1.RevokeAdminRequest]) (*connect.Response[v1.RevokeAdminResponse], error) {
+	return c.revokeAdmin.CallUnary(ctx, req)
+}
+
+// ListUsers calls api.server.v1.AdminService.ListUsers.
+func (c *adminServiceClient) ListUsers(ctx context.Context, req *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error) {
+	return c.listUsers.CallUnary(ctx, req)
+}
+
+// GetUser calls api.server.v1.AdminService.GetUser.
+func (c *adminServiceClient) GetUser(ctx context.Context, req *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return c.getUser.CallUnary(ctx, req)
+}
+
+// BanUser calls api.server.v1.AdminService.BanUser.
+func (c *adminServiceClient) BanUser(ctx context.Context, req *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error) {
+	return c.banUser.CallUnary(ctx, req)
+}
+
+// UnbanUser calls api.server.v1.AdminService.UnbanUser.
+func (c *adminServiceClient) UnbanUser(ctx context.Context, req *connect.Request[v1.UnbanUserRequest]) (*connect.Response[v1.UnbanUserResponse], error) {
+	return c.unbanUser.CallUnary(ctx, req)
+}
+
+// ResetUserPassword calls api.server.v1.AdminService.ResetUserPassword.
+func (c *adminServiceClient) ResetUserPassword(ctx context.Context, req *connect.Request[v1.ResetUserPasswordRequest]) (*connect.Response[v1.ResetUserPasswordResponse], error) {
+	return c.resetUserPassword.CallUnary(ctx, req)
+}
+
+// DeleteUser calls api.server.v1.AdminService.DeleteUser.
+func (c *adminServiceClient) DeleteUser(ctx context.Context, req *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error) {
+	return c.deleteUser.CallUnary(ctx, req)
+}
+
+// CreateHint calls api.server.v1.AdminService.CreateHint.
+func (c *adminServiceClient) CreateHint(ctx context.Context, req *connect.Request[v1.CreateHintRequest]) (*connect.Response[v1.CreateHintResponse], error) {
+	return c.createHint.CallUnary(ctx, req)
+}
+
+// UpdateHint calls api.server.v1.AdminService.UpdateHint.
+func (c *adminServiceClient) UpdateHint(ctx context.Context, req *connect.Request[v1.UpdateHintRequest]) (*connect.Response[v1.UpdateHintResponse], error) {
+	return c.updateHint.CallUnary(ctx, req)
+}
+
+// DeleteHint calls api.server.v1.AdminService.DeleteHint.
+func (c *adminServiceClient) DeleteHint(ctx context.Context, req *connect.Request[v1.DeleteHintRequest]) (*connect.Response[v1.DeleteHintResponse], error) {
+	return c.deleteHint.CallUnary(ctx, req)
+}
+
+// ListHints calls api.server.v1.AdminService.ListHints.
+func (c *adminServiceClient) ListHints(ctx context.Context, req *connect.Request[v1.ListHintsRequest]) (*connect.Response[v1.ListHintsResponse], error) {
+	return c.listHints.CallUnary(ctx, req)
+}
+
+// CreateAnnouncement calls api.server.v1.AdminService.CreateAnnouncement.
+func (c *adminServiceClient) CreateAnnouncement(ctx context.Context, req *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error) {
+	return c.createAnnouncement.CallUnary(ctx, req)
+}
+
+// UpdateAnnouncement calls api.server.v1.AdminService.UpdateAnnouncement.
+func (c *adminServiceClient) UpdateAnnouncement(ctx context.Context, req *connect.Request[v1.UpdateAnnouncementRequest]) (*connect.Response[v1.UpdateAnnouncementResponse], error) {
+	return c.updateAnnouncement.CallUnary(ctx, req)
+}
+
+// DeleteAnnouncement calls api.server.v1.AdminService.DeleteAnnouncement.
+func (c *adminServiceClient) DeleteAnnouncement(ctx context.Context, req *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error) {
+	return c.deleteAnnouncement.CallUnary(ctx, req)
+}
+
+// ListAnnouncements calls api.server.v1.AdminService.ListAnnouncements.
+func (c *adminServiceClient) ListAnnouncements(ctx context.Context, req *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error) {
+	return c.listAnnouncements.CallUnary(ctx, req)
+}
+
+// ListAuditEvents calls api.server.v1.AdminService.ListAuditEvents.
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
 // AdminServiceHandler is an implementation of the api.server.v1.AdminService service.
 type AdminServiceHandler interface {
 	CreateChallenge(context.Context, *connect.Request[v1.CreateChallengeRequest]) (*connect.Response[v1.CreateChallengeResponse], error)
@@ -254,6 +611,29 @@ type AdminServiceHandler interface {
 	StreamBuildLog(context.Context, *connect.Request[v1.StreamBuildLogRequest], *connect.ServerStream[v1.StreamBuildLogResponse]) error
 	UploadAttachment(context.Context, *connect.Request[v1.UploadAttachmentRequest]) (*connect.Response[v1.UploadAttachmentResponse], error)
 	DeleteAttachment(context.Context, *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[v1.DeleteAttachmentResponse], error)
+	GetEventConfig(context.Context, *connect.Request[v1.GetEventConfigRequest]) (*connect.Response[v1.GetEventConfigResponse], error)
+	UpdateEventConfig(context.Context, *connect.Request[v1.UpdateEventConfigRequest]) (*connect.Response[v1.UpdateEventConfigResponse], error)
+	GetFlagSharingReport(context.Context, *connect.Request[v1.GetFlagSharingReportRequest]) (*connect.Response[v1.GetFlagSharingReportResponse], error)
+	ListSubmissions(context.Context, *connect.Request[v1.ListSubmissionsRequest]) (*connect.Response[v1.ListSubmissionsResponse], error)
+	InvalidateSubmission(context.Context, *connect.Request[v1.InvalidateSubmissionRequest]) (*connect.Response[v1.InvalidateSubmissionResponse], error)
+	ListAdmins(context.Context, *connect.Request[v1.ListAdminsRequest]) (*connect.Response[v1.ListAdminsResponse], error)
+	GrantAdmin(context.Context, *connect.Request[v1.GrantAdminRequest]) (*connect.Response[v1.GrantAdminResponse], error)
+	RevokeAdmin(context.Context, *connect.Request[v1.RevokeAdminRequest]) (*connect.Response[v1.RevokeAdminResponse], error)
+	ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error)
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	BanUser(context.Context, *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error)
+	UnbanUser(context.Context, *connect.Request[v1.UnbanUserRequest]) (*connect.Response[v1.UnbanUserResponse], error)
+	ResetUserPassword(context.Context, *connect.Request[v1.ResetUserPasswordRequest]) (*connect.Response[v1.ResetUserPasswordResponse], error)
+	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	CreateHint(context.Context, *connect.Request[v1.CreateHintRequest]) (*connect.Response[v1.CreateHintResponse], error)
+	UpdateHint(context.Context, *connect.Request[v1.UpdateHintRequest]) (*connect.Response[v1.UpdateHintResponse], error)
+	DeleteHint(context.Context, *connect.Request[v1.DeleteHintRequest]) (*connect.Response[v1.DeleteHintResponse], error)
+	ListHints(context.Context, *connect.Request[v1.ListHintsRequest]) (*connect.Response[v1.ListHintsResponse], error)
+	CreateAnnouncement(context.Context, *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error)
+	UpdateAnnouncement(context.Context, *connect.Request[v1.UpdateAnnouncementRequest]) (*connect.Response[v1.UpdateAnnouncementResponse], error)
+	DeleteAnnouncement(context.Context, *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error)
+	ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error)
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
 }
 
 // NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -329,6 +709,144 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceMethods.ByName("DeleteAttachment")),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceGetEventConfigHandler := connect.NewUnaryHandler(
+		AdminServiceGetEventConfigProcedure,
+		svc.GetEventConfig,
+		connect.WithSchema(adminServiceMethods.ByName("GetEventConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateEventConfigHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateEventConfigProcedure,
+		svc.UpdateEventConfig,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateEventConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetFlagSharingReportHandler := connect.NewUnaryHandler(
+		AdminServiceGetFlagSharingReportProcedure,
+		svc.GetFlagSharingReport,
+		connect.WithSchema(adminServiceMethods.ByName("GetFlagSharingReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListSubmissionsHandler := connect.NewUnaryHandler(
+		AdminServiceListSubmissionsProcedure,
+		svc.ListSubmissions,
+		connect.WithSchema(adminServiceMethods.ByName("ListSubmissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceInvalidateSubmissionHandler := connect.NewUnaryHandler(
+		AdminServiceInvalidateSubmissionProcedure,
+		svc.InvalidateSubmission,
+		connect.WithSchema(adminServiceMethods.ByName("InvalidateSubmission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAdminsHandler := connect.NewUnaryHandler(
+		AdminServiceListAdminsProcedure,
+		svc.ListAdmins,
+		connect.WithSchema(adminServiceMethods.ByName("ListAdmins")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGrantAdminHandler := connect.NewUnaryHandler(
+		AdminServiceGrantAdminProcedure,
+		svc.GrantAdmin,
+		connect.WithSchema(adminServiceMethods.ByName("GrantAdmin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRevokeAdminHandler := connect.NewUnaryHandler(
+		AdminServiceRevokeAdminProcedure,
+		svc.RevokeAdmin,
+		connect.WithSchema(adminServiceMethods.ByName("RevokeAdmin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListUsersHandler := connect.NewUnaryHandler(
+		AdminServiceListUsersProcedure,
+		svc.ListUsers,
+		connect.WithSchema(adminServiceMethods.ByName("ListUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetUserHandler := connect.NewUnaryHandler(
+		AdminServiceGetUserProcedure,
+		svc.GetUser,
+		connect.WithSchema(adminServiceMethods.ByName("GetUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceBanUserHandler := connect.NewUnaryHandler(
+		AdminServiceBanUserProcedure,
+		svc.BanUser,
+		connect.WithSchema(adminServiceMethods.ByName("BanUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUnbanUserHandler := connect.NewUnaryHandler(
+		AdminServiceUnbanUserProcedure,
+		svc.UnbanUser,
+		connect.WithSchema(adminServiceMethods.ByName("UnbanUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceResetUserPasswordHandler := connect.NewUnaryHandler(
+		AdminServiceResetUserPasswordProcedure,
+		svc.ResetUserPassword,
+		connect.WithSchema(adminServiceMethods.ByName("ResetUserPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteUserHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteUserProcedure,
+		svc.DeleteUser,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateHintHandler := connect.NewUnaryHandler(
+		AdminServiceCreateHintProcedure,
+		svc.CreateHint,
+		connect.WithSchema(adminServiceMethods.ByName("CreateHint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateHintHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateHintProcedure,
+		svc.UpdateHint,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateHint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteHintHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteHintProcedure,
+		svc.DeleteHint,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteHint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListHintsHandler := connect.NewUnaryHandler(
+		AdminServiceListHintsProcedure,
+		svc.ListHints,
+		connect.WithSchema(adminServiceMethods.ByName("ListHints")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateAnnouncementHandler := connect.NewUnaryHandler(
+		AdminServiceCreateAnnouncementProcedure,
+		svc.CreateAnnouncement,
+		connect.WithSchema(adminServiceMethods.ByName("CreateAnnouncement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateAnnouncementHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateAnnouncementProcedure,
+		svc.UpdateAnnouncement,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateAnnouncement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteAnnouncementHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteAnnouncementProcedure,
+		svc.DeleteAnnouncement,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteAnnouncement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAnnouncementsHandler := connect.NewUnaryHandler(
+		AdminServiceListAnnouncementsProcedure,
+		svc.ListAnnouncements,
+		connect.WithSchema(adminServiceMethods.ByName("ListAnnouncements")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		AdminServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(adminServiceMethods.ByName("ListAuditEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.server.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AdminServiceCreateChallengeProcedure:
@@ -353,6 +871,52 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceUploadAttachmentHandler.ServeHTTP(w, r)
 		case AdminServiceDeleteAttachmentProcedure:
 			adminServiceDeleteAttachmentHandler.ServeHTTP(w, r)
+		case AdminServiceGetEventConfigProcedure:
+			adminServiceGetEventConfigHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateEventConfigProcedure:
+			adminServiceUpdateEventConfigHandler.ServeHTTP(w, r)
+		case AdminServiceGetFlagSharingReportProcedure:
+			adminServiceGetFlagSharingReportHandler.ServeHTTP(w, r)
+		case AdminServiceListSubmissionsProcedure:
+			adminServiceListSubmissionsHandler.ServeHTTP(w, r)
+		case AdminServiceInvalidateSubmissionProcedure:
+			adminServiceInvalidateSubmissionHandler.ServeHTTP(w, r)
+		case AdminServiceListAdminsProcedure:
+			adminServiceListAdminsHandler.ServeHTTP(w, r)
+		case AdminServiceGrantAdminProcedure:
+			adminServiceGrantAdminHandler.ServeHTTP(w, r)
+		case AdminServiceRevokeAdminProcedure:
+			adminServiceRevokeAdminHandler.ServeHTTP(w, r)
+		case AdminServiceListUsersProcedure:
+			adminServiceListUsersHandler.ServeHTTP(w, r)
+		case AdminServiceGetUserProcedure:
+			adminServiceGetUserHandler.ServeHTTP(w, r)
+		case AdminServiceBanUserProcedure:
+			adminServiceBanUserHandler.ServeHTTP(w, r)
+		case AdminServiceUnbanUserProcedure:
+			adminServiceUnbanUserHandler.ServeHTTP(w, r)
+		case AdminServiceResetUserPasswordProcedure:
+			adminServiceResetUserPasswordHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteUserProcedure:
+			adminServiceDeleteUserHandler.ServeHTTP(w, r)
+		case AdminServiceCreateHintProcedure:
+			adminServiceCreateHintHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateHintProcedure:
+			adminServiceUpdateHintHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteHintProcedure:
+			adminServiceDeleteHintHandler.ServeHTTP(w, r)
+		case AdminServiceListHintsProcedure:
+			adminServiceListHintsHandler.ServeHTTP(w, r)
+		case AdminServiceCreateAnnouncementProcedure:
+			adminServiceCreateAnnouncementHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateAnnouncementProcedure:
+			adminServiceUpdateAnnouncementHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteAnnouncementProcedure:
+			adminServiceDeleteAnnouncementHandler.ServeHTTP(w, r)
+		case AdminServiceListAnnouncementsProcedure:
+			adminServiceListAnnouncementsHandler.ServeHTTP(w, r)
+		case AdminServiceListAuditEventsProcedure:
+			adminServiceListAuditEventsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -406,6 +970,98 @@ func (UnimplementedAdminServiceHandler) DeleteAttachment(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.DeleteAttachment is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) GetEventConfig(context.Context, *connect.Request[v1.GetEventConfigRequest]) (*connect.Response[v1.GetEventConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.GetEventConfig is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateEventConfig(context.Context, *connect.Request[v1.UpdateEventConfigRequest]) (*connect.Response[v1.UpdateEventConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.UpdateEventConfig is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetFlagSharingReport(context.Context, *connect.Request[v1.GetFlagSharingReportRequest]) (*connect.Response[v1.GetFlagSharingReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.GetFlagSharingReport is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListSubmissions(context.Context, *connect.Request[v1.ListSubmissionsRequest]) (*connect.Response[v1.ListSubmissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.ListSubmissions is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) InvalidateSubmission(context.Context, *connect.Request[v1.InvalidateSubmissionRequest]) (*connect.Response[v1.InvalidateSubmissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.InvalidateSubmission is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAdmins(context.Context, *connect.Request[v1.ListAdminsRequest]) (*connect.Response[v1.ListAdminsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.ListAdmins is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GrantAdmin(context.Context, *connect.Request[v1.GrantAdminRequest]) (*connect.Response[v1.GrantAdminResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.GrantAdmin is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RevokeAdmin(context.Context, *connect.Request[v1.RevokeAdminRequest]) (*connect.Response[v1.RevokeAdminResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.RevokeAdmin is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.ListUsers is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.GetUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) BanUser(context.Context, *connect.Request[v1.BanUserRequest]) (*connect.Response[v1.BanUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.BanUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UnbanUser(context.Context, *connect.Request[v1.UnbanUserRequest]) (*connect.Response[v1.UnbanUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.UnbanUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ResetUserPassword(context.Context, *connect.Request[v1.ResetUserPasswordRequest]) (*connect.Response[v1.ResetUserPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.ResetUserPassword is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.DeleteUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateHint(context.Context, *connect.Request[v1.CreateHintRequest]) (*connect.Response[v1.CreateHintResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.CreateHint is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateHint(context.Context, *connect.Request[v1.UpdateHintRequest]) (*connect.Response[v1.UpdateHintResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.UpdateHint is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteHint(context.Context, *connect.Request[v1.DeleteHintRequest]) (*connect.Response[v1.DeleteHintResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.DeleteHint is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListHints(context.Context, *connect.Request[v1.ListHintsRequest]) (*connect.Response[v1.ListHintsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.ListHints is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateAnnouncement(context.Context, *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.CreateAnnouncement is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateAnnouncement(context.Context, *connect.Request[v1.UpdateAnnouncementRequest]) (*connect.Response[v1.UpdateAnnouncementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.UpdateAnnouncement is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteAnnouncement(context.Context, *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.DeleteAnnouncement is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.ListAnnouncements is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.AdminService.ListAuditEvents is not implemented"))
+}
+
 // AdminAuthServiceClient is a client for the api.server.v1.AdminAuthService service.
 type AdminAuthServiceClient interface {
 	AdminLogin(context.Context, *connect.Request[v1.AdminLoginRequest]) (*connect.Response[v1.AdminLoginResponse], error)
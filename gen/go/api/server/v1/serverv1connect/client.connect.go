@@ -23,6 +23,8 @@ const _ = connect.IsAtLeastVersion1_13_0
 const (
 	// ClientChallengeServiceName is the fully-qualified name of the ClientChallengeService service.
 	ClientChallengeServiceName = "api.server.v1.ClientChallengeService"
+	// TeamServiceName is the fully-qualified name of the TeamService service.
+	TeamServiceName = "api.server.v1.TeamService"
 	// UserAuthServiceName is the fully-qualified name of the UserAuthService service.
 	UserAuthServiceName = "api.server.v1.UserAuthService"
 )
@@ -41,6 +43,24 @@ const (
 	// ClientChallengeServiceSubmitFlagProcedure is the fully-qualified name of the
 	// ClientChallengeService's SubmitFlag RPC.
 	ClientChallengeServiceSubmitFlagProcedure = "/api.server.v1.ClientChallengeService/SubmitFlag"
+	// ClientChallengeServiceGetScoreboardProcedure is the fully-qualified name of the
+	// ClientChallengeService's GetScoreboard RPC.
+	ClientChallengeServiceGetScoreboardProcedure = "/api.server.v1.ClientChallengeService/GetScoreboard"
+	// ClientChallengeServiceGetScoreHistoryProcedure is the fully-qualified name of the
+	// ClientChallengeService's GetScoreHistory RPC.
+	ClientChallengeServiceGetScoreHistoryProcedure = "/api.server.v1.ClientChallengeService/GetScoreHistory"
+	// ClientChallengeServiceGetHintsProcedure is the fully-qualified name of the
+	// ClientChallengeService's GetHints RPC.
+	ClientChallengeServiceGetHintsProcedure = "/api.server.v1.ClientChallengeService/GetHints"
+	// ClientChallengeServiceUnlockHintProcedure is the fully-qualified name of the
+	// ClientChallengeService's UnlockHint RPC.
+	ClientChallengeServiceUnlockHintProcedure = "/api.server.v1.ClientChallengeService/UnlockHint"
+	// ClientChallengeServiceGetAnnouncementsProcedure is the fully-qualified name of the
+	// ClientChallengeService's GetAnnouncements RPC.
+	ClientChallengeServiceGetAnnouncementsProcedure = "/api.server.v1.ClientChallengeService/GetAnnouncements"
+	// ClientChallengeServiceStreamEventsProcedure is the fully-qualified name of the
+	// ClientChallengeService's StreamEvents RPC.
+	ClientChallengeServiceStreamEventsProcedure = "/api.server.v1.ClientChallengeService/StreamEvents"
 	// ClientChallengeServiceStartInstanceProcedure is the fully-qualified name of the
 	// ClientChallengeService's StartInstance RPC.
 	ClientChallengeServiceStartInstanceProcedure = "/api.server.v1.ClientChallengeService/StartInstance"
@@ -50,6 +70,14 @@ const (
 	// ClientChallengeServiceGetInstanceStatusProcedure is the fully-qualified name of the
 	// ClientChallengeService's GetInstanceStatus RPC.
 	ClientChallengeServiceGetInstanceStatusProcedure = "/api.server.v1.ClientChallengeService/GetInstanceStatus"
+	// TeamServiceCreateTeamProcedure is the fully-qualified name of the TeamService's CreateTeam RPC.
+	TeamServiceCreateTeamProcedure = "/api.server.v1.TeamService/CreateTeam"
+	// TeamServiceJoinTeamProcedure is the fully-qualified name of the TeamService's JoinTeam RPC.
+	TeamServiceJoinTeamProcedure = "/api.server.v1.TeamService/JoinTeam"
+	// TeamServiceLeaveTeamProcedure is the fully-qualified name of the TeamService's LeaveTeam RPC.
+	TeamServiceLeaveTeamProcedure = "/api.server.v1.TeamService/LeaveTeam"
+	// TeamServiceGetMyTeamProcedure is the fully-qualified name of the TeamService's GetMyTeam RPC.
+	TeamServiceGetMyTeamProcedure = "/api.server.v1.TeamService/GetMyTeam"
 	// UserAuthServiceLoginProcedure is the fully-qualified name of the UserAuthService's Login RPC.
 	UserAuthServiceLoginProcedure = "/api.server.v1.UserAuthService/Login"
 	// UserAuthServiceRegisterProcedure is the fully-qualified name of the UserAuthService's Register
@@ -57,12 +85,27 @@ const (
 	UserAuthServiceRegisterProcedure = "/api.server.v1.UserAuthService/Register"
 	// UserAuthServiceLogoutProcedure is the fully-qualified name of the UserAuthService's Logout RPC.
 	UserAuthServiceLogoutProcedure = "/api.server.v1.UserAuthService/Logout"
+	// UserAuthServiceGetProfileProcedure is the fully-qualified name of the UserAuthService's
+	// GetProfile RPC.
+	UserAuthServiceGetProfileProcedure = "/api.server.v1.UserAuthService/GetProfile"
+	// UserAuthServiceUpdateProfileProcedure is the fully-qualified name of the UserAuthService's
+	// UpdateProfile RPC.
+	UserAuthServiceUpdateProfileProcedure = "/api.server.v1.UserAuthService/UpdateProfile"
+	// UserAuthServiceChangePasswordProcedure is the fully-qualified name of the UserAuthService's
+	// ChangePassword RPC.
+	UserAuthServiceChangePasswordProcedure = "/api.server.v1.UserAuthService/ChangePassword"
 )
 
 // ClientChallengeServiceClient is a client for the api.server.v1.ClientChallengeService service.
 type ClientChallengeServiceClient interface {
 	GetChallenges(context.Context, *connect.Request[v1.GetChallengesRequest]) (*connect.Response[v1.GetChallengesResponse], error)
 	SubmitFlag(context.Context, *connect.Request[v1.SubmitFlagRequest]) (*connect.Response[v1.SubmitFlagResponse], error)
+	GetScoreboard(context.Context, *connect.Request[v1.GetScoreboardRequest]) (*connect.Response[v1.GetScoreboardResponse], error)
+	GetScoreHistory(context.Context, *connect.Request[v1.GetScoreHistoryRequest]) (*connect.Response[v1.GetScoreHistoryResponse], error)
+	GetHints(context.Context, *connect.Request[v1.GetHintsRequest]) (*connect.Response[v1.GetHintsResponse], error)
+	UnlockHint(context.Context, *connect.Request[v1.UnlockHintRequest]) (*connect.Response[v1.UnlockHintResponse], error)
+	GetAnnouncements(context.Context, *connect.Request[v1.GetAnnouncementsRequest]) (*connect.Response[v1.GetAnnouncementsResponse], error)
+	StreamEvents(context.Context, *connect.Request[v1.StreamEventsRequest]) (*connect.ServerStreamForClient[v1.StreamEventsResponse], error)
 	StartInstance(context.Context, *connect.Request[v1.StartInstanceRequest]) (*connect.Response[v1.StartInstanceResponse], error)
 	StopInstance(context.Context, *connect.Request[v1.StopInstanceRequest]) (*connect.Response[v1.StopInstanceResponse], error)
 	GetInstanceStatus(context.Context, *connect.Request[v1.GetInstanceStatusRequest]) (*connect.Response[v1.GetInstanceStatusResponse], error)
@@ -91,6 +134,42 @@ func NewClientChallengeServiceClient(httpClient connect.HTTPClient, baseURL stri
 			connect.WithSchema(clientChallengeServiceMethods.ByName("SubmitFlag")),
 			connect.WithClientOptions(opts...),
 		),
+		getScoreboard: connect.NewClient[v1.GetScoreboardRequest, v1.GetScoreboardResponse](
+			httpClient,
+			baseURL+ClientChallengeServiceGetScoreboardProcedure,
+			connect.WithSchema(clientChallengeServiceMethods.ByName("GetScoreboard")),
+			connect.WithClientOptions(opts...),
+		),
+		getScoreHistory: connect.NewClient[v1.GetScoreHistoryRequest, v1.GetScoreHistoryResponse](
+			httpClient,
+			baseURL+ClientChallengeServiceGetScoreHistoryProcedure,
+			connect.WithSchema(clientChallengeServiceMethods.ByName("GetScoreHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		getHints: connect.NewClient[v1.GetHintsRequest, v1.GetHintsResponse](
+			httpClient,
+			baseURL+ClientChallengeServiceGetHintsProcedure,
+			connect.WithSchema(clientChallengeServiceMethods.ByName("GetHints")),
+			connect.WithClientOptions(opts...),
+		),
+		unlockHint: connect.NewClient[v1.UnlockHintRequest, v1.UnlockHintResponse](
+			httpClient,
+			baseURL+ClientChallengeServiceUnlockHintProcedure,
+			connect.WithSchema(clientChallengeServiceMethods.ByName("UnlockHint")),
+			connect.WithClientOptions(opts...),
+		),
+		getAnnouncements: connect.NewClient[v1.GetAnnouncementsRequest, v1.GetAnnouncementsResponse](
+			httpClient,
+			baseURL+ClientChallengeServiceGetAnnouncementsProcedure,
+			connect.WithSchema(clientChallengeServiceMethods.ByName("GetAnnouncements")),
+			connect.WithClientOptions(opts...),
+		),
+		streamEvents: connect.NewClient[v1.StreamEventsRequest, v1.StreamEventsResponse](
+			httpClient,
+			baseURL+ClientChallengeServiceStreamEventsProcedure,
+			connect.WithSchema(clientChallengeServiceMethods.ByName("StreamEvents")),
+			connect.WithClientOptions(opts...),
+		),
 		startInstance: connect.NewClient[v1.StartInstanceRequest, v1.StartInstanceResponse](
 			httpClient,
 			baseURL+ClientChallengeServiceStartInstanceProcedure,
@@ -116,6 +195,12 @@ func NewClientChallengeServiceClient(httpClient connect.HTTPClient, baseURL stri
 type clientChallengeServiceClient struct {
 	getChallenges     *connect.Client[v1.GetChallengesRequest, v1.GetChallengesResponse]
 	submitFlag        *connect.Client[v1.SubmitFlagRequest, v1.SubmitFlagResponse]
+	getScoreboard     *connect.Client[v1.GetScoreboardRequest, v1.GetScoreboardResponse]
+	getScoreHistory   *connect.Client[v1.GetScoreHistoryRequest, v1.GetScoreHistoryResponse]
+	getHints          *connect.Client[v1.GetHintsRequest, v1.GetHintsResponse]
+	unlockHint        *connect.Client[v1.UnlockHintRequest, v1.UnlockHintResponse]
+	getAnnouncements  *connect.Client[v1.GetAnnouncementsRequest, v1.GetAnnouncementsResponse]
+	streamEvents      *connect.Client[v1.StreamEventsRequest, v1.StreamEventsResponse]
 	startInstance     *connect.Client[v1.StartInstanceRequest, v1.StartInstanceResponse]
 	stopInstance      *connect.Client[v1.StopInstanceRequest, v1.StopInstanceResponse]
 	getInstanceStatus *connect.Client[v1.GetInstanceStatusRequest, v1.GetInstanceStatusResponse]
@@ -131,6 +216,36 @@ func (c *clientChallengeServiceClient) SubmitFlag(ctx context.Context, req *conn
 	return c.submitFlag.CallUnary(ctx, req)
 }
 
+// GetScoreboard calls api.server.v1.ClientChallengeService.GetScoreboard.
+func (c *clientChallengeServiceClient) GetScoreboard(ctx context.Context, req *connect.Request[v1.GetScoreboardRequest]) (*connect.Response[v1.GetScoreboardResponse], error) {
+	return c.getScoreboard.CallUnary(ctx, req)
+}
+
+// GetScoreHistory calls api.server.v1.ClientChallengeService.GetScoreHistory.
+func (c *clientChallengeServiceClient) GetScoreHistory(ctx context.Context, req *connect.Request[v1.GetScoreHistoryRequest]) (*connect.Response[v1.GetScoreHistoryResponse], error) {
+	return c.getScoreHistory.CallUnary(ctx, req)
+}
+
+// GetHints calls api.server.v1.ClientChallengeService.GetHints.
+func (c *clientChallengeServiceClient) GetHints(ctx context.Context, req *connect.Request[v1.GetHintsRequest]) (*connect.Response[v1.GetHintsResponse], error) {
+	return c.getHints.CallUnary(ctx, req)
+}
+
+// UnlockHint calls api.server.v1.ClientChallengeService.UnlockHint.
+func (c *clientChallengeServiceClient) UnlockHint(ctx context.Context, req *connect.Request[v1.UnlockHintRequest]) (*connect.Response[v1.UnlockHintResponse], error) {
+	return c.unlockHint.CallUnary(ctx, req)
+}
+
+// GetAnnouncements calls api.server.v1.ClientChallengeService.GetAnnouncements.
+func (c *clientChallengeServiceClient) GetAnnouncements(ctx context.Context, req *connect.Request[v1.GetAnnouncementsRequest]) (*connect.Response[v1.GetAnnouncementsResponse], error) {
+	return c.getAnnouncements.CallUnary(ctx, req)
+}
+
+// StreamEvents calls api.server.v1.ClientChallengeService.StreamEvents.
+func (c *clientChallengeServiceClient) StreamEvents(ctx context.Context, req *connect.Request[v1.StreamEventsRequest]) (*connect.ServerStreamForClient[v1.StreamEventsResponse], error) {
+	return c.streamEvents.CallServerStream(ctx, req)
+}
+
 // StartInstance calls api.server.v1.ClientChallengeService.StartInstance.
 func (c *clientChallengeServiceClient) StartInstance(ctx context.Context, req *connect.Request[v1.StartInstanceRequest]) (*connect.Response[v1.StartInstanceResponse], error) {
 	return c.startInstance.CallUnary(ctx, req)
@@ -151,6 +266,12 @@ func (c *clientChallengeServiceClient) GetInstanceStatus(ctx context.Context, re
 type ClientChallengeServiceHandler interface {
 	GetChallenges(context.Context, *connect.Request[v1.GetChallengesRequest]) (*connect.Response[v1.GetChallengesResponse], error)
 	SubmitFlag(context.Context, *connect.Request[v1.SubmitFlagRequest]) (*connect.Response[v1.SubmitFlagResponse], error)
+	GetScoreboard(context.Context, *connect.Request[v1.GetScoreboardRequest]) (*connect.Response[v1.GetScoreboardResponse], error)
+	GetScoreHistory(context.Context, *connect.Request[v1.GetScoreHistoryRequest]) (*connect.Response[v1.GetScoreHistoryResponse], error)
+	GetHints(context.Context, *connect.Request[v1.GetHintsRequest]) (*connect.Response[v1.GetHintsResponse], error)
+	UnlockHint(context.Context, *connect.Request[v1.UnlockHintRequest]) (*connect.Response[v1.UnlockHintResponse], error)
+	GetAnnouncements(context.Context, *connect.Request[v1.GetAnnouncementsRequest]) (*connect.Response[v1.GetAnnouncementsResponse], error)
+	StreamEvents(context.Context, *connect.Request[v1.StreamEventsRequest], *connect.ServerStream[v1.StreamEventsResponse]) error
 	StartInstance(context.Context, *connect.Request[v1.StartInstanceRequest]) (*connect.Response[v1.StartInstanceResponse], error)
 	StopInstance(context.Context, *connect.Request[v1.StopInstanceRequest]) (*connect.Response[v1.StopInstanceResponse], error)
 	GetInstanceStatus(context.Context, *connect.Request[v1.GetInstanceStatusRequest]) (*connect.Response[v1.GetInstanceStatusResponse], error)
@@ -175,6 +296,42 @@ func NewClientChallengeServiceHandler(svc ClientChallengeServiceHandler, opts ..
 		connect.WithSchema(clientChallengeServiceMethods.ByName("SubmitFlag")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientChallengeServiceGetScoreboardHandler := connect.NewUnaryHandler(
+		ClientChallengeServiceGetScoreboardProcedure,
+		svc.GetScoreboard,
+		connect.WithSchema(clientChallengeServiceMethods.ByName("GetScoreboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientChallengeServiceGetScoreHistoryHandler := connect.NewUnaryHandler(
+		ClientChallengeServiceGetScoreHistoryProcedure,
+		svc.GetScoreHistory,
+		connect.WithSchema(clientChallengeServiceMethods.ByName("GetScoreHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientChallengeServiceGetHintsHandler := connect.NewUnaryHandler(
+		ClientChallengeServiceGetHintsProcedure,
+		svc.GetHints,
+		connect.WithSchema(clientChallengeServiceMethods.ByName("GetHints")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientChallengeServiceUnlockHintHandler := connect.NewUnaryHandler(
+		ClientChallengeServiceUnlockHintProcedure,
+		svc.UnlockHint,
+		connect.WithSchema(clientChallengeServiceMethods.ByName("UnlockHint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientChallengeServiceGetAnnouncementsHandler := connect.NewUnaryHandler(
+		ClientChallengeServiceGetAnnouncementsProcedure,
+		svc.GetAnnouncements,
+		connect.WithSchema(clientChallengeServiceMethods.ByName("GetAnnouncements")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientChallengeServiceStreamEventsHandler := connect.NewServerStreamHandler(
+		ClientChallengeServiceStreamEventsProcedure,
+		svc.StreamEvents,
+		connect.WithSchema(clientChallengeServiceMethods.ByName("StreamEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	clientChallengeServiceStartInstanceHandler := connect.NewUnaryHandler(
 		ClientChallengeServiceStartInstanceProcedure,
 		svc.StartInstance,
@@ -199,6 +356,18 @@ func NewClientChallengeServiceHandler(svc ClientChallengeServiceHandler, opts ..
 			clientChallengeServiceGetChallengesHandler.ServeHTTP(w, r)
 		case ClientChallengeServiceSubmitFlagProcedure:
 			clientChallengeServiceSubmitFlagHandler.ServeHTTP(w, r)
+		case ClientChallengeServiceGetScoreboardProcedure:
+			clientChallengeServiceGetScoreboardHandler.ServeHTTP(w, r)
+		case ClientChallengeServiceGetScoreHistoryProcedure:
+			clientChallengeServiceGetScoreHistoryHandler.ServeHTTP(w, r)
+		case ClientChallengeServiceGetHintsProcedure:
+			clientChallengeServiceGetHintsHandler.ServeHTTP(w, r)
+		case ClientChallengeServiceUnlockHintProcedure:
+			clientChallengeServiceUnlockHintHandler.ServeHTTP(w, r)
+		case ClientChallengeServiceGetAnnouncementsProcedure:
+			clientChallengeServiceGetAnnouncementsHandler.ServeHTTP(w, r)
+		case ClientChallengeServiceStreamEventsProcedure:
+			clientChallengeServiceStreamEventsHandler.ServeHTTP(w, r)
 		case ClientChallengeServiceStartInstanceProcedure:
 			clientChallengeServiceStartInstanceHandler.ServeHTTP(w, r)
 		case ClientChallengeServiceStopInstanceProcedure:
@@ -222,6 +391,30 @@ func (UnimplementedClientChallengeServiceHandler) SubmitFlag(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.ClientChallengeService.SubmitFlag is not implemented"))
 }
 
+func (UnimplementedClientChallengeServiceHandler) GetScoreboard(context.Context, *connect.Request[v1.GetScoreboardRequest]) (*connect.Response[v1.GetScoreboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.ClientChallengeService.GetScoreboard is not implemented"))
+}
+
+func (UnimplementedClientChallengeServiceHandler) GetScoreHistory(context.Context, *connect.Request[v1.GetScoreHistoryRequest]) (*connect.Response[v1.GetScoreHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.ClientChallengeService.GetScoreHistory is not implemented"))
+}
+
+func (UnimplementedClientChallengeServiceHandler) GetHints(context.Context, *connect.Request[v1.GetHintsRequest]) (*connect.Response[v1.GetHintsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.ClientChallengeService.GetHints is not implemented"))
+}
+
+func (UnimplementedClientChallengeServiceHandler) UnlockHint(context.Context, *connect.Request[v1.UnlockHintRequest]) (*connect.Response[v1.UnlockHintResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.ClientChallengeService.UnlockHint is not implemented"))
+}
+
+func (UnimplementedClientChallengeServiceHandler) GetAnnouncements(context.Context, *connect.Request[v1.GetAnnouncementsRequest]) (*connect.Response[v1.GetAnnouncementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.ClientChallengeService.GetAnnouncements is not implemented"))
+}
+
+func (UnimplementedClientChallengeServiceHandler) StreamEvents(context.Context, *connect.Request[v1.StreamEventsRequest], *connect.ServerStream[v1.StreamEventsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.ClientChallengeService.StreamEvents is not implemented"))
+}
+
 func (UnimplementedClientChallengeServiceHandler) StartInstance(context.Context, *connect.Request[v1.StartInstanceRequest]) (*connect.Response[v1.StartInstanceResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.ClientChallengeService.StartInstance is not implemented"))
 }
@@ -234,11 +427,162 @@ func (UnimplementedClientChallengeServiceHandler) GetInstanceStatus(context.Cont
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.ClientChallengeService.GetInstanceStatus is not implemented"))
 }
 
+// TeamServiceClient is a client for the api.server.v1.TeamService service.
+type TeamServiceClient interface {
+	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
+	JoinTeam(context.Context, *connect.Request[v1.JoinTeamRequest]) (*connect.Response[v1.JoinTeamResponse], error)
+	LeaveTeam(context.Context, *connect.Request[v1.LeaveTeamRequest]) (*connect.Response[v1.LeaveTeamResponse], error)
+	GetMyTeam(context.Context, *connect.Request[v1.GetMyTeamRequest]) (*connect.Response[v1.GetMyTeamResponse], error)
+}
+
+// NewTeamServiceClient constructs a client for the api.server.v1.TeamService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTeamServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TeamServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	teamServiceMethods := v1.File_api_server_v1_client_proto.Services().ByName("TeamService").Methods()
+	return &teamServiceClient{
+		createTeam: connect.NewClient[v1.CreateTeamRequest, v1.CreateTeamResponse](
+			httpClient,
+			baseURL+TeamServiceCreateTeamProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("CreateTeam")),
+			connect.WithClientOptions(opts...),
+		),
+		joinTeam: connect.NewClient[v1.JoinTeamRequest, v1.JoinTeamResponse](
+			httpClient,
+			baseURL+TeamServiceJoinTeamProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("JoinTeam")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveTeam: connect.NewClient[v1.LeaveTeamRequest, v1.LeaveTeamResponse](
+			httpClient,
+			baseURL+TeamServiceLeaveTeamProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("LeaveTeam")),
+			connect.WithClientOptions(opts...),
+		),
+		getMyTeam: connect.NewClient[v1.GetMyTeamRequest, v1.GetMyTeamResponse](
+			httpClient,
+			baseURL+TeamServiceGetMyTeamProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("GetMyTeam")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// teamServiceClient implements TeamServiceClient.
+type teamServiceClient struct {
+	createTeam *connect.Client[v1.CreateTeamRequest, v1.CreateTeamResponse]
+	joinTeam   *connect.Client[v1.JoinTeamRequest, v1.JoinTeamResponse]
+	leaveTeam  *connect.Client[v1.LeaveTeamRequest, v1.LeaveTeamResponse]
+	getMyTeam  *connect.Client[v1.GetMyTeamRequest, v1.GetMyTeamResponse]
+}
+
+// CreateTeam calls api.server.v1.TeamService.CreateTeam.
+func (c *teamServiceClient) CreateTeam(ctx context.Context, req *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error) {
+	return c.createTeam.CallUnary(ctx, req)
+}
+
+// JoinTeam calls api.server.v1.TeamService.JoinTeam.
+func (c *teamServiceClient) JoinTeam(ctx context.Context, req *connect.Request[v1.JoinTeamRequest]) (*connect.Response[v1.JoinTeamResponse], error) {
+	return c.joinTeam.CallUnary(ctx, req)
+}
+
+// LeaveTeam calls api.server.v1.TeamService.LeaveTeam.
+func (c *teamServiceClient) LeaveTeam(ctx context.Context, req *connect.Request[v1.LeaveTeamRequest]) (*connect.Response[v1.LeaveTeamResponse], error) {
+	return c.leaveTeam.CallUnary(ctx, req)
+}
+
+// GetMyTeam calls api.server.v1.TeamService.GetMyTeam.
+func (c *teamServiceClient) GetMyTeam(ctx context.Context, req *connect.Request[v1.GetMyTeamRequest]) (*connect.Response[v1.GetMyTeamResponse], error) {
+	return c.getMyTeam.CallUnary(ctx, req)
+}
+
+// TeamServiceHandler is an implementation of the api.server.v1.TeamService service.
+type TeamServiceHandler interface {
+	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
+	JoinTeam(context.Context, *connect.Request[v1.JoinTeamRequest]) (*connect.Response[v1.JoinTeamResponse], error)
+	LeaveTeam(context.Context, *connect.Request[v1.LeaveTeamRequest]) (*connect.Response[v1.LeaveTeamResponse], error)
+	GetMyTeam(context.Context, *connect.Request[v1.GetMyTeamRequest]) (*connect.Response[v1.GetMyTeamResponse], error)
+}
+
+// NewTeamServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTeamServiceHandler(svc TeamServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	teamServiceMethods := v1.File_api_server_v1_client_proto.Services().ByName("TeamService").Methods()
+	teamServiceCreateTeamHandler := connect.NewUnaryHandler(
+		TeamServiceCreateTeamProcedure,
+		svc.CreateTeam,
+		connect.WithSchema(teamServiceMethods.ByName("CreateTeam")),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceJoinTeamHandler := connect.NewUnaryHandler(
+		TeamServiceJoinTeamProcedure,
+		svc.JoinTeam,
+		connect.WithSchema(teamServiceMethods.ByName("JoinTeam")),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceLeaveTeamHandler := connect.NewUnaryHandler(
+		TeamServiceLeaveTeamProcedure,
+		svc.LeaveTeam,
+		connect.WithSchema(teamServiceMethods.ByName("LeaveTeam")),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceGetMyTeamHandler := connect.NewUnaryHandler(
+		TeamServiceGetMyTeamProcedure,
+		svc.GetMyTeam,
+		connect.WithSchema(teamServiceMethods.ByName("GetMyTeam")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.server.v1.TeamService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TeamServiceCreateTeamProcedure:
+			teamServiceCreateTeamHandler.ServeHTTP(w, r)
+		case TeamServiceJoinTeamProcedure:
+			teamServiceJoinTeamHandler.ServeHTTP(w, r)
+		case TeamServiceLeaveTeamProcedure:
+			teamServiceLeaveTeamHandler.ServeHTTP(w, r)
+		case TeamServiceGetMyTeamProcedure:
+			teamServiceGetMyTeamHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTeamServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTeamServiceHandler struct{}
+
+func (UnimplementedTeamServiceHandler) CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.TeamService.CreateTeam is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) JoinTeam(context.Context, *connect.Request[v1.JoinTeamRequest]) (*connect.Response[v1.JoinTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.TeamService.JoinTeam is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) LeaveTeam(context.Context, *connect.Request[v1.LeaveTeamRequest]) (*connect.Response[v1.LeaveTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.TeamService.LeaveTeam is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) GetMyTeam(context.Context, *connect.Request[v1.GetMyTeamRequest]) (*connect.Response[v1.GetMyTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.TeamService.GetMyTeam is not implemented"))
+}
+
 // UserAuthServiceClient is a client for the api.server.v1.UserAuthService service.
 type UserAuthServiceClient interface {
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error)
+	UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error)
+	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
 }
 
 // NewUserAuthServiceClient constructs a client for the api.server.v1.UserAuthService service. By
@@ -270,14 +614,35 @@ func NewUserAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(userAuthServiceMethods.ByName("Logout")),
 			connect.WithClientOptions(opts...),
 		),
+		getProfile: connect.NewClient[v1.GetProfileRequest, v1.GetProfileResponse](
+			httpClient,
+			baseURL+UserAuthServiceGetProfileProcedure,
+			connect.WithSchema(userAuthServiceMethods.ByName("GetProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProfile: connect.NewClient[v1.UpdateProfileRequest, v1.UpdateProfileResponse](
+			httpClient,
+			baseURL+UserAuthServiceUpdateProfileProcedure,
+			connect.WithSchema(userAuthServiceMethods.ByName("UpdateProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		changePassword: connect.NewClient[v1.ChangePasswordRequest, v1.ChangePasswordResponse](
+			httpClient,
+			baseURL+UserAuthServiceChangePasswordProcedure,
+			connect.WithSchema(userAuthServiceMethods.ByName("ChangePassword")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userAuthServiceClient implements UserAuthServiceClient.
 type userAuthServiceClient struct {
-	login    *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	register *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
-	logout   *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	login          *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	register       *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
+	logout         *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	getProfile     *connect.Client[v1.GetProfileRequest, v1.GetProfileResponse]
+	updateProfile  *connect.Client[v1.UpdateProfileRequest, v1.UpdateProfileResponse]
+	changePassword *connect.Client[v1.ChangePasswordRequest, v1.ChangePasswordResponse]
 }
 
 // Login calls api.server.v1.UserAuthService.Login.
@@ -295,11 +660,29 @@ func (c *userAuthServiceClient) Logout(ctx context.Context, req *connect.Request
 	return c.logout.CallUnary(ctx, req)
 }
 
+// GetProfile calls api.server.v1.UserAuthService.GetProfile.
+func (c *userAuthServiceClient) GetProfile(ctx context.Context, req *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error) {
+	return c.getProfile.CallUnary(ctx, req)
+}
+
+// UpdateProfile calls api.server.v1.UserAuthService.UpdateProfile.
+func (c *userAuthServiceClient) UpdateProfile(ctx context.Context, req *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error) {
+	return c.updateProfile.CallUnary(ctx, req)
+}
+
+// ChangePassword calls api.server.v1.UserAuthService.ChangePassword.
+func (c *userAuthServiceClient) ChangePassword(ctx context.Context, req *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error) {
+	return c.changePassword.CallUnary(ctx, req)
+}
+
 // UserAuthServiceHandler is an implementation of the api.server.v1.UserAuthService service.
 type UserAuthServiceHandler interface {
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error)
+	UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error)
+	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
 }
 
 // NewUserAuthServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -327,6 +710,24 @@ func NewUserAuthServiceHandler(svc UserAuthServiceHandler, opts ...connect.Handl
 		connect.WithSchema(userAuthServiceMethods.ByName("Logout")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userAuthServiceGetProfileHandler := connect.NewUnaryHandler(
+		UserAuthServiceGetProfileProcedure,
+		svc.GetProfile,
+		connect.WithSchema(userAuthServiceMethods.ByName("GetProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userAuthServiceUpdateProfileHandler := connect.NewUnaryHandler(
+		UserAuthServiceUpdateProfileProcedure,
+		svc.UpdateProfile,
+		connect.WithSchema(userAuthServiceMethods.ByName("UpdateProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userAuthServiceChangePasswordHandler := connect.NewUnaryHandler(
+		UserAuthServiceChangePasswordProcedure,
+		svc.ChangePassword,
+		connect.WithSchema(userAuthServiceMethods.ByName("ChangePassword")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.server.v1.UserAuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserAuthServiceLoginProcedure:
@@ -335,6 +736,12 @@ func NewUserAuthServiceHandler(svc UserAuthServiceHandler, opts ...connect.Handl
 			userAuthServiceRegisterHandler.ServeHTTP(w, r)
 		case UserAuthServiceLogoutProcedure:
 			userAuthServiceLogoutHandler.ServeHTTP(w, r)
+		case UserAuthServiceGetProfileProcedure:
+			userAuthServiceGetProfileHandler.ServeHTTP(w, r)
+		case UserAuthServiceUpdateProfileProcedure:
+			userAuthServiceUpdateProfileHandler.ServeHTTP(w, r)
+		case UserAuthServiceChangePasswordProcedure:
+			userAuthServiceChangePasswordHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -355,3 +762,15 @@ func (UnimplementedUserAuthServiceHandler) Register(context.Context, *connect.Re
 func (UnimplementedUserAuthServiceHandler) Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.UserAuthService.Logout is not implemented"))
 }
+
+func (UnimplementedUserAuthServiceHandler) GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.UserAuthService.GetProfile is not implemented"))
+}
+
+func (UnimplementedUserAuthServiceHandler) UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.UserAuthService.UpdateProfile is not implemented"))
+}
+
+func (UnimplementedUserAuthServiceHandler) ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.server.v1.UserAuthService.ChangePassword is not implemented"))
+}
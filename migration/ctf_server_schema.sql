@@ -3,13 +3,18 @@ USE ctf_server_db;
 CREATE TABLE IF NOT EXISTS users (
     id CHAR(36) PRIMARY KEY,
     username VARCHAR(255) NOT NULL UNIQUE,
-    email VARCHAR(255) NOT NULL UNIQUE,
+    email VARCHAR(255) UNIQUE, -- NULL until the user sets one
+    display_name VARCHAR(64) NOT NULL DEFAULT '',
+    affiliation VARCHAR(128) NOT NULL DEFAULT '',
+    country CHAR(2) NOT NULL DEFAULT '', -- ISO 3166-1 alpha-2
     password_hash VARCHAR(255) NOT NULL,
-    is_admin BOOLEAN NOT NULL DEFAULT FALSE,
+    role VARCHAR(20) NOT NULL DEFAULT '', -- superadmin, author or support. empty for players
+    banned_at TIMESTAMP NULL, -- set while an admin has banned the user
     created_at TIMESTAMP NOT NULL,
     updated_at TIMESTAMP NOT NULL,
     INDEX idx_username (username),
-    INDEX idx_email (email)
+    INDEX idx_email (email),
+    INDEX idx_created_at (created_at, id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE IF NOT EXISTS sessions (
@@ -24,6 +29,22 @@ CREATE TABLE IF NOT EXISTS sessions (
     INDEX idx_user_id (user_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
+CREATE TABLE IF NOT EXISTS teams (
+    id CHAR(36) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    invite_code VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS team_members (
+    user_id CHAR(36) PRIMARY KEY,
+    team_id CHAR(36) NOT NULL,
+    joined_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+    INDEX idx_team_id (team_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 CREATE TABLE IF NOT EXISTS challenges (
     id CHAR(36) PRIMARY KEY,
     name VARCHAR(255) NOT NULL,
@@ -32,8 +53,44 @@ CREATE TABLE IF NOT EXISTS challenges (
     points INT NOT NULL,
     genre VARCHAR(100) NOT NULL,
     requires_instance BOOLEAN NOT NULL DEFAULT FALSE,
+    scoring_type VARCHAR(20) NOT NULL DEFAULT 'static',
+    initial_points INT NOT NULL DEFAULT 0,
+    minimum_points INT NOT NULL DEFAULT 0,
+    decay INT NOT NULL DEFAULT 0,
+    dynamic_flag BOOLEAN NOT NULL DEFAULT FALSE,
+    flag_match_mode VARCHAR(20) NOT NULL DEFAULT 'exact',
+    accepted_flags TEXT,
+    prerequisite_mode VARCHAR(20) NOT NULL DEFAULT 'all',
+    visibility VARCHAR(20) NOT NULL DEFAULT 'visible',
+    release_at TIMESTAMP NULL,
+    first_blood_bonus INT NOT NULL DEFAULT 0,
+    second_blood_bonus INT NOT NULL DEFAULT 0,
+    third_blood_bonus INT NOT NULL DEFAULT 0,
+    author_id CHAR(36),
     created_at TIMESTAMP NOT NULL,
-    updated_at TIMESTAMP NOT NULL
+    updated_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE SET NULL,
+    INDEX idx_visibility_release_at (visibility, release_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS challenge_prerequisites (
+    challenge_id CHAR(36) NOT NULL,
+    prerequisite_id CHAR(36) NOT NULL,
+    PRIMARY KEY (challenge_id, prerequisite_id),
+    FOREIGN KEY (challenge_id) REFERENCES challenges(id) ON DELETE CASCADE,
+    FOREIGN KEY (prerequisite_id) REFERENCES challenges(id) ON DELETE CASCADE,
+    INDEX idx_prerequisite_id (prerequisite_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS flag_parts (
+    id CHAR(36) PRIMARY KEY,
+    challenge_id CHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    flag VARCHAR(255) NOT NULL,
+    points INT NOT NULL DEFAULT 0,
+    position INT NOT NULL,
+    FOREIGN KEY (challenge_id) REFERENCES challenges(id) ON DELETE CASCADE,
+    UNIQUE KEY uk_challenge_name (challenge_id, name)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE IF NOT EXISTS attachments (
@@ -50,14 +107,33 @@ CREATE TABLE IF NOT EXISTS attachments (
 CREATE TABLE IF NOT EXISTS submissions (
     id CHAR(36) PRIMARY KEY,
     user_id CHAR(36) NOT NULL,
+    team_id CHAR(36),
     challenge_id CHAR(36) NOT NULL,
     submitted_flag VARCHAR(255) NOT NULL,
     is_correct BOOLEAN NOT NULL,
+    part_id CHAR(36), -- set when the flag matched a part without completing the challenge
+    completed_part_id CHAR(36), -- set on the solve whose flag matched the last remaining part
+    solve_rank INT, -- order in which the challenge was solved, set only on solves
     submitted_at TIMESTAMP NOT NULL,
-    FOREIGN KEY (user_id) REFERENCES users(id),
+    invalidated_at TIMESTAMP NULL, -- set when an admin revoked a correct submission
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL,
     FOREIGN KEY (challenge_id) REFERENCES challenges(id),
     INDEX idx_user_id (user_id),
-    INDEX idx_challenge_id (challenge_id)
+    INDEX idx_team_id (team_id),
+    INDEX idx_challenge_id (challenge_id),
+    INDEX idx_submitted_at (submitted_at, id),
+    UNIQUE KEY uk_challenge_solve_rank (challenge_id, solve_rank)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS solves (
+    submission_id CHAR(36) PRIMARY KEY,
+    challenge_id CHAR(36) NOT NULL,
+    owner_id CHAR(36) NOT NULL, -- team_id in team mode, user_id otherwise
+    solved_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (submission_id) REFERENCES submissions(id) ON DELETE CASCADE,
+    FOREIGN KEY (challenge_id) REFERENCES challenges(id) ON DELETE CASCADE,
+    UNIQUE KEY uk_challenge_owner (challenge_id, owner_id)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE IF NOT EXISTS instances (
@@ -70,9 +146,100 @@ CREATE TABLE IF NOT EXISTS instances (
     port INT,
     started_at TIMESTAMP NOT NULL,
     expires_at TIMESTAMP NOT NULL,
-    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
     FOREIGN KEY (challenge_id) REFERENCES challenges(id),
     INDEX idx_user_id (user_id),
     INDEX idx_challenge_id (challenge_id),
     INDEX idx_status (status)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS event_config (
+    id TINYINT PRIMARY KEY,
+    start_at TIMESTAMP NULL,
+    end_at TIMESTAMP NULL,
+    freeze_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NOT NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS issued_flags (
+    id CHAR(36) PRIMARY KEY,
+    challenge_id CHAR(36) NOT NULL,
+    owner_id CHAR(36) NOT NULL,
+    flag VARCHAR(255) NOT NULL,
+    issued_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (challenge_id) REFERENCES challenges(id) ON DELETE CASCADE,
+    UNIQUE KEY uk_challenge_owner (challenge_id, owner_id),
+    INDEX idx_flag (flag)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS hints (
+    id CHAR(36) PRIMARY KEY,
+    challenge_id CHAR(36) NOT NULL,
+    content TEXT NOT NULL,
+    cost INT NOT NULL DEFAULT 0,
+    position INT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (challenge_id) REFERENCES challenges(id) ON DELETE CASCADE,
+    INDEX idx_challenge_id (challenge_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS hint_unlocks (
+    id CHAR(36) PRIMARY KEY,
+    hint_id CHAR(36) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    team_id CHAR(36),
+    owner_id CHAR(36) NOT NULL,
+    unlocked_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (hint_id) REFERENCES hints(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL,
+    UNIQUE KEY uk_hint_owner (hint_id, owner_id),
+    INDEX idx_user_id (user_id),
+    INDEX idx_team_id (team_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS flag_part_solves (
+    id CHAR(36) PRIMARY KEY,
+    part_id CHAR(36) NOT NULL,
+    challenge_id CHAR(36) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    team_id CHAR(36),
+    owner_id CHAR(36) NOT NULL,
+    solved_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (part_id) REFERENCES flag_parts(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL,
+    UNIQUE KEY uk_part_owner (part_id, owner_id),
+    INDEX idx_owner_id (owner_id),
+    INDEX idx_user_id (user_id),
+    INDEX idx_team_id (team_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS announcements (
+    id CHAR(36) PRIMARY KEY,
+    challenge_id CHAR(36),
+    title VARCHAR(255) NOT NULL,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (challenge_id) REFERENCES challenges(id) ON DELETE CASCADE,
+    INDEX idx_challenge_id (challenge_id),
+    INDEX idx_created_at (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- append-only. actor_id has no foreign key so that records outlive deleted users
+CREATE TABLE IF NOT EXISTS audit_events (
+    id CHAR(36) PRIMARY KEY,
+    actor_id CHAR(36) NOT NULL,
+    actor_name VARCHAR(255) NOT NULL,
+    action VARCHAR(100) NOT NULL,
+    target_type VARCHAR(20) NOT NULL,
+    target_id VARCHAR(255) NOT NULL,
+    changes MEDIUMTEXT NOT NULL,
+    error_message TEXT NOT NULL,
+    client_ip VARCHAR(64) NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    INDEX idx_occurred_at (occurred_at),
+    INDEX idx_actor_id (actor_id),
+    INDEX idx_target (target_type, target_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;